@@ -2,10 +2,19 @@ package main
 
 import (
 	"PullRequestService/internal/app"
+	"PullRequestService/internal/cli"
 	"log"
+	"os"
 )
 
 func main() {
+	if len(os.Args) > 1 {
+		if err := cli.Run(os.Args[1:]); err != nil {
+			log.Fatalf("%s: %v", os.Args[1], err)
+		}
+		return
+	}
+
 	a, err := app.New()
 	if err != nil {
 		log.Fatalf("failed to init app: %v", err)
@@ -0,0 +1,21 @@
+package domain
+
+import "time"
+
+type Absence struct {
+	ID             int64      `gorm:"column:id;primaryKey"`
+	Organization   string     `gorm:"column:organization"`
+	UserID         string     `gorm:"column:user_id"`
+	StartsAt       time.Time  `gorm:"column:starts_at"`
+	EndsAt         time.Time  `gorm:"column:ends_at"`
+	Reason         string     `gorm:"column:reason"`
+	Source         string     `gorm:"column:source"`
+	ExternalUID    *string    `gorm:"column:external_uid"`
+	HandOffReviews bool       `gorm:"column:hand_off_reviews"`
+	HandedOffAt    *time.Time `gorm:"column:handed_off_at"`
+	CreatedAt      time.Time  `gorm:"column:created_at"`
+}
+
+func (Absence) TableName() string {
+	return "user_absences"
+}
@@ -0,0 +1,43 @@
+package domain
+
+import "time"
+
+// Outcomes of an audited call, by the HTTP status of its response.
+const (
+	AuditSuccess  = "success"
+	AuditRejected = "rejected"
+	AuditError    = "error"
+)
+
+// AuditEntry records one mutating API call. Payload, Before and After hold JSON;
+// Before and After are snapshots of the team, user or PR the call acted on.
+type AuditEntry struct {
+	ID           int64     `gorm:"column:id;primaryKey"`
+	Organization string    `gorm:"column:organization"`
+	CreatedAt    time.Time `gorm:"column:created_at"`
+	Actor        string    `gorm:"column:actor"`
+	RequestID    string    `gorm:"column:request_id"`
+	Operation    string    `gorm:"column:operation"`
+	Payload      string    `gorm:"column:payload"`
+	Status       int       `gorm:"column:status"`
+	Outcome      string    `gorm:"column:outcome"`
+	ErrorCode    *string   `gorm:"column:error_code"`
+	Before       *string   `gorm:"column:before"`
+	After        *string   `gorm:"column:after"`
+}
+
+func (AuditEntry) TableName() string {
+	return "audit_log"
+}
+
+// AuditFilter narrows the audit log. Empty fields match everything.
+type AuditFilter struct {
+	Actor     string
+	Operation string
+	Outcome   string
+	RequestID string
+	From      *time.Time
+	To        *time.Time
+	Limit     int
+	Offset    int
+}
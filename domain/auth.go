@@ -0,0 +1,82 @@
+package domain
+
+import (
+	"context"
+	"strconv"
+	"time"
+)
+
+// Roles of API callers. Admins may do everything, team leads manage their team and
+// members act on their own behalf.
+const (
+	RoleAdmin    = "admin"
+	RoleTeamLead = "team-lead"
+	RoleMember   = "member"
+)
+
+// APIToken is a stored API token. Only the SHA-256 hash of the secret is kept. A
+// team lead token is bound to TeamName, a member token to UserID; a team lead may
+// have a UserID too, to act as that user.
+type APIToken struct {
+	ID           int64      `gorm:"column:id;primaryKey" json:"id"`
+	Organization string     `gorm:"column:organization" json:"organization"`
+	Name         string     `gorm:"column:name" json:"name"`
+	TokenHash    string     `gorm:"column:token_hash" json:"-"`
+	Role         string     `gorm:"column:role" json:"role"`
+	UserID       *string    `gorm:"column:user_id" json:"user_id,omitempty"`
+	TeamName     *string    `gorm:"column:team_name" json:"team_name,omitempty"`
+	CreatedAt    time.Time  `gorm:"column:created_at" json:"created_at"`
+	LastUsedAt   *time.Time `gorm:"column:last_used_at" json:"last_used_at,omitempty"`
+	RevokedAt    *time.Time `gorm:"column:revoked_at" json:"revoked_at,omitempty"`
+}
+
+func (APIToken) TableName() string {
+	return "api_tokens"
+}
+
+// Identity is the authenticated caller of a request. TokenID is zero for callers
+// authenticated by a JWT. The caller only sees the data of its Organization.
+type Identity struct {
+	TokenID      int64
+	Organization string
+	Role         string
+	UserID       string
+	TeamName     string
+}
+
+// ActorSystem is the actor of changes nobody asked for over the API: the scheduler,
+// the CLI, or any request while authentication is disabled.
+const ActorSystem = "system"
+
+// Actor names the caller in audit records: the user it acts as, else its token.
+func (i Identity) Actor() string {
+	if i.UserID != "" {
+		return i.UserID
+	}
+	if i.TokenID != 0 {
+		return "token:" + strconv.FormatInt(i.TokenID, 10)
+	}
+	return ActorSystem
+}
+
+type identityKey struct{}
+
+// WithIdentity returns a copy of ctx carrying the caller's identity.
+func WithIdentity(ctx context.Context, identity Identity) context.Context {
+	return context.WithValue(ctx, identityKey{}, identity)
+}
+
+// IdentityFrom returns the caller's identity stored by WithIdentity. ok is false when
+// authentication is disabled or the call didn't come from a request.
+func IdentityFrom(ctx context.Context) (identity Identity, ok bool) {
+	identity, ok = ctx.Value(identityKey{}).(Identity)
+	return identity, ok
+}
+
+// ActorFrom is the actor of the call ctx belongs to.
+func ActorFrom(ctx context.Context) string {
+	if identity, ok := IdentityFrom(ctx); ok {
+		return identity.Actor()
+	}
+	return ActorSystem
+}
@@ -2,7 +2,20 @@ package domain
 
 type DeactivateResult struct {
 	TeamName                 string
+	DryRun                   bool
 	DeactivatedCount         int
 	AffectedPRCount          int
 	ReassignedReviewersCount int
+	Reassignments            []ReviewerReassignment
+}
+
+// ReviewerReassignment describes what happened to one reviewer slot of an OPEN PR.
+// NewReviewerID is empty when the reviewer was dropped because there was no candidate.
+// PairingBroken is set when the PR's team uses the pairing policy and the slot could
+// not be given to someone of the same seniority.
+type ReviewerReassignment struct {
+	PullRequestID string
+	OldReviewerID string
+	NewReviewerID string
+	PairingBroken bool
 }
@@ -0,0 +1,118 @@
+package domain
+
+import (
+	"fmt"
+	"net/http"
+)
+
+// Error is a failure reported to the API caller: Code is the ErrorResponse code,
+// Status the HTTP status and Message the text for humans. Fields lists the invalid
+// fields of a rejected request. Cause is the underlying error, if any.
+type Error struct {
+	Code    string
+	Status  int
+	Message string
+	Fields  []FieldError
+	Cause   error
+
+	// parent is the error this one was derived from with Msg or Wrap.
+	parent *Error
+}
+
+// NewError defines an error kind. Derive the errors actually returned from it with
+// Msg, Msgf or Wrap, so errors.Is still recognizes the kind.
+func NewError(code string, status int, message string) *Error {
+	return &Error{Code: code, Status: status, Message: message}
+}
+
+// Kinds of errors shared by the services. Packages define their own ones with
+// NewError or derive them from these.
+var (
+	ErrBadRequest      = NewError("BAD_REQUEST", http.StatusBadRequest, "bad request")
+	ErrUnauthorized    = NewError("UNAUTHORIZED", http.StatusUnauthorized, "unauthorized")
+	ErrForbidden       = NewError("FORBIDDEN", http.StatusForbidden, "forbidden")
+	ErrNotFound        = NewError("NOT_FOUND", http.StatusNotFound, "resource not found")
+	ErrTeamExists      = NewError("TEAM_EXISTS", http.StatusBadRequest, "team already exists")
+	ErrPRExists        = NewError("PR_EXISTS", http.StatusConflict, "PR id already exists")
+	ErrPRMerged        = NewError("PR_MERGED", http.StatusConflict, "PR is merged")
+	ErrNotAssigned     = NewError("NOT_ASSIGNED", http.StatusConflict, "reviewer is not assigned to this PR")
+	ErrNoCandidate     = NewError("NO_CANDIDATE", http.StatusConflict, "no active replacement candidate in team or its fallbacks")
+	ErrVersionMismatch = NewError("VERSION_MISMATCH", http.StatusPreconditionFailed, "resource was changed by another request")
+	ErrInternal        = NewError("INTERNAL_ERROR", http.StatusInternalServerError, "internal error")
+
+	// ErrValidation is returned for a request that breaks the API schema; its Fields
+	// say where.
+	ErrValidation = ErrBadRequest.Msg("request validation failed")
+)
+
+// FieldError is one invalid field of a request. Field is the dotted path of the
+// field in the body, e.g. members.1.user_id, or the name of the parameter.
+type FieldError struct {
+	Field   string `json:"field"`
+	Message string `json:"message"`
+}
+
+func (e *Error) Error() string {
+	if e.Cause != nil {
+		return e.Message + ": " + e.Cause.Error()
+	}
+	return e.Message
+}
+
+func (e *Error) Unwrap() error {
+	return e.Cause
+}
+
+// Is reports whether e was derived from target, directly or through other derived
+// errors.
+func (e *Error) Is(target error) bool {
+	t, ok := target.(*Error)
+	if !ok {
+		return false
+	}
+	for p := e.parent; p != nil; p = p.parent {
+		if p == t {
+			return true
+		}
+	}
+	return false
+}
+
+// Msg returns an error of the same kind with another message.
+func (e *Error) Msg(message string) *Error {
+	derived := e.derive()
+	derived.Message = message
+	return derived
+}
+
+// Msgf is Msg with a format.
+func (e *Error) Msgf(format string, args ...interface{}) *Error {
+	return e.Msg(fmt.Sprintf(format, args...))
+}
+
+// Detailf returns an error of the same kind whose message adds the formatted detail
+// to e's, e.g. "invalid reviewer: user u3 is inactive". Unlike a Cause, the detail is
+// shown to the caller.
+func (e *Error) Detailf(format string, args ...interface{}) *Error {
+	return e.Msg(e.Message + ": " + fmt.Sprintf(format, args...))
+}
+
+// WithFields returns an error of the same kind listing the invalid fields.
+func (e *Error) WithFields(fields ...FieldError) *Error {
+	derived := e.derive()
+	derived.Fields = fields
+	return derived
+}
+
+// Wrap returns an error of the same kind caused by cause.
+func (e *Error) Wrap(cause error) *Error {
+	derived := e.derive()
+	derived.Cause = cause
+	return derived
+}
+
+func (e *Error) derive() *Error {
+	derived := *e
+	derived.parent = e
+	return &derived
+}
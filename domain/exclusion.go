@@ -0,0 +1,18 @@
+package domain
+
+import "time"
+
+// ReviewerExclusion forbids ReviewerID to review PRs authored by AuthorID.
+// A symmetric exclusion works both ways.
+type ReviewerExclusion struct {
+	Organization string    `gorm:"column:organization;primaryKey"`
+	AuthorID     string    `gorm:"column:author_id;primaryKey"`
+	ReviewerID   string    `gorm:"column:reviewer_id;primaryKey"`
+	Symmetric    bool      `gorm:"column:symmetric"`
+	Reason       string    `gorm:"column:reason"`
+	CreatedAt    time.Time `gorm:"column:created_at"`
+}
+
+func (ReviewerExclusion) TableName() string {
+	return "reviewer_exclusions"
+}
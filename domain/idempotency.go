@@ -0,0 +1,24 @@
+package domain
+
+import "time"
+
+// IdempotencyRecord is the stored outcome of a POST sent with an Idempotency-Key.
+// Keys belong to the caller, so Actor is part of the key. Headers holds the response
+// headers set by the handler as a JSON object of value lists. Until the first request
+// with the key finishes, only RequestHash is set.
+type IdempotencyRecord struct {
+	Organization string     `gorm:"column:organization;primaryKey"`
+	Actor        string     `gorm:"column:actor;primaryKey"`
+	Key          string     `gorm:"column:key;primaryKey"`
+	RequestHash  string     `gorm:"column:request_hash"`
+	Status       int        `gorm:"column:status"`
+	ContentType  string     `gorm:"column:content_type"`
+	Headers      string     `gorm:"column:headers"`
+	Body         []byte     `gorm:"column:body"`
+	CreatedAt    time.Time  `gorm:"column:created_at"`
+	CompletedAt  *time.Time `gorm:"column:completed_at"`
+}
+
+func (IdempotencyRecord) TableName() string {
+	return "idempotency_keys"
+}
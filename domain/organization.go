@@ -0,0 +1,33 @@
+package domain
+
+import (
+	"context"
+	"regexp"
+)
+
+// DefaultOrganization owns the data created before organizations were introduced and
+// every request that doesn't name one.
+const DefaultOrganization = "default"
+
+var organizationPattern = regexp.MustCompile(`^[a-z0-9][a-z0-9_-]{0,49}$`)
+
+// ValidOrganization reports whether name can be used as an organization: lower-case
+// letters, digits, '-' and '_', at most 50 characters.
+func ValidOrganization(name string) bool {
+	return organizationPattern.MatchString(name)
+}
+
+type organizationKey struct{}
+
+// WithOrganization returns a copy of ctx scoped to the organization.
+func WithOrganization(ctx context.Context, organization string) context.Context {
+	return context.WithValue(ctx, organizationKey{}, organization)
+}
+
+// OrganizationFrom returns the organization ctx is scoped to, or DefaultOrganization.
+func OrganizationFrom(ctx context.Context) string {
+	if organization, ok := ctx.Value(organizationKey{}).(string); ok && organization != "" {
+		return organization
+	}
+	return DefaultOrganization
+}
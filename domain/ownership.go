@@ -0,0 +1,18 @@
+package domain
+
+// OwnershipRule maps a CODEOWNERS-style pattern to its owners. Rules are ordered by
+// Position and, like in CODEOWNERS, the last rule matching a path wins. A rule without
+// owners marks the path as unowned.
+type OwnershipRule struct {
+	Position   int
+	Pattern    string
+	OwnerUsers []string
+	OwnerTeams []string
+}
+
+// OwnedArea is a rule touched by a PR and the reviewer chosen to cover it.
+// ReviewerID is empty when none of the owners could review.
+type OwnedArea struct {
+	Pattern    string
+	ReviewerID string
+}
@@ -4,17 +4,116 @@ import (
 	"time"
 )
 
+// PR priorities. Urgent PRs go to the least loaded and fastest reviewers.
+const (
+	PriorityLow    = "low"
+	PriorityNormal = "normal"
+	PriorityUrgent = "urgent"
+)
+
 type PullRequest struct {
+	Organization      string     `gorm:"column:organization;primaryKey"`
 	ID                string     `gorm:"column:pull_request_id;primaryKey"`
 	Name              string     `gorm:"column:pull_request_name"`
 	AuthorID          string     `gorm:"column:author_id"`
 	Status            string     `gorm:"column:status"`
-	AssignedReviewers []User     `gorm:"many2many:pull_request_reviewers;joinForeignKey:PullRequestID;joinReferences:ReviewerID"`
+	AssignedReviewers []User     `gorm:"-"`
 	CreatedAt         time.Time  `gorm:"column:created_at"`
 	MergedAt          *time.Time `gorm:"column:merged_at"`
+	Priority          string     `gorm:"column:priority;default:normal"`
+	DueAt             *time.Time `gorm:"column:due_at"`
+	Tags              []string   `gorm:"-"`
+	ExcludedReviewers []string   `gorm:"-"`
+	Version           int64      `gorm:"column:version;default:1"`
+	PullRequestMetadata
+}
+
+// PullRequestMetadata links a PR to its code. All fields are optional: empty strings
+// and zero sizes mean unknown.
+type PullRequestMetadata struct {
+	Repository   string   `gorm:"column:repository"`
+	SourceBranch string   `gorm:"column:source_branch"`
+	TargetBranch string   `gorm:"column:target_branch"`
+	URL          string   `gorm:"column:url"`
+	Additions    int      `gorm:"column:additions"`
+	Deletions    int      `gorm:"column:deletions"`
+	Labels       []string `gorm:"-"`
+}
+
+// PullRequestMetadataPatch changes the metadata of an existing PR. Nil fields are
+// left as they are; an empty Labels slice removes all labels.
+type PullRequestMetadataPatch struct {
+	Repository   *string
+	SourceBranch *string
+	TargetBranch *string
+	URL          *string
+	Additions    *int
+	Deletions    *int
+	Labels       *[]string
+}
+
+// PullRequestFilter narrows PR listings and stats. Empty fields match everything.
+type PullRequestFilter struct {
+	Repository string
+	Label      string
+	Status     string
 }
 
 type PullRequestReviewer struct {
-	PullRequestID string `gorm:"column:pull_request_id;primaryKey"`
-	ReviewerID    string `gorm:"column:reviewer_id;primaryKey"`
+	Organization  string    `gorm:"column:organization;primaryKey"`
+	PullRequestID string    `gorm:"column:pull_request_id;primaryKey"`
+	ReviewerID    string    `gorm:"column:reviewer_id;primaryKey"`
+	AssignedAt    time.Time `gorm:"column:assigned_at;default:now()"`
+}
+
+// PullRequestDraft is what the author sends when opening a PR.
+type PullRequestDraft struct {
+	ID           string
+	Name         string
+	AuthorID     string
+	ChangedFiles []string
+	Tags         []string
+	// ExcludeReviewers are never assigned to this PR, also on later reassignments.
+	ExcludeReviewers []string
+	// RequestedReviewers are assigned first, before any automatic choice.
+	RequestedReviewers []string
+	Priority           string
+	DueAt              *time.Time
+	Metadata           PullRequestMetadata
+}
+
+// AssignmentReport explains how the reviewers of a new PR were chosen. PairingMissing
+// lists the seniority levels the pairing policy asked for but nobody could cover.
+type AssignmentReport struct {
+	Requested       int
+	Assigned        int
+	CapacityLimited bool
+	External        []string
+	Owners          []OwnedArea
+	PairingMissing  []string
+}
+
+// ReviewDecline records that an assigned reviewer refused a PR. ReplacedBy is nil when
+// nobody could take over.
+type ReviewDecline struct {
+	ID            int64     `gorm:"column:id;primaryKey"`
+	Organization  string    `gorm:"column:organization"`
+	PullRequestID string    `gorm:"column:pull_request_id"`
+	UserID        string    `gorm:"column:user_id"`
+	Reason        string    `gorm:"column:reason"`
+	ReplacedBy    *string   `gorm:"column:replaced_by"`
+	CreatedAt     time.Time `gorm:"column:created_at"`
+}
+
+func (ReviewDecline) TableName() string {
+	return "review_declines"
+}
+
+// ReassignReport names the new reviewer and whether they came from outside the team.
+// PairingBroken is set when the pairing policy applies and the new reviewer's
+// seniority differs from the old one's.
+type ReassignReport struct {
+	ReplacedBy    string
+	External      bool
+	PairingBroken bool
 }
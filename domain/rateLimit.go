@@ -0,0 +1,15 @@
+package domain
+
+import "time"
+
+// RateLimitBucket is the token bucket of one client, or of one client on a route with
+// its own limit. Tokens is what was left at UpdatedAt; the bucket refills from there.
+type RateLimitBucket struct {
+	Key       string    `gorm:"column:key;primaryKey"`
+	Tokens    float64   `gorm:"column:tokens"`
+	UpdatedAt time.Time `gorm:"column:updated_at"`
+}
+
+func (RateLimitBucket) TableName() string {
+	return "rate_limit_buckets"
+}
@@ -0,0 +1,26 @@
+package domain
+
+type Roster struct {
+	Teams []Team
+}
+
+type RosterMove struct {
+	UserID   string `json:"user_id"`
+	FromTeam string `json:"from_team"`
+	ToTeam   string `json:"to_team"`
+}
+
+type RosterDiff struct {
+	TeamsToCreate     []string     `json:"teams_to_create"`
+	UsersToCreate     []User       `json:"users_to_create"`
+	UsersToMove       []RosterMove `json:"users_to_move"`
+	UsersToActivate   []string     `json:"users_to_activate"`
+	UsersToDeactivate []string     `json:"users_to_deactivate"`
+}
+
+type ImportResult struct {
+	DryRun                   bool       `json:"dry_run"`
+	Diff                     RosterDiff `json:"diff"`
+	AffectedPRCount          int        `json:"affected_pr_count"`
+	ReassignedReviewersCount int        `json:"reassigned_reviewers_count"`
+}
@@ -1,6 +1,35 @@
 package domain
 
+// Review policies of a team. With ReviewPolicyPairing every PR of the team gets one
+// senior and one junior reviewer.
+const (
+	ReviewPolicyStandard = "standard"
+	ReviewPolicyPairing  = "pairing"
+)
+
 type Team struct {
-	Name    string `gorm:"primaryKey" json:"team_name"`
-	Members []User `gorm:"foreignKey:TeamName;references:Name"`
+	Organization          string     `gorm:"column:organization;primaryKey" json:"-"`
+	Name                  string     `gorm:"primaryKey" json:"team_name"`
+	DefaultMaxOpenReviews *int       `gorm:"column:default_max_open_reviews" json:"default_max_open_reviews,omitempty"`
+	Members               []User     `gorm:"-"`
+	FallbackTeams         []string   `gorm:"-" json:"fallback_teams,omitempty"`
+	ReviewerPools         []string   `gorm:"-" json:"reviewer_pools,omitempty"`
+	ReviewPolicy          string     `gorm:"column:review_policy;default:standard" json:"review_policy,omitempty"`
+	SizeRules             []SizeRule `gorm:"-" json:"size_rules,omitempty"`
+	Version               int64      `gorm:"column:version;default:1" json:"-"`
+}
+
+// SizeRule sets how many reviewers a PR of the team gets once it changes at least
+// MinLines lines (additions plus deletions). The rule with the highest matching
+// MinLines applies.
+type SizeRule struct {
+	MinLines  int `gorm:"column:min_lines"`
+	Reviewers int `gorm:"column:reviewers"`
+}
+
+// ReviewerPool is a named group of users from any team that linked teams may borrow
+// reviewers from.
+type ReviewerPool struct {
+	Name    string
+	UserIDs []string
 }
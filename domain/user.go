@@ -1,8 +1,24 @@
 package domain
 
+// Seniority levels used by the pairing review policy.
+const (
+	SeniorityJunior = "junior"
+	SenioritySenior = "senior"
+)
+
 type User struct {
-	ID       string `gorm:"column:id;primaryKey"`
-	Username string `json:"username"`
-	TeamName string `json:"team_name"`
-	IsActive bool   `json:"isActive"`
+	Organization   string   `gorm:"column:organization;primaryKey" json:"-"`
+	ID             string   `gorm:"column:id;primaryKey"`
+	Username       string   `json:"username"`
+	TeamName       string   `json:"team_name"`
+	IsActive       bool     `json:"isActive"`
+	MaxOpenReviews *int     `gorm:"column:max_open_reviews" json:"max_open_reviews,omitempty"`
+	Skills         []string `gorm:"-" json:"skills,omitempty"`
+	Seniority      string   `gorm:"column:seniority;default:senior" json:"seniority,omitempty"`
+}
+
+type SetIsActiveResult struct {
+	User          User
+	Reassignments []ReviewerReassignment
+	RestoredPRIDs []string
 }
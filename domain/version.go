@@ -0,0 +1,43 @@
+package domain
+
+import (
+	"context"
+	"strconv"
+	"strings"
+)
+
+// FirstVersion is the version of a newly created team or PR.
+const FirstVersion int64 = 1
+
+// ETag renders the version of a team or PR as a strong entity tag.
+func ETag(version int64) string {
+	return `"` + strconv.FormatInt(version, 10) + `"`
+}
+
+type ifMatchKey struct{}
+
+// WithIfMatch returns a copy of ctx carrying the If-Match header of the request. A
+// missing or empty header leaves ctx as it is.
+func WithIfMatch(ctx context.Context, ifMatch *string) context.Context {
+	if ifMatch == nil || strings.TrimSpace(*ifMatch) == "" {
+		return ctx
+	}
+	return context.WithValue(ctx, ifMatchKey{}, *ifMatch)
+}
+
+// CheckVersion returns ErrVersionMismatch when ctx carries an If-Match header that
+// doesn't list the ETag of version. Without the header, or with "*", any version
+// passes.
+func CheckVersion(ctx context.Context, version int64) error {
+	ifMatch, ok := ctx.Value(ifMatchKey{}).(string)
+	if !ok {
+		return nil
+	}
+	for _, tag := range strings.Split(ifMatch, ",") {
+		tag = strings.TrimSpace(tag)
+		if tag == "*" || tag == ETag(version) {
+			return nil
+		}
+	}
+	return ErrVersionMismatch
+}
@@ -1,18 +1,37 @@
 package app
 
 import (
+	"PullRequestService/internal/auditService"
+	"PullRequestService/internal/authService"
+	"PullRequestService/internal/availabilityService"
 	"PullRequestService/internal/db"
 	"PullRequestService/internal/handlers"
+	"PullRequestService/internal/idempotencyService"
+	"PullRequestService/internal/ownershipService"
 	"PullRequestService/internal/pullRequestService"
+	"PullRequestService/internal/rateLimitService"
+	"PullRequestService/internal/rosterService"
 	"PullRequestService/internal/statsService"
 	"PullRequestService/internal/teamService"
 	"PullRequestService/internal/userService"
+	"PullRequestService/internal/validation"
+	"PullRequestService/internal/web/admin"
+	"PullRequestService/internal/web/availability"
+	"PullRequestService/internal/web/ownership"
 	"PullRequestService/internal/web/pullRequests"
 	"PullRequestService/internal/web/stats"
 	"PullRequestService/internal/web/teams"
 	"PullRequestService/internal/web/users"
+	"PullRequestService/openapi"
+	"fmt"
 	echo "github.com/labstack/echo/v4"
 	"github.com/labstack/echo/v4/middleware"
+	strictecho "github.com/oapi-codegen/runtime/strictmiddleware/echo"
+	"gorm.io/gorm"
+	"log"
+	"os"
+	"strconv"
+	"time"
 )
 
 type App struct {
@@ -24,30 +43,162 @@ func New() (*App, error) {
 	if err != nil {
 		return nil, err
 	}
+	return build(dbConn)
+}
 
+// build wires the services, middlewares and handlers around the database connection
+// and reads the rest of the configuration from the environment.
+func build(dbConn *gorm.DB) (*App, error) {
 	e := echo.New()
+	e.HTTPErrorHandler = handlers.ErrorHandler
 
+	e.Use(middleware.RequestID())
 	e.Use(middleware.Logger())
 
+	// JWT_JWKS_FILE or JWT_JWKS_URL lets callers use JWTs from our gateway besides
+	// API tokens.
+	jwtConfig, err := authService.JWTConfigFromEnv()
+	if err != nil {
+		return nil, err
+	}
+	var verifier *authService.JWTVerifier
+	if jwtConfig != nil {
+		verifier = authService.NewJWTVerifier(*jwtConfig)
+	}
+
+	repoAuth := authService.NewAuthRepository(dbConn)
+	serviceAuth := authService.NewAuthService(repoAuth, verifier)
+
+	// Every client gets a token bucket of RATE_LIMIT requests, /stats and
+	// /users/deactivate stricter limits of their own. Every IP also gets RATE_LIMIT_IP
+	// requests, counted before authentication so rejected calls are limited too.
+	// RATE_LIMIT_DISABLED=true turns both off.
+	rateLimitConfig, err := rateLimitService.ConfigFromEnv()
+	if err != nil {
+		return nil, err
+	}
+	var serviceRateLimit rateLimitService.RateLimitService
+	if !rateLimitConfig.Disabled {
+		if rateLimitConfig.TrustProxy {
+			e.IPExtractor = echo.ExtractIPFromXFFHeader()
+		} else {
+			e.IPExtractor = echo.ExtractIPDirect()
+		}
+
+		repoRateLimit := rateLimitService.NewMemoryRepository()
+		if rateLimitConfig.Store == rateLimitService.StorePostgres {
+			repoRateLimit = rateLimitService.NewRateLimitRepository(dbConn)
+		}
+		serviceRateLimit = rateLimitService.NewRateLimitService(repoRateLimit, rateLimitConfig)
+		e.Use(rateLimitService.IPMiddleware(serviceRateLimit))
+		rateLimitService.RunPruning(serviceRateLimit, time.Hour)
+	}
+
+	// Every request needs a bearer token and each operation is checked against the
+	// caller's role. AUTH_INSECURE_DISABLED=true turns this off for local runs only.
+	var strict []strictecho.StrictEchoMiddlewareFunc
+	authDisabled := false
+	if disabled := os.Getenv("AUTH_INSECURE_DISABLED"); disabled != "" {
+		authDisabled, err = strconv.ParseBool(disabled)
+		if err != nil {
+			return nil, fmt.Errorf("invalid AUTH_INSECURE_DISABLED: %w", err)
+		}
+	}
+	if authDisabled {
+		log.Printf("WARNING: authentication is disabled by AUTH_INSECURE_DISABLED, every caller acts without a role check")
+	} else {
+		e.Use(authService.Middleware(serviceAuth))
+		strict = append(strict, authService.Authorize(serviceAuth))
+	}
+
+	if serviceRateLimit != nil {
+		e.Use(rateLimitService.Middleware(serviceRateLimit))
+	}
+
+	// Every request works in one organization, taken from the token or the
+	// X-Organization header.
+	e.Use(authService.Tenant())
+
+	// Requests are checked against openapi/openapi.yaml before they reach the
+	// handlers.
+	validator, err := validation.Middleware(openapi.Spec)
+	if err != nil {
+		return nil, err
+	}
+	e.Use(validator)
+
+	// A POST with an Idempotency-Key runs once; retries within IDEMPOTENCY_TTL (24h
+	// by default) get the stored response.
+	idempotencyTTL := 24 * time.Hour
+	if ttl := os.Getenv("IDEMPOTENCY_TTL"); ttl != "" {
+		idempotencyTTL, err = time.ParseDuration(ttl)
+		if err != nil || idempotencyTTL <= 0 {
+			return nil, fmt.Errorf("invalid IDEMPOTENCY_TTL: %q", ttl)
+		}
+	}
+	repoIdempotency := idempotencyService.NewIdempotencyRepository(dbConn)
+	serviceIdempotency := idempotencyService.NewIdempotencyService(repoIdempotency, idempotencyTTL)
+	e.Use(idempotencyService.Middleware(serviceIdempotency))
+	idempotencyService.RunPruning(serviceIdempotency, time.Hour)
+
+	// Every POST operation is written to the audit log. Audit wraps Authorize, so
+	// forbidden calls are logged too.
+	repoAudit := auditService.NewAuditRepository(dbConn)
+	serviceAudit := auditService.NewAuditService(repoAudit)
+	strict = append(strict, auditService.Middleware(serviceAudit))
+
 	repoTeam := teamService.NewTeamRepository(dbConn)
 	serviceTeam := teamService.NewTeamService(repoTeam)
 	handlerTeam := handlers.NewTeamHandler(serviceTeam)
-	teams.RegisterHandlers(e, teams.NewStrictHandler(handlerTeam, nil))
+	teams.RegisterHandlers(e, teams.NewStrictHandler(handlerTeam, strict))
 
 	repoUser := userService.NewUserRepository(dbConn)
 	serviceUser := userService.NewUserService(repoUser)
 	handlerUser := handlers.NewUserHandler(serviceUser)
-	users.RegisterHandlers(e, users.NewStrictHandler(handlerUser, nil))
+	users.RegisterHandlers(e, users.NewStrictHandler(handlerUser, strict))
 
 	repoPR := pullRequestService.NewPullRequestRepository(dbConn)
 	servicePR := pullRequestService.NewPullRequestService(repoPR)
 	handlerPR := handlers.NewPullRequestHandler(servicePR)
-	pullRequests.RegisterHandlers(e, pullRequests.NewStrictHandler(handlerPR, nil))
+	pullRequests.RegisterHandlers(e, pullRequests.NewStrictHandler(handlerPR, strict))
 
 	repoStat := statsService.NewStatsRepository(dbConn)
 	serviceStat := statsService.NewStatsService(repoStat)
 	handlerStat := handlers.NewStatsHandler(serviceStat)
-	stats.RegisterHandlers(e, stats.NewStrictHandler(handlerStat, nil))
+	stats.RegisterHandlers(e, stats.NewStrictHandler(handlerStat, strict))
+
+	repoRoster := rosterService.NewRosterRepository(dbConn)
+	serviceRoster := rosterService.NewRosterService(repoRoster)
+	handlerAdmin := handlers.NewAdminHandler(serviceRoster, serviceAuth, serviceAudit)
+	admin.RegisterHandlers(e, admin.NewStrictHandler(handlerAdmin, strict))
+
+	repoAvailability := availabilityService.NewAvailabilityRepository(dbConn)
+	serviceAvailability := availabilityService.NewAvailabilityService(repoAvailability)
+	handlerAvailability := handlers.NewAvailabilityHandler(serviceAvailability)
+	availability.RegisterHandlers(e, availability.NewStrictHandler(handlerAvailability, strict))
+
+	repoOwnership := ownershipService.NewOwnershipRepository(dbConn)
+	serviceOwnership := ownershipService.NewOwnershipService(repoOwnership)
+	handlerOwnership := handlers.NewOwnershipHandler(serviceOwnership)
+	ownership.RegisterHandlers(e, ownership.NewStrictHandler(handlerOwnership, strict))
+
+	if interval := os.Getenv("ABSENCE_HANDOFF_INTERVAL"); interval != "" {
+		d, err := time.ParseDuration(interval)
+		if err != nil {
+			return nil, fmt.Errorf("invalid ABSENCE_HANDOFF_INTERVAL: %w", err)
+		}
+		availabilityService.RunHandOffScheduler(serviceAvailability, d)
+	}
+
+	// AUDIT_RETENTION_DAYS limits how long audit entries are kept; without it they
+	// are kept forever.
+	if days := os.Getenv("AUDIT_RETENTION_DAYS"); days != "" {
+		n, err := strconv.Atoi(days)
+		if err != nil || n <= 0 {
+			return nil, fmt.Errorf("invalid AUDIT_RETENTION_DAYS: %q", days)
+		}
+		auditService.RunRetention(serviceAudit, time.Duration(n)*24*time.Hour, time.Hour)
+	}
 
 	return &App{E: e}, nil
 }
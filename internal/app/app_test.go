@@ -0,0 +1,132 @@
+package app
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+)
+
+// newTestApp builds the app with the given environment around a database connection
+// that is never opened. The requests below are all answered before a handler runs.
+func newTestApp(t *testing.T, env map[string]string) *App {
+	t.Helper()
+
+	for _, name := range []string{
+		"AUTH_INSECURE_DISABLED", "RATE_LIMIT_DISABLED", "RATE_LIMIT", "RATE_LIMIT_IP",
+		"RATE_LIMIT_ROUTES", "RATE_LIMIT_STORE", "RATE_LIMIT_TRUST_PROXY",
+		"JWT_JWKS_FILE", "JWT_JWKS_URL", "ABSENCE_HANDOFF_INTERVAL", "AUDIT_RETENTION_DAYS",
+	} {
+		t.Setenv(name, env[name])
+	}
+
+	dbConn, err := gorm.Open(postgres.Open("host=127.0.0.1 port=1 sslmode=disable"), &gorm.Config{
+		DisableAutomaticPing: true,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	a, err := build(dbConn)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return a
+}
+
+// flood sends n unauthenticated requests from one address and returns their statuses.
+func flood(a *App, path string, n int) []int {
+	statuses := make([]int, 0, n)
+	for i := 0; i < n; i++ {
+		req := httptest.NewRequest(http.MethodGet, path, nil)
+		req.RemoteAddr = "203.0.113.7:40000"
+		rec := httptest.NewRecorder()
+		a.E.ServeHTTP(rec, req)
+		statuses = append(statuses, rec.Code)
+	}
+	return statuses
+}
+
+func TestUnauthenticatedFloodIsLimitedBeforeAuth(t *testing.T) {
+	a := newTestApp(t, map[string]string{"RATE_LIMIT_IP": "3/m"})
+
+	got := flood(a, "/team/get?team_name=backend", 5)
+	want := []int{401, 401, 401, 429, 429}
+	if !equal(got, want) {
+		t.Errorf("statuses = %v, want %v", got, want)
+	}
+
+	// Another address has a bucket of its own.
+	req := httptest.NewRequest(http.MethodGet, "/team/get?team_name=backend", nil)
+	req.RemoteAddr = "198.51.100.1:40000"
+	rec := httptest.NewRecorder()
+	a.E.ServeHTTP(rec, req)
+	if rec.Code != http.StatusUnauthorized {
+		t.Errorf("other address got %d, want 401", rec.Code)
+	}
+}
+
+func TestRateLimitOnByDefault(t *testing.T) {
+	a := newTestApp(t, nil)
+
+	req := httptest.NewRequest(http.MethodGet, "/team/get?team_name=backend", nil)
+	rec := httptest.NewRecorder()
+	a.E.ServeHTTP(rec, req)
+	if rec.Code != http.StatusUnauthorized || rec.Header().Get("RateLimit-Limit") != "3000" {
+		t.Errorf("got %d with RateLimit-Limit %q, want 401 counted against the default IP limit of 3000",
+			rec.Code, rec.Header().Get("RateLimit-Limit"))
+	}
+}
+
+func TestRateLimitDisabled(t *testing.T) {
+	a := newTestApp(t, map[string]string{"RATE_LIMIT_DISABLED": "true", "RATE_LIMIT_IP": "1/m"})
+
+	got := flood(a, "/team/get?team_name=backend", 3)
+	if want := []int{401, 401, 401}; !equal(got, want) {
+		t.Errorf("statuses = %v, want %v", got, want)
+	}
+}
+
+func TestAuthInsecureDisabled(t *testing.T) {
+	a := newTestApp(t, map[string]string{"RATE_LIMIT_DISABLED": "true"})
+	if got := flood(a, "/no/such/route", 1); got[0] != http.StatusUnauthorized {
+		t.Errorf("unknown route with authentication = %d, want 401", got[0])
+	}
+
+	a = newTestApp(t, map[string]string{"RATE_LIMIT_DISABLED": "true", "AUTH_INSECURE_DISABLED": "true"})
+	if got := flood(a, "/no/such/route", 1); got[0] != http.StatusNotFound {
+		t.Errorf("unknown route without authentication = %d, want 404", got[0])
+	}
+}
+
+func TestBuildRejectsBadOptOut(t *testing.T) {
+	for name, value := range map[string]string{"AUTH_INSECURE_DISABLED": "maybe", "RATE_LIMIT_DISABLED": "maybe"} {
+		t.Run(name, func(t *testing.T) {
+			t.Setenv(name, value)
+			dbConn, err := gorm.Open(postgres.Open("host=127.0.0.1 port=1 sslmode=disable"), &gorm.Config{
+				DisableAutomaticPing: true,
+			})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if _, err := build(dbConn); err == nil || !strings.Contains(err.Error(), name) {
+				t.Errorf("build error = %v, want one about %s", err, name)
+			}
+		})
+	}
+}
+
+func equal(a, b []int) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
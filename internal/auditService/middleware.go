@@ -0,0 +1,195 @@
+package auditService
+
+import (
+	"PullRequestService/domain"
+	"PullRequestService/internal/strictfields"
+	"encoding/json"
+	"errors"
+	"fmt"
+	echo "github.com/labstack/echo/v4"
+	strictecho "github.com/oapi-codegen/runtime/strictmiddleware/echo"
+	"log"
+	"net/http"
+	"reflect"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+// subject names the request field holding the object an operation changes and the
+// kind of snapshot to take of it.
+type subject struct {
+	field string
+	kind  string
+}
+
+// subjects lists the operations whose object is snapshotted before and after the
+// call. Other POST operations are logged without snapshots.
+var subjects = map[string]subject{
+	"PostTeamAdd":                      {"team_name", snapshotTeam},
+	"PostTeamSetDefaultMaxOpenReviews": {"team_name", snapshotTeam},
+	"PostTeamSetReviewPolicy":          {"team_name", snapshotTeam},
+	"PostTeamSetFallbacks":             {"team_name", snapshotTeam},
+	"PostTeamSetSizeRules":             {"team_name", snapshotTeam},
+	"PostUsersDeactivate":              {"team_name", snapshotTeam},
+
+	"PostUsersSetIsActive":       {"user_id", snapshotUser},
+	"PostUsersSetMaxOpenReviews": {"user_id", snapshotUser},
+	"PostUsersSetSeniority":      {"user_id", snapshotUser},
+
+	"PostPullRequestCreate":          {"pull_request_id", snapshotPR},
+	"PostPullRequestMerge":           {"pull_request_id", snapshotPR},
+	"PostPullRequestUpdate":          {"pull_request_id", snapshotPR},
+	"PostPullRequestReassign":        {"pull_request_id", snapshotPR},
+	"PostPullRequestReviewersAdd":    {"pull_request_id", snapshotPR},
+	"PostPullRequestReviewersRemove": {"pull_request_id", snapshotPR},
+	"PostPullRequestDecline":         {"pull_request_id", snapshotPR},
+}
+
+// Limits of the payload summary.
+const (
+	maxListItems = 20
+	maxString    = 200
+)
+
+// Middleware is a strict-server middleware that writes an audit entry for every POST
+// operation, successful or not. A failure to write the entry is logged and doesn't
+// change the response.
+func Middleware(service AuditService) strictecho.StrictEchoMiddlewareFunc {
+	return func(f strictecho.StrictEchoHandlerFunc, operationID string) strictecho.StrictEchoHandlerFunc {
+		return func(c echo.Context, request interface{}) (interface{}, error) {
+			if c.Request().Method != http.MethodPost {
+				return f(c, request)
+			}
+
+			ctx := c.Request().Context()
+			payload := summarize(request)
+
+			subj, ok := subjects[operationID]
+			id := ""
+			if ok {
+				id, _ = strictfields.String(payload[subj.field])
+			}
+
+			entry := domain.AuditEntry{
+				Actor:     domain.ActorFrom(ctx),
+				RequestID: c.Response().Header().Get(echo.HeaderXRequestID),
+				Operation: operationID,
+			}
+			if id != "" {
+				before, err := service.Snapshot(ctx, subj.kind, id)
+				if err != nil {
+					log.Printf("audit: snapshot %s %s: %v", subj.kind, id, err)
+				}
+				entry.Before = before
+			}
+
+			response, err := f(c, request)
+
+			entry.Status, entry.ErrorCode = responseStatus(operationID, response, err)
+			if id != "" && entry.Status < 400 {
+				after, err := service.Snapshot(ctx, subj.kind, id)
+				if err != nil {
+					log.Printf("audit: snapshot %s %s: %v", subj.kind, id, err)
+				}
+				entry.After = after
+			}
+			if raw, err := json.Marshal(payload); err == nil {
+				entry.Payload = string(raw)
+			}
+
+			if err := service.Record(ctx, entry); err != nil {
+				log.Printf("audit: record %s: %v", operationID, err)
+			}
+			return response, err
+		}
+	}
+}
+
+// responseStatus reads the HTTP status and error code of a strict response. Response
+// types are named after the operation and status, e.g. PostTeamAdd201JSONResponse.
+func responseStatus(operationID string, response interface{}, err error) (int, *string) {
+	if err != nil {
+		var de *domain.Error
+		if errors.As(err, &de) {
+			code := de.Code
+			return de.Status, &code
+		}
+		var he *echo.HTTPError
+		if errors.As(err, &he) {
+			return he.Code, errorCode(he.Message)
+		}
+		code := domain.ErrInternal.Code
+		return http.StatusInternalServerError, &code
+	}
+
+	status := http.StatusOK
+	if t := reflect.TypeOf(response); t != nil {
+		name := strings.TrimPrefix(t.Name(), operationID)
+		if len(name) >= 3 {
+			if code, err := strconv.Atoi(name[:3]); err == nil {
+				status = code
+			}
+		}
+	}
+	if status < 400 {
+		return status, nil
+	}
+	return status, errorCode(response)
+}
+
+// errorCode extracts error.code from a body in the ErrorResponse shape.
+func errorCode(body interface{}) *string {
+	raw, err := json.Marshal(body)
+	if err != nil {
+		return nil
+	}
+	var resp struct {
+		Error struct {
+			Code string `json:"code"`
+		} `json:"error"`
+	}
+	if json.Unmarshal(raw, &resp) != nil || resp.Error.Code == "" {
+		return nil
+	}
+	return &resp.Error.Code
+}
+
+// summarize collects the Params and JSON Body of a strict request object into one
+// map keyed by JSON names. Long lists and strings are shortened; text bodies such as
+// CSV or iCalendar files are left out.
+func summarize(request interface{}) map[string]interface{} {
+	summary := strictfields.Of(request)
+	for key, value := range summary {
+		summary[key] = shorten(value)
+	}
+	return summary
+}
+
+func shorten(value interface{}) interface{} {
+	switch value := value.(type) {
+	case string:
+		if len(value) > maxString {
+			cut := maxString
+			for cut > 0 && !utf8.RuneStart(value[cut]) {
+				cut--
+			}
+			return value[:cut] + "..."
+		}
+		return value
+	case []interface{}:
+		if len(value) > maxListItems {
+			return fmt.Sprintf("<%d items>", len(value))
+		}
+		for i := range value {
+			value[i] = shorten(value[i])
+		}
+		return value
+	case map[string]interface{}:
+		for key := range value {
+			value[key] = shorten(value[key])
+		}
+		return value
+	}
+	return value
+}
@@ -0,0 +1,110 @@
+package auditService
+
+import (
+	"PullRequestService/domain"
+	"database/sql"
+	"errors"
+	"gorm.io/gorm"
+	"time"
+)
+
+// Kinds of objects the audit log snapshots.
+const (
+	snapshotTeam = "team"
+	snapshotUser = "user"
+	snapshotPR   = "pull_request"
+)
+
+// snapshotQueries render the current state of an object of an organization as one
+// JSON value. They take the organization and the object's ID.
+var snapshotQueries = map[string]string{
+	snapshotTeam: `
+		SELECT json_build_object(
+			'team', t,
+			'members', COALESCE((
+				SELECT json_agg(u ORDER BY u.id) FROM users u
+				WHERE u.organization = t.organization AND u.team_name = t.name), '[]'))
+		FROM teams t WHERE t.organization = ? AND t.name = ?`,
+	snapshotUser: `SELECT row_to_json(u) FROM users u WHERE u.organization = ? AND u.id = ?`,
+	snapshotPR: `
+		SELECT json_build_object(
+			'pull_request', p,
+			'reviewers', COALESCE((
+				SELECT json_agg(r.reviewer_id ORDER BY r.reviewer_id)
+				FROM pull_request_reviewers r
+				WHERE r.organization = p.organization AND r.pull_request_id = p.pull_request_id), '[]'))
+		FROM pull_requests p WHERE p.organization = ? AND p.pull_request_id = ?`,
+}
+
+// AuditRepository reads and snapshots within the organization passed as org. Pruning
+// spans all organizations.
+type AuditRepository interface {
+	Append(entry domain.AuditEntry) error
+	List(org string, filter domain.AuditFilter) ([]domain.AuditEntry, error)
+	Prune(before time.Time) (int64, error)
+	Snapshot(org, kind, id string) (*string, error)
+}
+
+type auditRepository struct {
+	db *gorm.DB
+}
+
+func NewAuditRepository(db *gorm.DB) AuditRepository {
+	return &auditRepository{db: db}
+}
+
+func (r *auditRepository) Append(entry domain.AuditEntry) error {
+	return r.db.Omit("id").Create(&entry).Error
+}
+
+// List returns the entries matching the filter, newest first.
+func (r *auditRepository) List(org string, filter domain.AuditFilter) ([]domain.AuditEntry, error) {
+	query := r.db.Model(&domain.AuditEntry{}).Where("organization = ?", org)
+	if filter.Actor != "" {
+		query = query.Where("actor = ?", filter.Actor)
+	}
+	if filter.Operation != "" {
+		query = query.Where("operation = ?", filter.Operation)
+	}
+	if filter.Outcome != "" {
+		query = query.Where("outcome = ?", filter.Outcome)
+	}
+	if filter.RequestID != "" {
+		query = query.Where("request_id = ?", filter.RequestID)
+	}
+	if filter.From != nil {
+		query = query.Where("created_at >= ?", *filter.From)
+	}
+	if filter.To != nil {
+		query = query.Where("created_at < ?", *filter.To)
+	}
+
+	var entries []domain.AuditEntry
+	err := query.Order("created_at DESC, id DESC").
+		Limit(filter.Limit).
+		Offset(filter.Offset).
+		Find(&entries).Error
+	return entries, err
+}
+
+func (r *auditRepository) Prune(before time.Time) (int64, error) {
+	res := r.db.Where("created_at < ?", before).Delete(&domain.AuditEntry{})
+	return res.RowsAffected, res.Error
+}
+
+// Snapshot returns the object as JSON, or nil if it doesn't exist.
+func (r *auditRepository) Snapshot(org, kind, id string) (*string, error) {
+	query, ok := snapshotQueries[kind]
+	if !ok {
+		return nil, nil
+	}
+
+	var snapshot string
+	if err := r.db.Raw(query, org, id).Row().Scan(&snapshot); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &snapshot, nil
+}
@@ -0,0 +1,26 @@
+package auditService
+
+import (
+	"context"
+	"log"
+	"time"
+)
+
+// RunRetention periodically deletes audit entries older than retention.
+func RunRetention(service AuditService, retention, interval time.Duration) {
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		for ; ; <-ticker.C {
+			count, err := service.Prune(context.Background(), retention)
+			if err != nil {
+				log.Printf("audit retention failed: %v", err)
+				continue
+			}
+			if count > 0 {
+				log.Printf("audit retention: %d entries deleted", count)
+			}
+		}
+	}()
+}
@@ -0,0 +1,83 @@
+package auditService
+
+import (
+	"PullRequestService/domain"
+	"context"
+	"time"
+)
+
+type AuditService interface {
+	Record(ctx context.Context, entry domain.AuditEntry) error
+	List(ctx context.Context, filter domain.AuditFilter) ([]domain.AuditEntry, bool, error)
+	Prune(ctx context.Context, retention time.Duration) (int64, error)
+	Snapshot(ctx context.Context, kind, id string) (*string, error)
+}
+
+// ErrInvalidFilter is returned for an audit log query with a bad page or period.
+var ErrInvalidFilter = domain.ErrBadRequest.Msg("invalid filter")
+
+const (
+	defaultPageSize = 50
+	maxPageSize     = 500
+)
+
+type auditService struct {
+	repo AuditRepository
+}
+
+func NewAuditService(repo AuditRepository) AuditService {
+	return &auditService{repo: repo}
+}
+
+// Record appends the entry to the log of the organization of ctx, deriving its
+// outcome from the HTTP status.
+func (s *auditService) Record(ctx context.Context, entry domain.AuditEntry) error {
+	entry.Organization = domain.OrganizationFrom(ctx)
+	entry.CreatedAt = time.Now()
+	switch {
+	case entry.Status >= 500:
+		entry.Outcome = domain.AuditError
+	case entry.Status >= 400:
+		entry.Outcome = domain.AuditRejected
+	default:
+		entry.Outcome = domain.AuditSuccess
+	}
+	return s.repo.Append(entry)
+}
+
+// List returns a page of the log, newest first, and whether more entries follow.
+func (s *auditService) List(ctx context.Context, filter domain.AuditFilter) ([]domain.AuditEntry, bool, error) {
+	if filter.Limit == 0 {
+		filter.Limit = defaultPageSize
+	}
+	if filter.Limit < 0 || filter.Limit > maxPageSize {
+		return nil, false, ErrInvalidFilter.Detailf("limit must be between 1 and %d", maxPageSize)
+	}
+	if filter.Offset < 0 {
+		return nil, false, ErrInvalidFilter.Detailf("offset must not be negative")
+	}
+	if filter.From != nil && filter.To != nil && !filter.From.Before(*filter.To) {
+		return nil, false, ErrInvalidFilter.Detailf("from must be before to")
+	}
+
+	// One extra row tells whether there is a next page.
+	filter.Limit++
+	entries, err := s.repo.List(domain.OrganizationFrom(ctx), filter)
+	if err != nil {
+		return nil, false, err
+	}
+	more := len(entries) == filter.Limit
+	if more {
+		entries = entries[:len(entries)-1]
+	}
+	return entries, more, nil
+}
+
+// Prune deletes entries older than retention and returns how many were deleted.
+func (s *auditService) Prune(ctx context.Context, retention time.Duration) (int64, error) {
+	return s.repo.Prune(time.Now().Add(-retention))
+}
+
+func (s *auditService) Snapshot(ctx context.Context, kind, id string) (*string, error) {
+	return s.repo.Snapshot(domain.OrganizationFrom(ctx), kind, id)
+}
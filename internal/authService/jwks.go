@@ -0,0 +1,209 @@
+package authService
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math/big"
+	"net/http"
+	"os"
+	"sync"
+	"time"
+)
+
+// minRefreshInterval keeps a token with an unknown kid from making the key set
+// reload on every request.
+const minRefreshInterval = 30 * time.Second
+
+// KeySet is a cached JWKS. Keys are loaded on first use and reloaded once the TTL has
+// passed or a token names a key the set doesn't have. If a reload fails the old keys
+// stay in use.
+type KeySet struct {
+	load func() ([]byte, error)
+	ttl  time.Duration
+	now  func() time.Time
+
+	mu       sync.Mutex
+	keys     map[string]crypto.PublicKey
+	loadedAt time.Time
+	triedAt  time.Time
+}
+
+// NewFileKeySet reads the JWKS from a local file, for setups without network access
+// to the identity provider.
+func NewFileKeySet(path string, ttl time.Duration) *KeySet {
+	return newKeySet(func() ([]byte, error) { return os.ReadFile(path) }, ttl)
+}
+
+// NewURLKeySet fetches the JWKS over HTTP.
+func NewURLKeySet(url string, client *http.Client, ttl time.Duration) *KeySet {
+	return newKeySet(func() ([]byte, error) {
+		resp, err := client.Get(url)
+		if err != nil {
+			return nil, err
+		}
+		defer resp.Body.Close()
+		if resp.StatusCode != http.StatusOK {
+			return nil, fmt.Errorf("fetch JWKS: unexpected status %d", resp.StatusCode)
+		}
+		return io.ReadAll(io.LimitReader(resp.Body, 1<<20))
+	}, ttl)
+}
+
+// NewStaticKeySet serves a JWKS document held in memory.
+func NewStaticKeySet(data []byte) *KeySet {
+	return newKeySet(func() ([]byte, error) { return data, nil }, 0)
+}
+
+func newKeySet(load func() ([]byte, error), ttl time.Duration) *KeySet {
+	return &KeySet{load: load, ttl: ttl, now: time.Now}
+}
+
+// Key returns the key with the given kid. A token without kid may use the only key
+// of a single-key set.
+func (ks *KeySet) Key(kid string) (crypto.PublicKey, error) {
+	ks.mu.Lock()
+	defer ks.mu.Unlock()
+
+	now := ks.now()
+	expired := ks.keys == nil || (ks.ttl > 0 && now.Sub(ks.loadedAt) >= ks.ttl)
+	if key, ok := ks.lookup(kid); ok && !expired {
+		return key, nil
+	}
+
+	if ks.keys == nil || now.Sub(ks.triedAt) >= minRefreshInterval {
+		ks.triedAt = now
+		if err := ks.reload(now); err != nil && ks.keys == nil {
+			return nil, err
+		}
+	}
+
+	if key, ok := ks.lookup(kid); ok {
+		return key, nil
+	}
+	return nil, ErrUnauthorized.Detailf("unknown signing key %q", kid)
+}
+
+func (ks *KeySet) lookup(kid string) (crypto.PublicKey, bool) {
+	if key, ok := ks.keys[kid]; ok {
+		return key, true
+	}
+	if kid == "" && len(ks.keys) == 1 {
+		for _, key := range ks.keys {
+			return key, true
+		}
+	}
+	return nil, false
+}
+
+func (ks *KeySet) reload(now time.Time) error {
+	data, err := ks.load()
+	if err != nil {
+		return err
+	}
+	keys, err := parseJWKS(data)
+	if err != nil {
+		return err
+	}
+	ks.keys = keys
+	ks.loadedAt = now
+	return nil
+}
+
+type jwk struct {
+	Kty string `json:"kty"`
+	Kid string `json:"kid"`
+	Use string `json:"use"`
+	Alg string `json:"alg"`
+	N   string `json:"n"`
+	E   string `json:"e"`
+	Crv string `json:"crv"`
+	X   string `json:"x"`
+	Y   string `json:"y"`
+}
+
+// parseJWKS reads the RSA and P-256 signing keys of a JWKS document. Keys of other
+// types or meant for encryption are skipped.
+func parseJWKS(data []byte) (map[string]crypto.PublicKey, error) {
+	var doc struct {
+		Keys []jwk `json:"keys"`
+	}
+	if err := json.Unmarshal(data, &doc); err != nil {
+		return nil, fmt.Errorf("parse JWKS: %w", err)
+	}
+
+	keys := make(map[string]crypto.PublicKey, len(doc.Keys))
+	for _, k := range doc.Keys {
+		if k.Use != "" && k.Use != "sig" {
+			continue
+		}
+
+		var (
+			key crypto.PublicKey
+			err error
+		)
+		switch k.Kty {
+		case "RSA":
+			key, err = rsaKey(k)
+		case "EC":
+			if k.Crv != "P-256" {
+				continue
+			}
+			key, err = ecKey(k)
+		default:
+			continue
+		}
+		if err != nil {
+			return nil, fmt.Errorf("parse JWKS key %q: %w", k.Kid, err)
+		}
+		keys[k.Kid] = key
+	}
+	return keys, nil
+}
+
+func rsaKey(k jwk) (*rsa.PublicKey, error) {
+	n, err := decodeBigInt(k.N)
+	if err != nil {
+		return nil, err
+	}
+	e, err := decodeBigInt(k.E)
+	if err != nil {
+		return nil, err
+	}
+	if !e.IsInt64() || e.Int64() < 3 || e.Int64() > 1<<31-1 {
+		return nil, fmt.Errorf("bad RSA exponent")
+	}
+	return &rsa.PublicKey{N: n, E: int(e.Int64())}, nil
+}
+
+func ecKey(k jwk) (*ecdsa.PublicKey, error) {
+	x, err := decodeBigInt(k.X)
+	if err != nil {
+		return nil, err
+	}
+	y, err := decodeBigInt(k.Y)
+	if err != nil {
+		return nil, err
+	}
+	curve := elliptic.P256()
+	if !curve.IsOnCurve(x, y) {
+		return nil, fmt.Errorf("point is not on P-256")
+	}
+	return &ecdsa.PublicKey{Curve: curve, X: x, Y: y}, nil
+}
+
+func decodeBigInt(s string) (*big.Int, error) {
+	raw, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return nil, err
+	}
+	if len(raw) == 0 {
+		return nil, fmt.Errorf("empty value")
+	}
+	return new(big.Int).SetBytes(raw), nil
+}
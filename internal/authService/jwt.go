@@ -0,0 +1,309 @@
+package authService
+
+import (
+	"PullRequestService/domain"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"net/http"
+	"os"
+	"strings"
+	"time"
+)
+
+// JWTConfig says where the signing keys of bearer JWTs come from, which tokens are
+// accepted and how their claims map to our users and roles.
+type JWTConfig struct {
+	JWKSFile string
+	JWKSURL  string
+	CacheTTL time.Duration
+	Issuer   string
+	Audience string
+	Leeway   time.Duration
+
+	UserClaim string
+	RoleClaim string
+	TeamClaim string
+	OrgClaim  string
+	// Roles maps role claim values to our roles. Values missing here are taken as
+	// they are when they name one of our roles.
+	Roles map[string]string
+}
+
+// JWTConfigFromEnv reads the JWT_* variables. It returns nil when neither
+// JWT_JWKS_FILE nor JWT_JWKS_URL is set, so only API tokens are accepted.
+func JWTConfigFromEnv() (*JWTConfig, error) {
+	cfg := &JWTConfig{
+		JWKSFile:  os.Getenv("JWT_JWKS_FILE"),
+		JWKSURL:   os.Getenv("JWT_JWKS_URL"),
+		CacheTTL:  10 * time.Minute,
+		Issuer:    os.Getenv("JWT_ISSUER"),
+		Audience:  os.Getenv("JWT_AUDIENCE"),
+		Leeway:    30 * time.Second,
+		UserClaim: envOr("JWT_USER_CLAIM", "sub"),
+		RoleClaim: envOr("JWT_ROLE_CLAIM", "role"),
+		TeamClaim: envOr("JWT_TEAM_CLAIM", "team"),
+		OrgClaim:  envOr("JWT_ORG_CLAIM", "org"),
+		Roles:     map[string]string{},
+	}
+	if cfg.JWKSFile == "" && cfg.JWKSURL == "" {
+		return nil, nil
+	}
+	if cfg.JWKSFile != "" && cfg.JWKSURL != "" {
+		return nil, fmt.Errorf("set only one of JWT_JWKS_FILE and JWT_JWKS_URL")
+	}
+
+	if ttl := os.Getenv("JWT_JWKS_CACHE_TTL"); ttl != "" {
+		d, err := time.ParseDuration(ttl)
+		if err != nil {
+			return nil, fmt.Errorf("invalid JWT_JWKS_CACHE_TTL: %w", err)
+		}
+		cfg.CacheTTL = d
+	}
+
+	// JWT_ROLE_MAP looks like "platform-admin=admin,lead=team-lead".
+	if roles := os.Getenv("JWT_ROLE_MAP"); roles != "" {
+		for _, pair := range strings.Split(roles, ",") {
+			from, to, ok := strings.Cut(strings.TrimSpace(pair), "=")
+			if !ok || !isRole(to) {
+				return nil, fmt.Errorf("invalid JWT_ROLE_MAP entry %q", pair)
+			}
+			cfg.Roles[from] = to
+		}
+	}
+	return cfg, nil
+}
+
+func envOr(key, fallback string) string {
+	if v := os.Getenv(key); v != "" {
+		return v
+	}
+	return fallback
+}
+
+// JWTVerifier checks RS256 and ES256 signed JWTs against a JWKS.
+type JWTVerifier struct {
+	cfg  JWTConfig
+	keys *KeySet
+	now  func() time.Time
+}
+
+// NewJWTVerifier builds a verifier over the key set the config points at.
+func NewJWTVerifier(cfg JWTConfig) *JWTVerifier {
+	var keys *KeySet
+	if cfg.JWKSFile != "" {
+		keys = NewFileKeySet(cfg.JWKSFile, cfg.CacheTTL)
+	} else {
+		keys = NewURLKeySet(cfg.JWKSURL, &http.Client{Timeout: 10 * time.Second}, cfg.CacheTTL)
+	}
+	return NewJWTVerifierWithKeys(cfg, keys)
+}
+
+// NewJWTVerifierWithKeys builds a verifier over an existing key set.
+func NewJWTVerifierWithKeys(cfg JWTConfig, keys *KeySet) *JWTVerifier {
+	return &JWTVerifier{cfg: cfg, keys: keys, now: time.Now}
+}
+
+// jwtClaims is what we read from a verified token.
+type jwtClaims struct {
+	UserID       string
+	Role         string
+	TeamName     string
+	Organization string
+}
+
+// looksLikeJWT tells a compact JWT apart from an API token secret.
+func looksLikeJWT(token string) bool {
+	return strings.Count(token, ".") == 2
+}
+
+// Verify checks the signature and the registered claims of a compact JWT and maps its
+// claims. Every failure wraps ErrUnauthorized.
+func (v *JWTVerifier) Verify(token string) (jwtClaims, error) {
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		return jwtClaims{}, ErrUnauthorized.Detailf("malformed token")
+	}
+
+	var header struct {
+		Alg string `json:"alg"`
+		Kid string `json:"kid"`
+	}
+	if err := decodeSegment(parts[0], &header); err != nil {
+		return jwtClaims{}, ErrUnauthorized.Detailf("malformed header")
+	}
+	if header.Alg != "RS256" && header.Alg != "ES256" {
+		return jwtClaims{}, ErrUnauthorized.Detailf("unsupported algorithm %q", header.Alg)
+	}
+	signature, err := base64.RawURLEncoding.DecodeString(parts[2])
+	if err != nil {
+		return jwtClaims{}, ErrUnauthorized.Detailf("malformed signature")
+	}
+
+	key, err := v.keys.Key(header.Kid)
+	if err != nil {
+		return jwtClaims{}, err
+	}
+	if err := verifySignature(header.Alg, key, parts[0]+"."+parts[1], signature); err != nil {
+		return jwtClaims{}, err
+	}
+
+	claims := map[string]interface{}{}
+	if err := decodeSegment(parts[1], &claims); err != nil {
+		return jwtClaims{}, ErrUnauthorized.Detailf("malformed claims")
+	}
+	if err := v.checkRegistered(claims); err != nil {
+		return jwtClaims{}, err
+	}
+	return v.mapClaims(claims)
+}
+
+func verifySignature(alg string, key crypto.PublicKey, signed string, signature []byte) error {
+	digest := sha256.Sum256([]byte(signed))
+
+	switch alg {
+	case "RS256":
+		pub, ok := key.(*rsa.PublicKey)
+		if !ok {
+			return ErrUnauthorized.Detailf("key does not fit RS256")
+		}
+		if rsa.VerifyPKCS1v15(pub, crypto.SHA256, digest[:], signature) != nil {
+			return ErrUnauthorized.Detailf("bad signature")
+		}
+	case "ES256":
+		pub, ok := key.(*ecdsa.PublicKey)
+		if !ok {
+			return ErrUnauthorized.Detailf("key does not fit ES256")
+		}
+		// JWS carries the ECDSA signature as r and s, 32 bytes each.
+		if len(signature) != 64 {
+			return ErrUnauthorized.Detailf("bad signature")
+		}
+		r := new(big.Int).SetBytes(signature[:32])
+		s := new(big.Int).SetBytes(signature[32:])
+		if !ecdsa.Verify(pub, digest[:], r, s) {
+			return ErrUnauthorized.Detailf("bad signature")
+		}
+	default:
+		return ErrUnauthorized.Detailf("unsupported algorithm %q", alg)
+	}
+	return nil
+}
+
+func (v *JWTVerifier) checkRegistered(claims map[string]interface{}) error {
+	now := v.now()
+
+	exp, ok := numericClaim(claims, "exp")
+	if !ok {
+		return ErrUnauthorized.Detailf("token has no exp")
+	}
+	if !now.Before(exp.Add(v.cfg.Leeway)) {
+		return ErrUnauthorized.Detailf("token expired")
+	}
+	if nbf, ok := numericClaim(claims, "nbf"); ok && now.Add(v.cfg.Leeway).Before(nbf) {
+		return ErrUnauthorized.Detailf("token not valid yet")
+	}
+
+	if v.cfg.Issuer != "" {
+		if iss, _ := claims["iss"].(string); iss != v.cfg.Issuer {
+			return ErrUnauthorized.Detailf("unexpected issuer")
+		}
+	}
+	if v.cfg.Audience != "" && !hasAudience(claims["aud"], v.cfg.Audience) {
+		return ErrUnauthorized.Detailf("unexpected audience")
+	}
+	return nil
+}
+
+// mapClaims reads the user, role, team and organization claims. A role we don't know
+// maps to member, a token without an organization belongs to the default one.
+func (v *JWTVerifier) mapClaims(claims map[string]interface{}) (jwtClaims, error) {
+	userID, _ := claims[v.cfg.UserClaim].(string)
+	if userID == "" {
+		return jwtClaims{}, ErrUnauthorized.Detailf("token has no %s claim", v.cfg.UserClaim)
+	}
+
+	role := ""
+	switch value := claims[v.cfg.RoleClaim].(type) {
+	case string:
+		role = v.mapRole(value)
+	case []interface{}:
+		// With a list of roles the strongest one wins.
+		for _, item := range value {
+			if s, ok := item.(string); ok {
+				role = strongerRole(role, v.mapRole(s))
+			}
+		}
+	}
+	if role == "" {
+		role = domain.RoleMember
+	}
+
+	org, _ := claims[v.cfg.OrgClaim].(string)
+	if org == "" {
+		org = domain.DefaultOrganization
+	}
+	if !domain.ValidOrganization(org) {
+		return jwtClaims{}, ErrUnauthorized.Detailf("invalid %s claim", v.cfg.OrgClaim)
+	}
+
+	team, _ := claims[v.cfg.TeamClaim].(string)
+	return jwtClaims{UserID: userID, Role: role, TeamName: team, Organization: org}, nil
+}
+
+func (v *JWTVerifier) mapRole(value string) string {
+	if role, ok := v.cfg.Roles[value]; ok {
+		return role
+	}
+	if isRole(value) {
+		return value
+	}
+	return ""
+}
+
+func strongerRole(a, b string) string {
+	rank := map[string]int{domain.RoleMember: 1, domain.RoleTeamLead: 2, domain.RoleAdmin: 3}
+	if rank[b] > rank[a] {
+		return b
+	}
+	return a
+}
+
+func isRole(role string) bool {
+	return role == domain.RoleAdmin || role == domain.RoleTeamLead || role == domain.RoleMember
+}
+
+func numericClaim(claims map[string]interface{}, name string) (time.Time, bool) {
+	n, ok := claims[name].(float64)
+	if !ok {
+		return time.Time{}, false
+	}
+	return time.Unix(int64(n), 0), true
+}
+
+func hasAudience(aud interface{}, want string) bool {
+	switch aud := aud.(type) {
+	case string:
+		return aud == want
+	case []interface{}:
+		for _, item := range aud {
+			if item == want {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func decodeSegment(segment string, v interface{}) error {
+	raw, err := base64.RawURLEncoding.DecodeString(segment)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(raw, v)
+}
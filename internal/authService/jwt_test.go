@@ -0,0 +1,183 @@
+package authService
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"math/big"
+	"strings"
+	"testing"
+	"time"
+)
+
+var testNow = time.Date(2025, 12, 1, 12, 0, 0, 0, time.UTC)
+
+type testKeys struct {
+	rsa *rsa.PrivateKey
+	ec  *ecdsa.PrivateKey
+}
+
+func newTestKeys(t *testing.T) testKeys {
+	t.Helper()
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ecKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return testKeys{rsa: rsaKey, ec: ecKey}
+}
+
+// jwks publishes the public halves as kid "rsa" and "ec".
+func (k testKeys) jwks(t *testing.T) []byte {
+	t.Helper()
+	enc := func(b []byte) string { return base64.RawURLEncoding.EncodeToString(b) }
+	doc := map[string]interface{}{
+		"keys": []map[string]string{
+			{
+				"kty": "RSA", "kid": "rsa", "use": "sig",
+				"n": enc(k.rsa.N.Bytes()),
+				"e": enc(big.NewInt(int64(k.rsa.E)).Bytes()),
+			},
+			{
+				"kty": "EC", "kid": "ec", "crv": "P-256",
+				"x": enc(k.ec.X.FillBytes(make([]byte, 32))),
+				"y": enc(k.ec.Y.FillBytes(make([]byte, 32))),
+			},
+		},
+	}
+	data, err := json.Marshal(doc)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return data
+}
+
+// sign builds a compact JWT signed with the RSA key for RS256 and the EC key for
+// ES256, whatever kid the header names.
+func (k testKeys) sign(t *testing.T, alg, kid string, claims map[string]interface{}) string {
+	t.Helper()
+	header, _ := json.Marshal(map[string]string{"alg": alg, "kid": kid, "typ": "JWT"})
+	payload, _ := json.Marshal(claims)
+	signed := base64.RawURLEncoding.EncodeToString(header) + "." + base64.RawURLEncoding.EncodeToString(payload)
+	digest := sha256.Sum256([]byte(signed))
+
+	var signature []byte
+	switch alg {
+	case "RS256":
+		sig, err := rsa.SignPKCS1v15(rand.Reader, k.rsa, crypto.SHA256, digest[:])
+		if err != nil {
+			t.Fatal(err)
+		}
+		signature = sig
+	case "ES256":
+		r, s, err := ecdsa.Sign(rand.Reader, k.ec, digest[:])
+		if err != nil {
+			t.Fatal(err)
+		}
+		signature = append(r.FillBytes(make([]byte, 32)), s.FillBytes(make([]byte, 32))...)
+	default:
+		t.Fatalf("unknown alg %s", alg)
+	}
+	return signed + "." + base64.RawURLEncoding.EncodeToString(signature)
+}
+
+func validClaims() map[string]interface{} {
+	return map[string]interface{}{
+		"sub":  "u1",
+		"iss":  "https://id.example.com",
+		"aud":  "pr-service",
+		"exp":  float64(testNow.Add(time.Hour).Unix()),
+		"nbf":  float64(testNow.Add(-time.Minute).Unix()),
+		"role": "team-lead",
+		"team": "backend",
+		"org":  "acme",
+	}
+}
+
+func TestJWTVerifierVerify(t *testing.T) {
+	keys := newTestKeys(t)
+	verifier := NewJWTVerifierWithKeys(JWTConfig{
+		Issuer:    "https://id.example.com",
+		Audience:  "pr-service",
+		Leeway:    30 * time.Second,
+		UserClaim: "sub",
+		RoleClaim: "role",
+		TeamClaim: "team",
+		OrgClaim:  "org",
+	}, NewStaticKeySet(keys.jwks(t)))
+	verifier.now = func() time.Time { return testNow }
+
+	with := func(name string, value interface{}) map[string]interface{} {
+		claims := validClaims()
+		claims[name] = value
+		return claims
+	}
+
+	tests := []struct {
+		name  string
+		token string
+		ok    bool
+	}{
+		{"valid RS256", keys.sign(t, "RS256", "rsa", validClaims()), true},
+		{"valid ES256", keys.sign(t, "ES256", "ec", validClaims()), true},
+		{"audience list", keys.sign(t, "RS256", "rsa", with("aud", []string{"other", "pr-service"})), true},
+		{"expired", keys.sign(t, "RS256", "rsa", with("exp", float64(testNow.Add(-time.Minute).Unix()))), false},
+		{"expired within leeway", keys.sign(t, "RS256", "rsa", with("exp", float64(testNow.Add(-10*time.Second).Unix()))), true},
+		{"not valid yet", keys.sign(t, "ES256", "ec", with("nbf", float64(testNow.Add(time.Minute).Unix()))), false},
+		{"no exp", keys.sign(t, "RS256", "rsa", with("exp", nil)), false},
+		{"wrong audience", keys.sign(t, "RS256", "rsa", with("aud", "other")), false},
+		{"wrong issuer", keys.sign(t, "ES256", "ec", with("iss", "https://evil.example.com")), false},
+		{"unknown kid", keys.sign(t, "RS256", "missing", validClaims()), false},
+		{"RS256 with EC key", keys.sign(t, "RS256", "ec", validClaims()), false},
+		{"ES256 with RSA key", keys.sign(t, "ES256", "rsa", validClaims()), false},
+		{"unsupported alg", "eyJhbGciOiJIUzI1NiJ9.e30.c2ln", false},
+		{"malformed", "not.a-jwt", false},
+		{"bad RS256 signature", tamper(keys.sign(t, "RS256", "rsa", validClaims())), false},
+		{"bad ES256 signature", tamper(keys.sign(t, "ES256", "ec", validClaims())), false},
+		{"swapped payload", swapPayload(keys.sign(t, "RS256", "rsa", validClaims()), with("role", "admin")), false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			claims, err := verifier.Verify(tt.token)
+			if !tt.ok {
+				if !errors.Is(err, ErrUnauthorized) {
+					t.Fatalf("Verify() error = %v, want ErrUnauthorized", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Verify() error = %v", err)
+			}
+			want := jwtClaims{UserID: "u1", Role: "team-lead", TeamName: "backend", Organization: "acme"}
+			if claims != want {
+				t.Fatalf("Verify() = %+v, want %+v", claims, want)
+			}
+		})
+	}
+}
+
+// tamper flips a bit in the signature.
+func tamper(token string) string {
+	i := strings.LastIndex(token, ".")
+	sig, _ := base64.RawURLEncoding.DecodeString(token[i+1:])
+	sig[len(sig)/2] ^= 1
+	return token[:i+1] + base64.RawURLEncoding.EncodeToString(sig)
+}
+
+// swapPayload keeps the header and signature but replaces the claims.
+func swapPayload(token string, claims map[string]interface{}) string {
+	parts := strings.Split(token, ".")
+	payload, _ := json.Marshal(claims)
+	parts[1] = base64.RawURLEncoding.EncodeToString(payload)
+	return strings.Join(parts, ".")
+}
@@ -0,0 +1,127 @@
+package authService
+
+import (
+	"PullRequestService/domain"
+	"strconv"
+)
+
+// rule says who besides admins may call an operation. subject is the request field
+// naming what the operation acts on: a team, a user, a PR (its author) or an absence
+// (its user). lead lets the lead of the subject's team in, self the subject user.
+type rule struct {
+	anyone  bool
+	subject string
+	lead    bool
+	self    bool
+}
+
+// policy maps operation IDs from the OpenAPI spec to their rules. Operations missing
+// here are admin-only.
+var policy = map[string]rule{
+	"GetTeamGet":         {anyone: true},
+	"GetPoolsGet":        {anyone: true},
+	"GetStats":           {anyone: true},
+	"GetOwnershipRules":  {anyone: true},
+	"GetPullRequestList": {anyone: true},
+	"GetUsersGetReview":  {anyone: true},
+	"GetUsersSkills":     {anyone: true},
+	"GetUsersExclusions": {anyone: true},
+	"GetUsersAbsences":   {anyone: true},
+
+	"PostTeamSetDefaultMaxOpenReviews": {subject: "team_name", lead: true},
+	"PostTeamSetReviewPolicy":          {subject: "team_name", lead: true},
+	"PostTeamSetFallbacks":             {subject: "team_name", lead: true},
+	"PostTeamSetSizeRules":             {subject: "team_name", lead: true},
+	"PostUsersDeactivate":              {subject: "team_name", lead: true},
+
+	"PostUsersSetIsActive":       {subject: "user_id", lead: true},
+	"PostUsersSetMaxOpenReviews": {subject: "user_id", lead: true},
+	"PostUsersSetSeniority":      {subject: "user_id", lead: true},
+	"PostUsersSkillsAdd":         {subject: "user_id", lead: true, self: true},
+	"PostUsersSkillsRemove":      {subject: "user_id", lead: true, self: true},
+	"PostUsersExclusionsAdd":     {subject: "author_id", lead: true},
+	"PostUsersExclusionsDelete":  {subject: "author_id", lead: true},
+	"PostUsersAbsencesAdd":       {subject: "user_id", lead: true, self: true},
+	"PostUsersAbsencesImport":    {subject: "user_id", lead: true, self: true},
+	"PostUsersAbsencesDelete":    {subject: "absence_id", lead: true, self: true},
+
+	"PostPullRequestCreate":          {subject: "author_id", lead: true, self: true},
+	"PostPullRequestMerge":           {subject: "pull_request_id", self: true},
+	"PostPullRequestUpdate":          {subject: "pull_request_id", lead: true, self: true},
+	"PostPullRequestReassign":        {subject: "pull_request_id", lead: true, self: true},
+	"PostPullRequestReviewersAdd":    {subject: "pull_request_id", lead: true, self: true},
+	"PostPullRequestReviewersRemove": {subject: "pull_request_id", lead: true, self: true},
+	"PostPullRequestDecline":         {subject: "user_id", lead: true, self: true},
+}
+
+// Allowed checks the identity against the rule of the operation. fields holds the
+// request's parameters and top-level body fields. A subject that doesn't exist only
+// lets admins through.
+func (s *authService) Allowed(identity domain.Identity, operationID string, fields map[string]string) (bool, error) {
+	if identity.Role == domain.RoleAdmin {
+		return true, nil
+	}
+
+	r, ok := policy[operationID]
+	if !ok {
+		return false, nil
+	}
+	if r.anyone {
+		return true, nil
+	}
+
+	userID, teamName, found, err := s.resolveSubject(identity.Organization, r.subject, fields[r.subject])
+	if err != nil || !found {
+		return false, err
+	}
+
+	if r.self && userID != "" && identity.UserID == userID {
+		return true, nil
+	}
+	if r.lead && identity.Role == domain.RoleTeamLead && teamName != "" && identity.TeamName == teamName {
+		return true, nil
+	}
+	return false, nil
+}
+
+// resolveSubject turns a subject field into the user it is about (if any) and the
+// team that user or the subject itself belongs to, within the caller's organization.
+func (s *authService) resolveSubject(org, field, value string) (string, string, bool, error) {
+	if value == "" {
+		return "", "", false, nil
+	}
+
+	var (
+		userID string
+		found  bool
+		err    error
+	)
+	switch field {
+	case "team_name":
+		return "", value, true, nil
+	case "user_id", "author_id":
+		userID = value
+	case "pull_request_id":
+		userID, found, err = s.repo.GetPRAuthor(org, value)
+		if err != nil || !found {
+			return "", "", false, err
+		}
+	case "absence_id":
+		id, parseErr := strconv.ParseInt(value, 10, 64)
+		if parseErr != nil {
+			return "", "", false, nil
+		}
+		userID, found, err = s.repo.GetAbsenceUser(org, id)
+		if err != nil || !found {
+			return "", "", false, err
+		}
+	default:
+		return "", "", false, nil
+	}
+
+	teamName, found, err := s.repo.GetUserTeam(org, userID)
+	if err != nil || !found {
+		return "", "", false, err
+	}
+	return userID, teamName, true, nil
+}
@@ -0,0 +1,104 @@
+package authService
+
+import (
+	"PullRequestService/domain"
+	"errors"
+	"gorm.io/gorm"
+	"time"
+)
+
+// AuthRepository looks tokens up by their hash across organizations; everything else
+// works within the organization passed as org.
+type AuthRepository interface {
+	CreateToken(token domain.APIToken) (domain.APIToken, error)
+	GetTokenByHash(hash string) (domain.APIToken, error)
+	ListTokens(org string) ([]domain.APIToken, error)
+	RevokeToken(org string, id int64, at time.Time) (bool, error)
+	TouchToken(id int64, at time.Time) error
+	UserExists(org, userID string) (bool, error)
+	TeamExists(org, teamName string) (bool, error)
+	GetUserTeam(org, userID string) (string, bool, error)
+	GetPRAuthor(org, prID string) (string, bool, error)
+	GetAbsenceUser(org string, absenceID int64) (string, bool, error)
+}
+
+type authRepository struct {
+	db *gorm.DB
+}
+
+func NewAuthRepository(db *gorm.DB) AuthRepository {
+	return &authRepository{db: db}
+}
+
+func (r *authRepository) CreateToken(token domain.APIToken) (domain.APIToken, error) {
+	err := r.db.Omit("id").Create(&token).Error
+	return token, err
+}
+
+func (r *authRepository) GetTokenByHash(hash string) (domain.APIToken, error) {
+	var token domain.APIToken
+	err := r.db.First(&token, "token_hash = ?", hash).Error
+	return token, err
+}
+
+func (r *authRepository) ListTokens(org string) ([]domain.APIToken, error) {
+	var tokens []domain.APIToken
+	err := r.db.Where("organization = ?", org).Order("id").Find(&tokens).Error
+	return tokens, err
+}
+
+func (r *authRepository) RevokeToken(org string, id int64, at time.Time) (bool, error) {
+	res := r.db.Model(&domain.APIToken{}).
+		Where("organization = ? AND id = ? AND revoked_at IS NULL", org, id).
+		Update("revoked_at", at)
+	return res.RowsAffected > 0, res.Error
+}
+
+// touchInterval limits how often last_used_at is written for a busy token.
+const touchInterval = time.Minute
+
+func (r *authRepository) TouchToken(id int64, at time.Time) error {
+	return r.db.Model(&domain.APIToken{}).
+		Where("id = ? AND (last_used_at IS NULL OR last_used_at < ?)", id, at.Add(-touchInterval)).
+		Update("last_used_at", at).Error
+}
+
+func (r *authRepository) UserExists(org, userID string) (bool, error) {
+	var count int64
+	err := r.db.Model(&domain.User{}).Where("organization = ? AND id = ?", org, userID).Count(&count).Error
+	return count > 0, err
+}
+
+func (r *authRepository) TeamExists(org, teamName string) (bool, error) {
+	var count int64
+	err := r.db.Model(&domain.Team{}).Where("organization = ? AND name = ?", org, teamName).Count(&count).Error
+	return count > 0, err
+}
+
+func (r *authRepository) GetUserTeam(org, userID string) (string, bool, error) {
+	var user domain.User
+	err := r.db.Select("team_name").First(&user, "organization = ? AND id = ?", org, userID).Error
+	return found(user.TeamName, err)
+}
+
+func (r *authRepository) GetPRAuthor(org, prID string) (string, bool, error) {
+	var pr domain.PullRequest
+	err := r.db.Select("author_id").First(&pr, "organization = ? AND pull_request_id = ?", org, prID).Error
+	return found(pr.AuthorID, err)
+}
+
+func (r *authRepository) GetAbsenceUser(org string, absenceID int64) (string, bool, error) {
+	var absence domain.Absence
+	err := r.db.Select("user_id").First(&absence, "organization = ? AND id = ?", org, absenceID).Error
+	return found(absence.UserID, err)
+}
+
+func found(value string, err error) (string, bool, error) {
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return "", false, nil
+	}
+	if err != nil {
+		return "", false, err
+	}
+	return value, true, nil
+}
@@ -0,0 +1,198 @@
+package authService
+
+import (
+	"PullRequestService/domain"
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"gorm.io/gorm"
+	"strings"
+	"time"
+)
+
+type AuthService interface {
+	IssueToken(ctx context.Context, token domain.APIToken) (string, domain.APIToken, error)
+	Authenticate(secret string) (domain.Identity, error)
+	ListTokens(ctx context.Context) ([]domain.APIToken, error)
+	RevokeToken(ctx context.Context, id int64) error
+	Allowed(identity domain.Identity, operationID string, fields map[string]string) (bool, error)
+}
+
+var (
+	// ErrUnauthorized is returned when a request carries no valid token.
+	ErrUnauthorized = domain.ErrUnauthorized.Msg("invalid or revoked token")
+	// ErrInvalidToken is returned when a token to issue has an unknown role or is not
+	// bound to the user or team its role needs.
+	ErrInvalidToken = domain.ErrBadRequest.Msg("invalid token")
+)
+
+// tokenPrefix marks secrets issued by this service, so they are easy to spot in
+// configs and logs.
+const tokenPrefix = "prs_"
+
+type authService struct {
+	repo AuthRepository
+	jwt  *JWTVerifier
+}
+
+// NewAuthService builds the service. jwt may be nil, then only API tokens are
+// accepted.
+func NewAuthService(repo AuthRepository, jwt *JWTVerifier) AuthService {
+	return &authService{repo: repo, jwt: jwt}
+}
+
+// IssueToken creates a token in the organization of ctx and returns its secret. The
+// secret is not stored and can't be shown again.
+func (s *authService) IssueToken(ctx context.Context, token domain.APIToken) (string, domain.APIToken, error) {
+	token.Organization = domain.OrganizationFrom(ctx)
+	if err := s.checkBinding(token); err != nil {
+		return "", domain.APIToken{}, err
+	}
+
+	raw := make([]byte, 32)
+	if _, err := rand.Read(raw); err != nil {
+		return "", domain.APIToken{}, err
+	}
+	secret := tokenPrefix + hex.EncodeToString(raw)
+
+	token.ID = 0
+	token.TokenHash = hashToken(secret)
+	token.CreatedAt = time.Now()
+	token.LastUsedAt = nil
+	token.RevokedAt = nil
+
+	created, err := s.repo.CreateToken(token)
+	if err != nil {
+		return "", domain.APIToken{}, err
+	}
+	return secret, created, nil
+}
+
+func (s *authService) checkBinding(token domain.APIToken) error {
+	if strings.TrimSpace(token.Name) == "" {
+		return ErrInvalidToken.Detailf("name is required")
+	}
+
+	switch token.Role {
+	case domain.RoleAdmin:
+	case domain.RoleTeamLead:
+		if token.TeamName == nil {
+			return ErrInvalidToken.Detailf("a team-lead token needs team_name")
+		}
+	case domain.RoleMember:
+		if token.UserID == nil {
+			return ErrInvalidToken.Detailf("a member token needs user_id")
+		}
+	default:
+		return ErrInvalidToken.Detailf("role must be admin, team-lead or member")
+	}
+
+	if token.UserID != nil {
+		ok, err := s.repo.UserExists(token.Organization, *token.UserID)
+		if err != nil {
+			return err
+		}
+		if !ok {
+			return ErrInvalidToken.Detailf("user %s not found", *token.UserID)
+		}
+	}
+	if token.TeamName != nil {
+		ok, err := s.repo.TeamExists(token.Organization, *token.TeamName)
+		if err != nil {
+			return err
+		}
+		if !ok {
+			return ErrInvalidToken.Detailf("team %s not found", *token.TeamName)
+		}
+	}
+	return nil
+}
+
+// Authenticate resolves a bearer secret: an API token issued by IssueToken or, when
+// JWT validation is configured, a JWT.
+func (s *authService) Authenticate(secret string) (domain.Identity, error) {
+	if s.jwt != nil && looksLikeJWT(secret) {
+		return s.authenticateJWT(secret)
+	}
+	if !strings.HasPrefix(secret, tokenPrefix) {
+		return domain.Identity{}, ErrUnauthorized
+	}
+
+	token, err := s.repo.GetTokenByHash(hashToken(secret))
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return domain.Identity{}, ErrUnauthorized
+		}
+		return domain.Identity{}, err
+	}
+	if token.RevokedAt != nil {
+		return domain.Identity{}, ErrUnauthorized
+	}
+
+	if err := s.repo.TouchToken(token.ID, time.Now()); err != nil {
+		return domain.Identity{}, err
+	}
+
+	identity := domain.Identity{TokenID: token.ID, Organization: token.Organization, Role: token.Role}
+	if token.UserID != nil {
+		identity.UserID = *token.UserID
+	}
+	if token.TeamName != nil {
+		identity.TeamName = *token.TeamName
+	}
+	return identity, nil
+}
+
+// authenticateJWT maps a verified JWT to an identity. Members and team leads must be
+// known users; a team lead without a team claim leads the user's own team. Admins
+// may come from outside the users table.
+func (s *authService) authenticateJWT(token string) (domain.Identity, error) {
+	claims, err := s.jwt.Verify(token)
+	if err != nil {
+		return domain.Identity{}, err
+	}
+
+	identity := domain.Identity{
+		Organization: claims.Organization,
+		Role:         claims.Role,
+		UserID:       claims.UserID,
+		TeamName:     claims.TeamName,
+	}
+	if identity.Role == domain.RoleAdmin {
+		return identity, nil
+	}
+
+	team, found, err := s.repo.GetUserTeam(claims.Organization, claims.UserID)
+	if err != nil {
+		return domain.Identity{}, err
+	}
+	if !found {
+		return domain.Identity{}, ErrUnauthorized.Detailf("user %s not found", claims.UserID)
+	}
+	if identity.Role == domain.RoleTeamLead && identity.TeamName == "" {
+		identity.TeamName = team
+	}
+	return identity, nil
+}
+
+func (s *authService) ListTokens(ctx context.Context) ([]domain.APIToken, error) {
+	return s.repo.ListTokens(domain.OrganizationFrom(ctx))
+}
+
+func (s *authService) RevokeToken(ctx context.Context, id int64) error {
+	found, err := s.repo.RevokeToken(domain.OrganizationFrom(ctx), id, time.Now())
+	if err != nil {
+		return err
+	}
+	if !found {
+		return domain.ErrNotFound.Msg("token not found or already revoked")
+	}
+	return nil
+}
+
+func hashToken(secret string) string {
+	sum := sha256.Sum256([]byte(secret))
+	return hex.EncodeToString(sum[:])
+}
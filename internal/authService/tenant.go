@@ -0,0 +1,39 @@
+package authService
+
+import (
+	"PullRequestService/domain"
+	echo "github.com/labstack/echo/v4"
+)
+
+// HeaderOrganization names the organization a request works in.
+const HeaderOrganization = "X-Organization"
+
+// Tenant scopes the request context to an organization. An authenticated caller works
+// in the organization of its token and may repeat it in the X-Organization header but
+// not name another one. Without authentication the header picks the organization,
+// or the default one is used. It must run after Middleware.
+func Tenant() echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			ctx := c.Request().Context()
+			header := c.Request().Header.Get(HeaderOrganization)
+
+			org := header
+			if identity, ok := domain.IdentityFrom(ctx); ok {
+				org = identity.Organization
+				if header != "" && header != org {
+					return domain.ErrForbidden.Msgf("token does not belong to organization %s", header)
+				}
+			}
+			if org == "" {
+				org = domain.DefaultOrganization
+			}
+			if !domain.ValidOrganization(org) {
+				return domain.ErrBadRequest.Msgf("invalid %s header", HeaderOrganization)
+			}
+
+			c.SetRequest(c.Request().WithContext(domain.WithOrganization(ctx, org)))
+			return next(c)
+		}
+	}
+}
@@ -0,0 +1,140 @@
+package availabilityService
+
+import (
+	"PullRequestService/domain"
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+)
+
+// ParseICS turns the VEVENT entries of an iCalendar file into absences. All-day events
+// (VALUE=DATE) start at midnight UTC and DTEND is exclusive, as in RFC 5545.
+func ParseICS(r io.Reader) ([]domain.Absence, error) {
+	lines, err := unfoldICS(r)
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		absences []domain.Absence
+		current  *domain.Absence
+		allDay   bool
+		hasEnd   bool
+	)
+
+	for _, line := range lines {
+		name, params, value, ok := splitICSLine(line)
+		if !ok {
+			continue
+		}
+
+		switch {
+		case name == "BEGIN" && value == "VEVENT":
+			current = &domain.Absence{Source: "ics"}
+			allDay, hasEnd = false, false
+		case name == "END" && value == "VEVENT":
+			if current == nil {
+				return nil, errors.New("END:VEVENT without BEGIN:VEVENT")
+			}
+			if current.StartsAt.IsZero() {
+				return nil, errors.New("VEVENT without DTSTART")
+			}
+			if !hasEnd {
+				if allDay {
+					current.EndsAt = current.StartsAt.AddDate(0, 0, 1)
+				} else {
+					return nil, fmt.Errorf("VEVENT %s without DTEND", current.StartsAt.Format(time.RFC3339))
+				}
+			}
+			absences = append(absences, *current)
+			current = nil
+		case current == nil:
+			continue
+		case name == "DTSTART":
+			current.StartsAt, allDay, err = parseICSTime(params, value)
+			if err != nil {
+				return nil, fmt.Errorf("DTSTART: %w", err)
+			}
+		case name == "DTEND":
+			current.EndsAt, _, err = parseICSTime(params, value)
+			if err != nil {
+				return nil, fmt.Errorf("DTEND: %w", err)
+			}
+			hasEnd = true
+		case name == "SUMMARY":
+			current.Reason = unescapeICSText(value)
+		case name == "UID":
+			uid := value
+			current.ExternalUID = &uid
+		}
+	}
+
+	return absences, nil
+}
+
+// periodUID stands in for the UID of an event that has none, so importing the same
+// event again updates it instead of adding a copy. The migration that filled it in
+// for older imports builds the same string.
+func periodUID(startsAt, endsAt time.Time) string {
+	const layout = "20060102T150405Z"
+	return "period:" + startsAt.UTC().Format(layout) + "/" + endsAt.UTC().Format(layout)
+}
+
+func unfoldICS(r io.Reader) ([]string, error) {
+	var lines []string
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		line := strings.TrimRight(scanner.Text(), "\r")
+		if (strings.HasPrefix(line, " ") || strings.HasPrefix(line, "\t")) && len(lines) > 0 {
+			lines[len(lines)-1] += line[1:]
+			continue
+		}
+		lines = append(lines, line)
+	}
+	return lines, scanner.Err()
+}
+
+func splitICSLine(line string) (name string, params map[string]string, value string, ok bool) {
+	colon := strings.Index(line, ":")
+	if colon < 0 {
+		return "", nil, "", false
+	}
+
+	parts := strings.Split(line[:colon], ";")
+	params = make(map[string]string, len(parts)-1)
+	for _, p := range parts[1:] {
+		if k, v, found := strings.Cut(p, "="); found {
+			params[strings.ToUpper(k)] = strings.Trim(v, `"`)
+		}
+	}
+
+	return strings.ToUpper(parts[0]), params, strings.TrimSpace(line[colon+1:]), true
+}
+
+func parseICSTime(params map[string]string, value string) (time.Time, bool, error) {
+	if params["VALUE"] == "DATE" || len(value) == len("20060102") {
+		t, err := time.ParseInLocation("20060102", value, time.UTC)
+		return t, true, err
+	}
+
+	if strings.HasSuffix(value, "Z") {
+		t, err := time.Parse("20060102T150405Z", value)
+		return t, false, err
+	}
+
+	loc := time.UTC
+	if tzid, ok := params["TZID"]; ok {
+		if l, err := time.LoadLocation(tzid); err == nil {
+			loc = l
+		}
+	}
+	t, err := time.ParseInLocation("20060102T150405", value, loc)
+	return t, false, err
+}
+
+func unescapeICSText(s string) string {
+	return strings.NewReplacer(`\n`, " ", `\N`, " ", `\,`, ",", `\;`, ";", `\\`, `\`).Replace(s)
+}
@@ -0,0 +1,138 @@
+package availabilityService
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestParseICS(t *testing.T) {
+	berlin, err := time.LoadLocation("Europe/Berlin")
+	if err != nil {
+		t.Skipf("no time zone data: %v", err)
+	}
+
+	data := strings.Join([]string{
+		"BEGIN:VCALENDAR",
+		"BEGIN:VEVENT",
+		"UID:trip-1@calendar",
+		"DTSTART:20250310T080000Z",
+		"DTEND:20250312T170000Z",
+		"SUMMARY:Conference\\, Berlin",
+		"END:VEVENT",
+		"BEGIN:VEVENT",
+		"UID:vacation-",
+		" with-a-folded-uid@calendar",
+		"DTSTART;VALUE=DATE:20250401",
+		"SUMMARY:Day ",
+		"\toff",
+		"END:VEVENT",
+		"BEGIN:VEVENT",
+		"DTSTART;TZID=Europe/Berlin:20250701T090000",
+		"DTEND;TZID=\"Europe/Berlin\":20250701T130000",
+		"END:VEVENT",
+		"BEGIN:VEVENT",
+		"DTSTART;VALUE=DATE:20250801",
+		"DTEND;VALUE=DATE:20250805",
+		"END:VEVENT",
+		"END:VCALENDAR",
+	}, "\r\n")
+
+	absences, err := ParseICS(strings.NewReader(data))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	type want struct {
+		uid    string
+		start  time.Time
+		end    time.Time
+		reason string
+	}
+	wants := []want{
+		{"trip-1@calendar", time.Date(2025, 3, 10, 8, 0, 0, 0, time.UTC), time.Date(2025, 3, 12, 17, 0, 0, 0, time.UTC), "Conference, Berlin"},
+		{"vacation-with-a-folded-uid@calendar", time.Date(2025, 4, 1, 0, 0, 0, 0, time.UTC), time.Date(2025, 4, 2, 0, 0, 0, 0, time.UTC), "Day off"},
+		{"", time.Date(2025, 7, 1, 9, 0, 0, 0, berlin), time.Date(2025, 7, 1, 13, 0, 0, 0, berlin), ""},
+		{"", time.Date(2025, 8, 1, 0, 0, 0, 0, time.UTC), time.Date(2025, 8, 5, 0, 0, 0, 0, time.UTC), ""},
+	}
+	if len(absences) != len(wants) {
+		t.Fatalf("got %d absences, want %d", len(absences), len(wants))
+	}
+	for i, w := range wants {
+		a := absences[i]
+		uid := ""
+		if a.ExternalUID != nil {
+			uid = *a.ExternalUID
+		}
+		if uid != w.uid || !a.StartsAt.Equal(w.start) || !a.EndsAt.Equal(w.end) || a.Reason != w.reason || a.Source != "ics" {
+			t.Errorf("absence %d = %s %s..%s %q (%s), want %s %s..%s %q", i,
+				uid, a.StartsAt, a.EndsAt, a.Reason, a.Source, w.uid, w.start, w.end, w.reason)
+		}
+	}
+}
+
+func TestParseICSErrors(t *testing.T) {
+	tests := []struct {
+		name string
+		data string
+		want string
+	}{
+		{"timed event without DTEND", "BEGIN:VEVENT\nDTSTART:20250310T080000Z\nEND:VEVENT\n", "without DTEND"},
+		{"event without DTSTART", "BEGIN:VEVENT\nDTEND:20250310T080000Z\nEND:VEVENT\n", "without DTSTART"},
+		{"END without BEGIN", "END:VEVENT\n", "END:VEVENT without BEGIN:VEVENT"},
+		{"bad DTSTART", "BEGIN:VEVENT\nDTSTART:tomorrow\nEND:VEVENT\n", "DTSTART"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := ParseICS(strings.NewReader(tt.data))
+			if err == nil || !strings.Contains(err.Error(), tt.want) {
+				t.Fatalf("ParseICS error = %v, want %q", err, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseICSTime(t *testing.T) {
+	tests := []struct {
+		name   string
+		params map[string]string
+		value  string
+		want   time.Time
+		allDay bool
+	}{
+		{"UTC suffix", nil, "20250310T080000Z", time.Date(2025, 3, 10, 8, 0, 0, 0, time.UTC), false},
+		{"floating", nil, "20250310T080000", time.Date(2025, 3, 10, 8, 0, 0, 0, time.UTC), false},
+		{"date", map[string]string{"VALUE": "DATE"}, "20250310", time.Date(2025, 3, 10, 0, 0, 0, 0, time.UTC), true},
+		{"date without VALUE", nil, "20250310", time.Date(2025, 3, 10, 0, 0, 0, 0, time.UTC), true},
+		{"unknown TZID", map[string]string{"TZID": "Mars/Olympus"}, "20250310T080000", time.Date(2025, 3, 10, 8, 0, 0, 0, time.UTC), false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, allDay, err := parseICSTime(tt.params, tt.value)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !got.Equal(tt.want) || allDay != tt.allDay {
+				t.Errorf("parseICSTime = %s, %v; want %s, %v", got, allDay, tt.want, tt.allDay)
+			}
+		})
+	}
+}
+
+func TestUnfoldICS(t *testing.T) {
+	lines, err := unfoldICS(strings.NewReader("SUMMARY:a long\r\n  line\r\n\tcontinued\r\nUID:1\r\n"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(lines) != 2 || lines[0] != "SUMMARY:a long linecontinued" || lines[1] != "UID:1" {
+		t.Errorf("unfoldICS = %q", lines)
+	}
+}
+
+func TestPeriodUID(t *testing.T) {
+	cet := time.FixedZone("CET", 3600)
+	got := periodUID(time.Date(2025, 7, 1, 9, 0, 0, 0, cet), time.Date(2025, 7, 1, 13, 30, 5, 0, cet))
+	if want := "period:20250701T080000Z/20250701T123005Z"; got != want {
+		t.Errorf("periodUID = %q, want %q", got, want)
+	}
+}
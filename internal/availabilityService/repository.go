@@ -0,0 +1,106 @@
+package availabilityService
+
+import (
+	"PullRequestService/domain"
+	"PullRequestService/internal/userService"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// AvailabilityRepository works within the organization passed as org. The hand-off
+// methods serve the scheduler and span all organizations, using the absence's own.
+type AvailabilityRepository interface {
+	GetUserByID(org, id string) (domain.User, error)
+	AddAbsence(org string, absence domain.Absence) (domain.Absence, error)
+	UpsertAbsences(org string, absences []domain.Absence) ([]domain.Absence, error)
+	GetAbsences(org, userID string) ([]domain.Absence, error)
+	DeleteAbsence(org string, id int64) (bool, error)
+	GetAbsencesToHandOff(at time.Time) ([]domain.Absence, error)
+	HandOffReviews(absence domain.Absence, teamName, actor string) (*domain.DeactivateResult, error)
+}
+
+type availabilityRepository struct {
+	db *gorm.DB
+}
+
+func NewAvailabilityRepository(db *gorm.DB) AvailabilityRepository {
+	return &availabilityRepository{db: db}
+}
+
+func (r *availabilityRepository) GetUserByID(org, id string) (domain.User, error) {
+	var user domain.User
+	err := r.db.First(&user, "organization = ? AND id = ?", org, id).Error
+	return user, err
+}
+
+func (r *availabilityRepository) AddAbsence(org string, absence domain.Absence) (domain.Absence, error) {
+	absence.Organization = org
+	err := r.db.Omit("id", "created_at").Create(&absence).Error
+	return absence, err
+}
+
+// UpsertAbsences inserts imported absences, updating the ones with a known external UID.
+// Every imported absence must have one: a NULL never conflicts, so it would be added
+// again on every import. An absence that moved to another start is handed off again
+// when the new one comes.
+func (r *availabilityRepository) UpsertAbsences(org string, absences []domain.Absence) ([]domain.Absence, error) {
+	updates := append(clause.AssignmentColumns([]string{"starts_at", "ends_at", "reason", "hand_off_reviews"}),
+		clause.Assignment{
+			Column: clause.Column{Name: "handed_off_at"},
+			Value: gorm.Expr(`CASE WHEN user_absences.starts_at = EXCLUDED.starts_at
+				THEN user_absences.handed_off_at END`),
+		})
+
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		for i := range absences {
+			absences[i].Organization = org
+			if err := tx.Omit("id", "created_at").
+				Clauses(clause.OnConflict{
+					Columns:   []clause.Column{{Name: "organization"}, {Name: "user_id"}, {Name: "external_uid"}},
+					DoUpdates: updates,
+				}).
+				Create(&absences[i]).Error; err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	return absences, err
+}
+
+func (r *availabilityRepository) GetAbsences(org, userID string) ([]domain.Absence, error) {
+	var absences []domain.Absence
+	err := r.db.Where("organization = ? AND user_id = ?", org, userID).Order("starts_at").Find(&absences).Error
+	return absences, err
+}
+
+func (r *availabilityRepository) DeleteAbsence(org string, id int64) (bool, error) {
+	res := r.db.Delete(&domain.Absence{}, "organization = ? AND id = ?", org, id)
+	return res.RowsAffected > 0, res.Error
+}
+
+func (r *availabilityRepository) GetAbsencesToHandOff(at time.Time) ([]domain.Absence, error) {
+	var absences []domain.Absence
+	err := r.db.
+		Where("hand_off_reviews AND handed_off_at IS NULL AND starts_at <= ? AND ends_at > ?", at, at).
+		Order("starts_at").
+		Find(&absences).Error
+	return absences, err
+}
+
+func (r *availabilityRepository) HandOffReviews(absence domain.Absence, teamName, actor string) (*domain.DeactivateResult, error) {
+	var result *domain.DeactivateResult
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		var err error
+		result, err = userService.ReassignReviewsTx(tx, absence.Organization, teamName, []string{absence.UserID}, actor)
+		if err != nil {
+			return err
+		}
+		return tx.Model(&domain.Absence{}).
+			Where("organization = ? AND id = ?", absence.Organization, absence.ID).
+			Update("handed_off_at", gorm.Expr("NOW()")).Error
+	})
+	return result, err
+}
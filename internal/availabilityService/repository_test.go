@@ -0,0 +1,89 @@
+package availabilityService
+
+import (
+	"PullRequestService/domain"
+	"PullRequestService/internal/testdb"
+	"os"
+	"testing"
+	"time"
+)
+
+func TestUpsertAbsencesResetsHandOffWhenMoved(t *testing.T) {
+	db := testdb.Open(t)
+	testdb.Seed(t, db)
+	repo := NewAvailabilityRepository(db)
+
+	uid := "vacation@calendar"
+	start := time.Now().Add(-time.Hour).Truncate(time.Second)
+	absence := domain.Absence{UserID: "u2", Source: "ics", ExternalUID: &uid, HandOffReviews: true,
+		StartsAt: start, EndsAt: start.Add(24 * time.Hour)}
+
+	upsert := func(a domain.Absence) {
+		t.Helper()
+		if _, err := repo.UpsertAbsences(testdb.Acme, []domain.Absence{a}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	handedOff := func() bool {
+		t.Helper()
+		absences, err := repo.GetAbsences(testdb.Acme, "u2")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(absences) != 1 {
+			t.Fatalf("u2 has %d absences, want 1", len(absences))
+		}
+		return absences[0].HandedOffAt != nil
+	}
+
+	upsert(absence)
+	if err := db.Model(&domain.Absence{}).Where("external_uid = ?", uid).
+		Update("handed_off_at", time.Now()).Error; err != nil {
+		t.Fatal(err)
+	}
+
+	absence.Reason = "longer vacation"
+	absence.EndsAt = absence.EndsAt.Add(24 * time.Hour)
+	upsert(absence)
+	if !handedOff() {
+		t.Error("re-import with the same start reset the hand-off")
+	}
+
+	absence.StartsAt = start.Add(48 * time.Hour)
+	absence.EndsAt = absence.StartsAt.Add(24 * time.Hour)
+	upsert(absence)
+	if handedOff() {
+		t.Error("re-import with a new start kept the old hand-off")
+	}
+}
+
+// TestPeriodUIDMigration checks that the migration filling in UIDs for older imports
+// builds the same key as periodUID, so a re-import updates those absences.
+func TestPeriodUIDMigration(t *testing.T) {
+	db := testdb.Open(t)
+	testdb.Seed(t, db)
+
+	migration, err := os.ReadFile("../../migrations/20251215112230_absence_period_uid.up.sql")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	cet := time.FixedZone("CET", 3600)
+	start := time.Date(2025, 7, 1, 9, 0, 0, 0, cet)
+	absence := domain.Absence{Organization: testdb.Acme, UserID: "u2", Source: "ics",
+		StartsAt: start, EndsAt: start.Add(4*time.Hour + 5*time.Second)}
+	if err := db.Omit("id", "created_at").Create(&absence).Error; err != nil {
+		t.Fatal(err)
+	}
+	if err := db.Exec(string(migration)).Error; err != nil {
+		t.Fatal(err)
+	}
+
+	var uid string
+	if err := db.Model(&domain.Absence{}).Where("id = ?", absence.ID).Pluck("external_uid", &uid).Error; err != nil {
+		t.Fatal(err)
+	}
+	if want := periodUID(absence.StartsAt, absence.EndsAt); uid != want {
+		t.Errorf("migration set UID %q, periodUID gives %q", uid, want)
+	}
+}
@@ -0,0 +1,25 @@
+package availabilityService
+
+import (
+	"context"
+	"log"
+	"time"
+)
+
+// RunHandOffScheduler periodically hands off the reviews of users whose absence has started.
+func RunHandOffScheduler(service AvailabilityService, interval time.Duration) {
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		for range ticker.C {
+			count, err := service.HandOffStartedAbsences(context.Background())
+			if err != nil {
+				log.Printf("absence hand-off failed: %v", err)
+			}
+			if count > 0 {
+				log.Printf("absence hand-off: %d reviews reassigned", count)
+			}
+		}
+	}()
+}
@@ -0,0 +1,141 @@
+package availabilityService
+
+import (
+	"PullRequestService/domain"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+var ErrInvalidAbsence = domain.ErrBadRequest.Msg("invalid absence")
+
+type AvailabilityService interface {
+	AddAbsence(ctx context.Context, absence domain.Absence) (domain.Absence, error)
+	GetAbsences(ctx context.Context, userID string) ([]domain.Absence, error)
+	DeleteAbsence(ctx context.Context, id int64) error
+	ImportICS(ctx context.Context, userID string, data io.Reader, handOffReviews bool) ([]domain.Absence, error)
+	HandOffStartedAbsences(ctx context.Context) (int, error)
+}
+
+type availabilityService struct {
+	repo AvailabilityRepository
+}
+
+func NewAvailabilityService(repo AvailabilityRepository) AvailabilityService {
+	return &availabilityService{repo: repo}
+}
+
+func (s *availabilityService) AddAbsence(ctx context.Context, absence domain.Absence) (domain.Absence, error) {
+	org := domain.OrganizationFrom(ctx)
+	if err := s.checkUser(org, absence.UserID); err != nil {
+		return domain.Absence{}, err
+	}
+	if !absence.EndsAt.After(absence.StartsAt) {
+		return domain.Absence{}, ErrInvalidAbsence.Detailf("ends_at must be after starts_at")
+	}
+
+	absence.Source = "api"
+	return s.repo.AddAbsence(org, absence)
+}
+
+func (s *availabilityService) GetAbsences(ctx context.Context, userID string) ([]domain.Absence, error) {
+	org := domain.OrganizationFrom(ctx)
+	if err := s.checkUser(org, userID); err != nil {
+		return nil, err
+	}
+	return s.repo.GetAbsences(org, userID)
+}
+
+func (s *availabilityService) DeleteAbsence(ctx context.Context, id int64) error {
+	org := domain.OrganizationFrom(ctx)
+	deleted, err := s.repo.DeleteAbsence(org, id)
+	if err != nil {
+		return err
+	}
+	if !deleted {
+		return domain.ErrNotFound.Msg("absence not found")
+	}
+	return nil
+}
+
+func (s *availabilityService) ImportICS(ctx context.Context, userID string, data io.Reader, handOffReviews bool) ([]domain.Absence, error) {
+	org := domain.OrganizationFrom(ctx)
+	if err := s.checkUser(org, userID); err != nil {
+		return nil, err
+	}
+
+	absences, err := ParseICS(data)
+	if err != nil {
+		return nil, ErrInvalidAbsence.Detailf("%v", err)
+	}
+
+	for i := range absences {
+		if !absences[i].EndsAt.After(absences[i].StartsAt) {
+			return nil, ErrInvalidAbsence.Detailf("event starting at %s ends before it starts",
+				absences[i].StartsAt.Format(time.RFC3339))
+		}
+		absences[i].UserID = userID
+		absences[i].HandOffReviews = handOffReviews
+		if absences[i].ExternalUID == nil {
+			uid := periodUID(absences[i].StartsAt, absences[i].EndsAt)
+			absences[i].ExternalUID = &uid
+		}
+	}
+
+	if len(absences) == 0 {
+		return []domain.Absence{}, nil
+	}
+
+	return s.repo.UpsertAbsences(org, absences)
+}
+
+// HandOffStartedAbsences reassigns the OPEN reviews of users whose absence has started
+// and asked for a hand-off, in every organization. It returns the number of reassigned
+// reviews. An absence that fails doesn't stop the others: it stays pending for the next
+// run and its error is returned together with the rest.
+func (s *availabilityService) HandOffStartedAbsences(ctx context.Context) (int, error) {
+	absences, err := s.repo.GetAbsencesToHandOff(time.Now())
+	if err != nil {
+		return 0, err
+	}
+
+	reassigned := 0
+	var errs []error
+	for _, a := range absences {
+		count, err := s.handOff(ctx, a)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("absence %d of %s/%s: %w", a.ID, a.Organization, a.UserID, err))
+			continue
+		}
+		reassigned += count
+	}
+
+	return reassigned, errors.Join(errs...)
+}
+
+func (s *availabilityService) handOff(ctx context.Context, a domain.Absence) (int, error) {
+	user, err := s.repo.GetUserByID(a.Organization, a.UserID)
+	if err != nil {
+		return 0, err
+	}
+
+	result, err := s.repo.HandOffReviews(a, user.TeamName, domain.ActorFrom(ctx))
+	if err != nil {
+		return 0, err
+	}
+	return result.ReassignedReviewersCount, nil
+}
+
+func (s *availabilityService) checkUser(org, userID string) error {
+	if _, err := s.repo.GetUserByID(org, userID); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return domain.ErrNotFound.Msg("user not found")
+		}
+		return err
+	}
+	return nil
+}
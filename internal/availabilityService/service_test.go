@@ -0,0 +1,74 @@
+package availabilityService
+
+import (
+	"PullRequestService/domain"
+	"context"
+	"errors"
+	"gorm.io/gorm"
+	"strings"
+	"testing"
+	"time"
+)
+
+// handOffRepo serves the absences due for a hand-off and records which were handed off.
+type handOffRepo struct {
+	AvailabilityRepository
+	absences  []domain.Absence
+	users     map[string]domain.User
+	failing   map[int64]bool
+	handedOff []int64
+}
+
+func (r *handOffRepo) GetAbsencesToHandOff(at time.Time) ([]domain.Absence, error) {
+	return r.absences, nil
+}
+
+func (r *handOffRepo) GetUserByID(org, id string) (domain.User, error) {
+	user, ok := r.users[org+"/"+id]
+	if !ok {
+		return domain.User{}, gorm.ErrRecordNotFound
+	}
+	return user, nil
+}
+
+func (r *handOffRepo) HandOffReviews(absence domain.Absence, teamName, actor string) (*domain.DeactivateResult, error) {
+	if r.failing[absence.ID] {
+		return nil, errors.New("no candidates left")
+	}
+	r.handedOff = append(r.handedOff, absence.ID)
+	return &domain.DeactivateResult{ReassignedReviewersCount: 2}, nil
+}
+
+func TestHandOffStartedAbsencesContinuesPastFailures(t *testing.T) {
+	repo := &handOffRepo{
+		absences: []domain.Absence{
+			{ID: 1, Organization: "acme", UserID: "gone"},
+			{ID: 2, Organization: "acme", UserID: "u1"},
+			{ID: 3, Organization: "globex", UserID: "u1"},
+			{ID: 4, Organization: "globex", UserID: "u2"},
+		},
+		users: map[string]domain.User{
+			"acme/u1":   {ID: "u1", TeamName: "backend"},
+			"globex/u1": {ID: "u1", TeamName: "backend"},
+			"globex/u2": {ID: "u2", TeamName: "backend"},
+		},
+		failing: map[int64]bool{3: true},
+	}
+	s := NewAvailabilityService(repo)
+
+	count, err := s.HandOffStartedAbsences(context.Background())
+	if count != 4 {
+		t.Errorf("reassigned %d reviews, want 4", count)
+	}
+	if len(repo.handedOff) != 2 || repo.handedOff[0] != 2 || repo.handedOff[1] != 4 {
+		t.Errorf("handed off absences %v, want [2 4]", repo.handedOff)
+	}
+	if err == nil {
+		t.Fatal("HandOffStartedAbsences hid the failed absences")
+	}
+	for _, want := range []string{"absence 1 of acme/gone", "absence 3 of globex/u1: no candidates left"} {
+		if !strings.Contains(err.Error(), want) {
+			t.Errorf("error %q does not mention %q", err, want)
+		}
+	}
+}
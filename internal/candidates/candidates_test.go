@@ -0,0 +1,97 @@
+package candidates
+
+import (
+	"PullRequestService/internal/testdb"
+	"reflect"
+	"sort"
+	"testing"
+	"time"
+)
+
+func TestQueriesStayInOrganization(t *testing.T) {
+	db := testdb.Open(t)
+	testdb.Seed(t, db)
+
+	t.Run("Workloads", func(t *testing.T) {
+		limit := func(n int) *int { return &n }
+		tests := []struct {
+			org  string
+			want Workload
+		}{
+			{testdb.Acme, Workload{UserID: "u2", OpenReviews: 1, Load: 2, MaxOpenReviews: limit(5)}},
+			{testdb.Globex, Workload{UserID: "u2", OpenReviews: 0, Load: 0, MaxOpenReviews: limit(1)}},
+		}
+		for _, tt := range tests {
+			got, err := Workloads(db, tt.org, []string{"u2"})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(got) != 1 || !reflect.DeepEqual(got["u2"], tt.want) {
+				t.Errorf("Workloads(%s) = %+v, want only %+v", tt.org, got, tt.want)
+			}
+		}
+	})
+
+	t.Run("Excluded", func(t *testing.T) {
+		tests := []struct {
+			org  string
+			want []string
+		}{
+			{testdb.Acme, []string{"u3"}},
+			{testdb.Globex, []string{"u2"}},
+		}
+		for _, tt := range tests {
+			got, err := Excluded(db, tt.org, []string{"u1"})
+			if err != nil {
+				t.Fatal(err)
+			}
+			var ids []string
+			for id := range got["u1"] {
+				ids = append(ids, id)
+			}
+			sort.Strings(ids)
+			if !reflect.DeepEqual(ids, tt.want) {
+				t.Errorf("Excluded(%s) = %v, want %v", tt.org, ids, tt.want)
+			}
+		}
+	})
+
+	t.Run("ExcludedForPRs", func(t *testing.T) {
+		tests := []struct {
+			org  string
+			want []string
+		}{
+			{testdb.Acme, nil},
+			{testdb.Globex, []string{"u4"}},
+		}
+		for _, tt := range tests {
+			got, err := ExcludedForPRs(db, tt.org, []string{"pr-1"})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(got["pr-1"], tt.want) {
+				t.Errorf("ExcludedForPRs(%s) = %v, want %v", tt.org, got["pr-1"], tt.want)
+			}
+		}
+	})
+
+	t.Run("FallbackTiers", func(t *testing.T) {
+		tests := []struct {
+			org  string
+			want string
+		}{
+			// Acme borrows its frontend team, Globex the oncall pool; both are u4.
+			{testdb.Acme, "acme-u4"},
+			{testdb.Globex, "globex-u4"},
+		}
+		for _, tt := range tests {
+			tiers, err := FallbackTiers(db, tt.org, "backend", time.Now())
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(tiers) != 1 || len(tiers[0]) != 1 || tiers[0][0].Username != tt.want {
+				t.Errorf("FallbackTiers(%s) = %+v, want one tier with %s", tt.org, tiers, tt.want)
+			}
+		}
+	})
+}
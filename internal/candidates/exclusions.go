@@ -0,0 +1,67 @@
+package candidates
+
+import "gorm.io/gorm"
+
+// Excluded returns, for every author, the users that must not review their PRs:
+// reviewers excluded for that author and users with a symmetric exclusion against them.
+func Excluded(db *gorm.DB, org string, authorIDs []string) (map[string]map[string]struct{}, error) {
+	result := make(map[string]map[string]struct{}, len(authorIDs))
+	if len(authorIDs) == 0 {
+		return result, nil
+	}
+
+	var rows []struct {
+		AuthorID   string `gorm:"column:author_id"`
+		ReviewerID string `gorm:"column:reviewer_id"`
+	}
+	err := db.Raw(`
+		SELECT author_id, reviewer_id FROM reviewer_exclusions
+		WHERE organization = ? AND author_id IN ?
+		UNION
+		SELECT reviewer_id, author_id FROM reviewer_exclusions
+		WHERE organization = ? AND symmetric AND reviewer_id IN ?`,
+		org, authorIDs, org, authorIDs).
+		Scan(&rows).Error
+	if err != nil {
+		return nil, err
+	}
+
+	for _, row := range rows {
+		if result[row.AuthorID] == nil {
+			result[row.AuthorID] = make(map[string]struct{})
+		}
+		result[row.AuthorID][row.ReviewerID] = struct{}{}
+	}
+	return result, nil
+}
+
+// ExcludedForPRs returns the reviewers excluded on the PRs themselves, including the
+// ones that declined to review them.
+func ExcludedForPRs(db *gorm.DB, org string, prIDs []string) (map[string][]string, error) {
+	result := make(map[string][]string, len(prIDs))
+	if len(prIDs) == 0 {
+		return result, nil
+	}
+
+	var rows []struct {
+		PRID   string `gorm:"column:pull_request_id"`
+		UserID string `gorm:"column:user_id"`
+	}
+	err := db.Raw(`
+		SELECT pull_request_id, user_id FROM pull_request_excluded_reviewers
+		WHERE organization = ? AND pull_request_id IN ?
+		UNION
+		SELECT pull_request_id, user_id FROM review_declines
+		WHERE organization = ? AND pull_request_id IN ?
+		ORDER BY pull_request_id, user_id`,
+		org, prIDs, org, prIDs).
+		Scan(&rows).Error
+	if err != nil {
+		return nil, err
+	}
+
+	for _, row := range rows {
+		result[row.PRID] = append(result[row.PRID], row.UserID)
+	}
+	return result, nil
+}
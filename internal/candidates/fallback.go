@@ -0,0 +1,53 @@
+package candidates
+
+import (
+	"PullRequestService/domain"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// FallbackTiers returns the reviewers a team may borrow when it can't fill the required
+// reviewer count itself: one group per fallback team in their declared order, then the
+// members of the reviewer pools linked to the team. Only active and available users are
+// returned, members of the team itself are skipped.
+func FallbackTiers(db *gorm.DB, org, teamName string, at time.Time) ([][]domain.User, error) {
+	var fallbackTeams []string
+	if err := db.Table("team_fallbacks").
+		Where("organization = ? AND team_name = ?", org, teamName).
+		Order("position").
+		Pluck("fallback_team_name", &fallbackTeams).Error; err != nil {
+		return nil, err
+	}
+
+	var tiers [][]domain.User
+	for _, name := range fallbackTeams {
+		var members []domain.User
+		if err := db.Scopes(Available(at)).
+			Where("organization = ? AND team_name = ? AND is_active = true", org, name).
+			Find(&members).Error; err != nil {
+			return nil, err
+		}
+		if len(members) > 0 {
+			tiers = append(tiers, members)
+		}
+	}
+
+	var poolMembers []domain.User
+	if err := db.Scopes(Available(at)).
+		Where("organization = ? AND is_active = true AND team_name IS DISTINCT FROM ?", org, teamName).
+		Where(`id IN (
+			SELECT pm.user_id FROM reviewer_pool_members pm
+			JOIN team_reviewer_pools tp
+				ON tp.organization = pm.organization AND tp.pool_name = pm.pool_name
+			WHERE tp.organization = ? AND tp.team_name = ?
+		)`, org, teamName).
+		Find(&poolMembers).Error; err != nil {
+		return nil, err
+	}
+	if len(poolMembers) > 0 {
+		tiers = append(tiers, poolMembers)
+	}
+
+	return tiers, nil
+}
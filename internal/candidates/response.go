@@ -0,0 +1,37 @@
+package candidates
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// ResponseTimes returns how long, on average, each user's reviews stayed open: the
+// time from assignment to merge over the merged PRs they reviewed. Users without
+// merged reviews are missing from the result.
+func ResponseTimes(db *gorm.DB, org string, userIDs []string) (map[string]time.Duration, error) {
+	result := make(map[string]time.Duration, len(userIDs))
+	if len(userIDs) == 0 {
+		return result, nil
+	}
+
+	var rows []struct {
+		UserID  string  `gorm:"column:user_id"`
+		Seconds float64 `gorm:"column:seconds"`
+	}
+	err := db.Table("pull_request_reviewers prr").
+		Select("prr.reviewer_id AS user_id, AVG(EXTRACT(EPOCH FROM pr.merged_at - prr.assigned_at)) AS seconds").
+		Joins("JOIN pull_requests pr ON pr.organization = prr.organization AND pr.pull_request_id = prr.pull_request_id").
+		Where("prr.organization = ? AND prr.reviewer_id IN ?", org, userIDs).
+		Where("pr.status = 'MERGED' AND pr.merged_at IS NOT NULL").
+		Group("prr.reviewer_id").
+		Scan(&rows).Error
+	if err != nil {
+		return nil, err
+	}
+
+	for _, row := range rows {
+		result[row.UserID] = time.Duration(row.Seconds * float64(time.Second))
+	}
+	return result, nil
+}
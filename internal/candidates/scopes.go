@@ -0,0 +1,19 @@
+package candidates
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// Available keeps users that have no absence covering the given moment.
+// It expects the users table to be queried under its own name.
+func Available(at time.Time) func(db *gorm.DB) *gorm.DB {
+	return func(db *gorm.DB) *gorm.DB {
+		return db.Where(`NOT EXISTS (
+			SELECT 1 FROM user_absences a
+			WHERE a.organization = users.organization AND a.user_id = users.id
+				AND a.starts_at <= ? AND a.ends_at > ?
+		)`, at, at)
+	}
+}
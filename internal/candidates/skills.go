@@ -0,0 +1,52 @@
+package candidates
+
+import (
+	"strings"
+
+	"gorm.io/gorm"
+)
+
+// Skills returns the skill tags of the given users, sorted by name.
+func Skills(db *gorm.DB, org string, userIDs []string) (map[string][]string, error) {
+	result := make(map[string][]string, len(userIDs))
+	if len(userIDs) == 0 {
+		return result, nil
+	}
+
+	var rows []struct {
+		UserID string `gorm:"column:user_id"`
+		Skill  string `gorm:"column:skill"`
+	}
+	err := db.Table("user_skills").
+		Select("user_id, skill").
+		Where("organization = ? AND user_id IN ?", org, userIDs).
+		Order("user_id, skill").
+		Scan(&rows).Error
+	if err != nil {
+		return nil, err
+	}
+
+	for _, row := range rows {
+		result[row.UserID] = append(result[row.UserID], row.Skill)
+	}
+	return result, nil
+}
+
+// NormalizeTags trims and lower-cases tags and drops empty values and duplicates,
+// keeping the first occurrence order.
+func NormalizeTags(tags []string) []string {
+	var result []string
+	seen := make(map[string]struct{}, len(tags))
+	for _, t := range tags {
+		t = strings.ToLower(strings.TrimSpace(t))
+		if t == "" {
+			continue
+		}
+		if _, ok := seen[t]; ok {
+			continue
+		}
+		seen[t] = struct{}{}
+		result = append(result, t)
+	}
+	return result
+}
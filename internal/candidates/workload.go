@@ -0,0 +1,83 @@
+package candidates
+
+import "gorm.io/gorm"
+
+// Workload is the number of OPEN reviews of a user and the limit that applies to them:
+// the user's own max_open_reviews or, if unset, the default of their team. Load weighs
+// the same reviews by PR size; the limit still counts reviews.
+type Workload struct {
+	UserID         string `gorm:"column:user_id"`
+	OpenReviews    int    `gorm:"column:open_reviews"`
+	Load           int    `gorm:"column:load"`
+	MaxOpenReviews *int   `gorm:"column:max_open_reviews"`
+}
+
+// linesPerWeight and maxWeight define the weight of one review in Load: it counts once
+// per started linesPerWeight changed lines, but no more than maxWeight times. PRs of
+// unknown size weigh as small ones. weightSQL is the only place that applies them, to
+// the PR aliased pr.
+const (
+	linesPerWeight = 500
+	maxWeight      = 4
+
+	weightSQL = "LEAST(1 + (pr.additions + pr.deletions) / ?, ?)"
+)
+
+func (w Workload) AtCapacity() bool {
+	return w.MaxOpenReviews != nil && w.OpenReviews >= *w.MaxOpenReviews
+}
+
+func Workloads(db *gorm.DB, org string, userIDs []string) (map[string]Workload, error) {
+	result := make(map[string]Workload, len(userIDs))
+	if len(userIDs) == 0 {
+		return result, nil
+	}
+
+	var rows []Workload
+	err := db.Table("users AS u").
+		Select(`u.id AS user_id,
+			(SELECT COUNT(*) FROM pull_request_reviewers prr
+				JOIN pull_requests pr ON pr.organization = prr.organization AND pr.pull_request_id = prr.pull_request_id
+				WHERE prr.organization = u.organization AND prr.reviewer_id = u.id AND pr.status = 'OPEN') AS open_reviews,
+			(SELECT COALESCE(SUM(`+weightSQL+`), 0) FROM pull_request_reviewers prr
+				JOIN pull_requests pr ON pr.organization = prr.organization AND pr.pull_request_id = prr.pull_request_id
+				WHERE prr.organization = u.organization AND prr.reviewer_id = u.id AND pr.status = 'OPEN') AS load,
+			COALESCE(u.max_open_reviews, t.default_max_open_reviews) AS max_open_reviews`,
+			linesPerWeight, maxWeight).
+		Joins("LEFT JOIN teams t ON t.organization = u.organization AND t.name = u.team_name").
+		Where("u.organization = ? AND u.id IN ?", org, userIDs).
+		Scan(&rows).Error
+	if err != nil {
+		return nil, err
+	}
+
+	for _, w := range rows {
+		result[w.UserID] = w
+	}
+	return result, nil
+}
+
+// ReviewWeights returns how much one review of each PR adds to a reviewer's Load.
+func ReviewWeights(db *gorm.DB, org string, prIDs []string) (map[string]int, error) {
+	result := make(map[string]int, len(prIDs))
+	if len(prIDs) == 0 {
+		return result, nil
+	}
+
+	var rows []struct {
+		PRID   string `gorm:"column:pull_request_id"`
+		Weight int    `gorm:"column:weight"`
+	}
+	err := db.Table("pull_requests AS pr").
+		Select("pr.pull_request_id, "+weightSQL+" AS weight", linesPerWeight, maxWeight).
+		Where("pr.organization = ? AND pr.pull_request_id IN ?", org, prIDs).
+		Scan(&rows).Error
+	if err != nil {
+		return nil, err
+	}
+
+	for _, r := range rows {
+		result[r.PRID] = r.Weight
+	}
+	return result, nil
+}
@@ -0,0 +1,185 @@
+package cli
+
+import (
+	"PullRequestService/domain"
+	"PullRequestService/internal/authService"
+	"PullRequestService/internal/db"
+	"PullRequestService/internal/rosterService"
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// Run executes an administrative subcommand instead of starting the HTTP server.
+func Run(args []string) error {
+	if len(args) == 0 {
+		return fmt.Errorf("no command given")
+	}
+
+	switch args[0] {
+	case "import":
+		return runImport(args[1:])
+	case "tokens":
+		return runTokens(args[1:])
+	default:
+		return fmt.Errorf("unknown command %q", args[0])
+	}
+}
+
+func runImport(args []string) error {
+	fs := flag.NewFlagSet("import", flag.ContinueOnError)
+	file := fs.String("file", "", "path to the roster file (.csv or .yaml)")
+	format := fs.String("format", "", "roster format: csv or yaml (detected from the file extension by default)")
+	dryRun := fs.Bool("dry-run", false, "only print the diff without applying it")
+	org := orgFlag(fs)
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	ctx, err := orgContext(*org)
+	if err != nil {
+		return err
+	}
+
+	if *file == "" {
+		return fmt.Errorf("-file is required")
+	}
+	if *format == "" {
+		*format = strings.TrimPrefix(filepath.Ext(*file), ".")
+	}
+
+	f, err := os.Open(*file)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	dbConn, err := db.InitDB()
+	if err != nil {
+		return err
+	}
+
+	service := rosterService.NewRosterService(rosterService.NewRosterRepository(dbConn))
+	result, err := service.Import(ctx, *format, f, *dryRun)
+	if err != nil {
+		return err
+	}
+
+	enc := json.NewEncoder(os.Stdout)
+	enc.SetIndent("", "  ")
+	return enc.Encode(result)
+}
+
+func runTokens(args []string) error {
+	if len(args) == 0 {
+		return fmt.Errorf("usage: tokens create|list|revoke")
+	}
+
+	var run func(authService.AuthService, []string) (any, error)
+	switch args[0] {
+	case "create":
+		run = createToken
+	case "list":
+		run = listTokens
+	case "revoke":
+		run = revokeToken
+	default:
+		return fmt.Errorf("unknown tokens command %q", args[0])
+	}
+
+	dbConn, err := db.InitDB()
+	if err != nil {
+		return err
+	}
+
+	result, err := run(authService.NewAuthService(authService.NewAuthRepository(dbConn), nil), args[1:])
+	if err != nil {
+		return err
+	}
+
+	enc := json.NewEncoder(os.Stdout)
+	enc.SetIndent("", "  ")
+	return enc.Encode(result)
+}
+
+func createToken(service authService.AuthService, args []string) (any, error) {
+	fs := flag.NewFlagSet("tokens create", flag.ContinueOnError)
+	name := fs.String("name", "", "token description, e.g. who uses it")
+	role := fs.String("role", "", "admin, team-lead or member")
+	user := fs.String("user", "", "user the token acts as (required for member)")
+	team := fs.String("team", "", "team of the lead (required for team-lead)")
+	org := orgFlag(fs)
+	if err := fs.Parse(args); err != nil {
+		return nil, err
+	}
+	ctx, err := orgContext(*org)
+	if err != nil {
+		return nil, err
+	}
+
+	token := domain.APIToken{Name: *name, Role: *role}
+	if *user != "" {
+		token.UserID = user
+	}
+	if *team != "" {
+		token.TeamName = team
+	}
+
+	secret, created, err := service.IssueToken(ctx, token)
+	if err != nil {
+		return nil, err
+	}
+	return struct {
+		Token  domain.APIToken `json:"token"`
+		Secret string          `json:"secret"`
+	}{created, secret}, nil
+}
+
+func revokeToken(service authService.AuthService, args []string) (any, error) {
+	fs := flag.NewFlagSet("tokens revoke", flag.ContinueOnError)
+	id := fs.Int64("id", 0, "ID of the token to revoke")
+	org := orgFlag(fs)
+	if err := fs.Parse(args); err != nil {
+		return nil, err
+	}
+	if *id == 0 {
+		return nil, fmt.Errorf("-id is required")
+	}
+	ctx, err := orgContext(*org)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := service.RevokeToken(ctx, *id); err != nil {
+		return nil, err
+	}
+	return map[string]int64{"revoked": *id}, nil
+}
+
+func listTokens(service authService.AuthService, args []string) (any, error) {
+	fs := flag.NewFlagSet("tokens list", flag.ContinueOnError)
+	org := orgFlag(fs)
+	if err := fs.Parse(args); err != nil {
+		return nil, err
+	}
+	ctx, err := orgContext(*org)
+	if err != nil {
+		return nil, err
+	}
+
+	return service.ListTokens(ctx)
+}
+
+func orgFlag(fs *flag.FlagSet) *string {
+	return fs.String("org", domain.DefaultOrganization, "organization to work in")
+}
+
+func orgContext(org string) (context.Context, error) {
+	if !domain.ValidOrganization(org) {
+		return nil, fmt.Errorf("invalid organization %q", org)
+	}
+	return domain.WithOrganization(context.Background(), org), nil
+}
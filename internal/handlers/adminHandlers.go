@@ -0,0 +1,203 @@
+package handlers
+
+import (
+	"PullRequestService/domain"
+	"PullRequestService/internal/auditService"
+	"PullRequestService/internal/authService"
+	"PullRequestService/internal/rosterService"
+	"PullRequestService/internal/web/admin"
+	"context"
+	"encoding/json"
+	"strings"
+)
+
+type AdminHandler struct {
+	roster rosterService.RosterService
+	auth   authService.AuthService
+	audit  auditService.AuditService
+}
+
+func NewAdminHandler(roster rosterService.RosterService, auth authService.AuthService, audit auditService.AuditService) *AdminHandler {
+	return &AdminHandler{
+		roster: roster,
+		auth:   auth,
+		audit:  audit,
+	}
+}
+
+func (a *AdminHandler) PostAdminImport(ctx context.Context, request admin.PostAdminImportRequestObject) (admin.PostAdminImportResponseObject, error) {
+	if request.Body == nil {
+		return nil, errNoBody
+	}
+
+	dryRun := request.Params.DryRun != nil && *request.Params.DryRun
+
+	result, err := a.roster.Import(ctx, string(request.Params.Format), strings.NewReader(*request.Body), dryRun)
+	if err != nil {
+		return nil, err
+	}
+
+	diff := result.Diff
+
+	usersToCreate := make([]admin.User, 0, len(diff.UsersToCreate))
+	for _, u := range diff.UsersToCreate {
+		usersToCreate = append(usersToCreate, admin.User{
+			UserId:   u.ID,
+			Username: u.Username,
+			TeamName: u.TeamName,
+			IsActive: u.IsActive,
+		})
+	}
+
+	usersToMove := make([]admin.RosterMove, 0, len(diff.UsersToMove))
+	for _, m := range diff.UsersToMove {
+		usersToMove = append(usersToMove, admin.RosterMove{
+			UserId:   m.UserID,
+			FromTeam: m.FromTeam,
+			ToTeam:   m.ToTeam,
+		})
+	}
+
+	return admin.PostAdminImport200JSONResponse{
+		DryRun: result.DryRun,
+		Diff: admin.RosterDiff{
+			TeamsToCreate:     nonNil(diff.TeamsToCreate),
+			UsersToCreate:     usersToCreate,
+			UsersToMove:       usersToMove,
+			UsersToActivate:   nonNil(diff.UsersToActivate),
+			UsersToDeactivate: nonNil(diff.UsersToDeactivate),
+		},
+		AffectedPrCount:          result.AffectedPRCount,
+		ReassignedReviewersCount: result.ReassignedReviewersCount,
+	}, nil
+}
+
+func (a *AdminHandler) GetAdminTokens(ctx context.Context, request admin.GetAdminTokensRequestObject) (admin.GetAdminTokensResponseObject, error) {
+	tokens, err := a.auth.ListTokens(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]admin.APIToken, 0, len(tokens))
+	for _, t := range tokens {
+		result = append(result, toAPIToken(t))
+	}
+	return admin.GetAdminTokens200JSONResponse{Tokens: result}, nil
+}
+
+func (a *AdminHandler) PostAdminTokensCreate(ctx context.Context, request admin.PostAdminTokensCreateRequestObject) (admin.PostAdminTokensCreateResponseObject, error) {
+	req := request.Body
+	if req == nil {
+		return nil, errNoBody
+	}
+
+	secret, token, err := a.auth.IssueToken(ctx, domain.APIToken{
+		Name:     req.Name,
+		Role:     string(req.Role),
+		UserID:   req.UserId,
+		TeamName: req.TeamName,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return admin.PostAdminTokensCreate201JSONResponse{
+		Token:  toAPIToken(token),
+		Secret: secret,
+	}, nil
+}
+
+func (a *AdminHandler) PostAdminTokensRevoke(ctx context.Context, request admin.PostAdminTokensRevokeRequestObject) (admin.PostAdminTokensRevokeResponseObject, error) {
+	req := request.Body
+	if req == nil {
+		return nil, errNoBody
+	}
+
+	if err := a.auth.RevokeToken(ctx, req.TokenId); err != nil {
+		return nil, err
+	}
+
+	return admin.PostAdminTokensRevoke204Response{}, nil
+}
+
+func (a *AdminHandler) GetAdminAudit(ctx context.Context, request admin.GetAdminAuditRequestObject) (admin.GetAdminAuditResponseObject, error) {
+	params := request.Params
+	filter := domain.AuditFilter{
+		Actor:     deref(params.Actor),
+		Operation: deref(params.Operation),
+		Outcome:   string(deref(params.Outcome)),
+		RequestID: deref(params.RequestId),
+		From:      params.From,
+		To:        params.To,
+		Limit:     deref(params.Limit),
+		Offset:    deref(params.Offset),
+	}
+
+	entries, more, err := a.audit.List(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]admin.AuditEntry, 0, len(entries))
+	for _, e := range entries {
+		result = append(result, toAuditEntry(e))
+	}
+
+	response := admin.GetAdminAudit200JSONResponse{Entries: result}
+	if more {
+		next := filter.Offset + len(entries)
+		response.NextOffset = &next
+	}
+	return response, nil
+}
+
+func toAuditEntry(e domain.AuditEntry) admin.AuditEntry {
+	entry := admin.AuditEntry{
+		Id:        e.ID,
+		CreatedAt: e.CreatedAt,
+		Actor:     e.Actor,
+		RequestId: e.RequestID,
+		Operation: e.Operation,
+		Payload:   map[string]interface{}{},
+		Status:    e.Status,
+		Outcome:   admin.AuditEntryOutcome(e.Outcome),
+		ErrorCode: e.ErrorCode,
+		Before:    jsonObject(e.Before),
+		After:     jsonObject(e.After),
+	}
+	_ = json.Unmarshal([]byte(e.Payload), &entry.Payload)
+	return entry
+}
+
+// jsonObject decodes a stored JSON snapshot; nil stays nil.
+func jsonObject(raw *string) *map[string]interface{} {
+	if raw == nil {
+		return nil
+	}
+	var obj map[string]interface{}
+	if err := json.Unmarshal([]byte(*raw), &obj); err != nil {
+		return nil
+	}
+	return &obj
+}
+
+func toAPIToken(t domain.APIToken) admin.APIToken {
+	return admin.APIToken{
+		Id:           t.ID,
+		Organization: t.Organization,
+		Name:         t.Name,
+		Role:         admin.Role(t.Role),
+		UserId:       t.UserID,
+		TeamName:     t.TeamName,
+		CreatedAt:    t.CreatedAt,
+		LastUsedAt:   t.LastUsedAt,
+		RevokedAt:    t.RevokedAt,
+	}
+}
+
+func nonNil(s []string) []string {
+	if s == nil {
+		return []string{}
+	}
+	return s
+}
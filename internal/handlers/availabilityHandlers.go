@@ -0,0 +1,109 @@
+package handlers
+
+import (
+	"PullRequestService/domain"
+	"PullRequestService/internal/availabilityService"
+	"PullRequestService/internal/web/availability"
+	"context"
+	"strings"
+)
+
+type AvailabilityHandler struct {
+	service availabilityService.AvailabilityService
+}
+
+func NewAvailabilityHandler(service availabilityService.AvailabilityService) *AvailabilityHandler {
+	return &AvailabilityHandler{
+		service: service,
+	}
+}
+
+func (a *AvailabilityHandler) GetUsersAbsences(ctx context.Context, request availability.GetUsersAbsencesRequestObject) (availability.GetUsersAbsencesResponseObject, error) {
+	absences, err := a.service.GetAbsences(ctx, request.Params.UserId)
+	if err != nil {
+		return nil, err
+	}
+
+	return availability.GetUsersAbsences200JSONResponse{
+		UserId:   request.Params.UserId,
+		Absences: toAbsences(absences),
+	}, nil
+}
+
+func (a *AvailabilityHandler) PostUsersAbsencesAdd(ctx context.Context, request availability.PostUsersAbsencesAddRequestObject) (availability.PostUsersAbsencesAddResponseObject, error) {
+	req := request.Body
+	if req == nil {
+		return nil, errNoBody
+	}
+
+	absence := domain.Absence{
+		UserID:         req.UserId,
+		StartsAt:       req.StartsAt,
+		EndsAt:         req.EndsAt,
+		HandOffReviews: req.HandOffReviews != nil && *req.HandOffReviews,
+	}
+	if req.Reason != nil {
+		absence.Reason = *req.Reason
+	}
+
+	created, err := a.service.AddAbsence(ctx, absence)
+	if err != nil {
+		return nil, err
+	}
+
+	return availability.PostUsersAbsencesAdd201JSONResponse{
+		Absence: toAbsence(created),
+	}, nil
+}
+
+func (a *AvailabilityHandler) PostUsersAbsencesDelete(ctx context.Context, request availability.PostUsersAbsencesDeleteRequestObject) (availability.PostUsersAbsencesDeleteResponseObject, error) {
+	req := request.Body
+	if req == nil {
+		return nil, errNoBody
+	}
+
+	if err := a.service.DeleteAbsence(ctx, req.AbsenceId); err != nil {
+		return nil, err
+	}
+
+	return availability.PostUsersAbsencesDelete204Response{}, nil
+}
+
+func (a *AvailabilityHandler) PostUsersAbsencesImport(ctx context.Context, request availability.PostUsersAbsencesImportRequestObject) (availability.PostUsersAbsencesImportResponseObject, error) {
+	if request.Body == nil {
+		return nil, errNoBody
+	}
+
+	handOff := request.Params.HandOffReviews != nil && *request.Params.HandOffReviews
+
+	absences, err := a.service.ImportICS(ctx, request.Params.UserId, strings.NewReader(*request.Body), handOff)
+	if err != nil {
+		return nil, err
+	}
+
+	return availability.PostUsersAbsencesImport200JSONResponse{
+		UserId:   request.Params.UserId,
+		Absences: toAbsences(absences),
+	}, nil
+}
+
+func toAbsences(absences []domain.Absence) []availability.Absence {
+	result := make([]availability.Absence, 0, len(absences))
+	for _, a := range absences {
+		result = append(result, toAbsence(a))
+	}
+	return result
+}
+
+func toAbsence(a domain.Absence) availability.Absence {
+	return availability.Absence{
+		AbsenceId:      a.ID,
+		UserId:         a.UserID,
+		StartsAt:       a.StartsAt,
+		EndsAt:         a.EndsAt,
+		Reason:         a.Reason,
+		Source:         availability.AbsenceSource(a.Source),
+		HandOffReviews: a.HandOffReviews,
+		HandedOffAt:    a.HandedOffAt,
+	}
+}
@@ -0,0 +1,95 @@
+package handlers
+
+import (
+	"PullRequestService/domain"
+	"PullRequestService/internal/web/pullRequests"
+	"errors"
+	"fmt"
+	echo "github.com/labstack/echo/v4"
+	"log"
+	"net/http"
+)
+
+// errNoBody is returned when a JSON body is required but missing.
+var errNoBody = domain.ErrBadRequest.Msg("request body is required")
+
+// ErrorHandler writes every error as an ErrorResponse. It replaces echo's handler, so
+// it also covers errors the handlers never see: malformed JSON bodies and parameters,
+// unknown routes and the middlewares. The body carries the request ID, so a caller can
+// point at the log lines of a failure. Server errors are logged and reported without
+// details.
+func ErrorHandler(err error, c echo.Context) {
+	if c.Response().Committed {
+		return
+	}
+
+	e := ToError(err)
+	requestID := c.Response().Header().Get(echo.HeaderXRequestID)
+	if e.Status >= http.StatusInternalServerError {
+		log.Printf("%s %s (request %s): %v", c.Request().Method, c.Path(), requestID, err)
+	}
+	body := toErrorResponse(e, requestID)
+
+	if c.Request().Method == http.MethodHead {
+		err = c.NoContent(e.Status)
+	} else {
+		err = c.JSON(e.Status, body)
+	}
+	if err != nil {
+		log.Printf("write error response: %v", err)
+	}
+}
+
+// ToError finds the domain error in err. Echo errors get the code of their status;
+// any other error is internal.
+func ToError(err error) *domain.Error {
+	var e *domain.Error
+	if errors.As(err, &e) {
+		return e
+	}
+
+	var he *echo.HTTPError
+	if errors.As(err, &he) {
+		kind := domain.ErrInternal
+		switch {
+		case he.Code == http.StatusUnauthorized:
+			kind = domain.ErrUnauthorized
+		case he.Code == http.StatusForbidden:
+			kind = domain.ErrForbidden
+		case he.Code == http.StatusNotFound:
+			kind = domain.ErrNotFound
+		case he.Code < http.StatusInternalServerError:
+			kind = domain.ErrBadRequest
+		}
+		e = kind.Msg(fmt.Sprint(he.Message))
+		e.Status = he.Code
+		return e
+	}
+
+	return domain.ErrInternal.Wrap(err)
+}
+
+// toErrorResponse maps e into the generated ErrorResponse. Every web package generates
+// the same type from the shared schema, so the one of pullRequests stands for all of
+// them; a change to the schema breaks the build here instead of drifting silently.
+func toErrorResponse(e *domain.Error, requestID string) pullRequests.ErrorResponse {
+	var body pullRequests.ErrorResponse
+	body.Error.Code = pullRequests.ErrorResponseErrorCode(e.Code)
+	body.Error.Message = e.Message
+	if requestID != "" {
+		body.Error.RequestId = &requestID
+	}
+
+	if len(e.Fields) > 0 {
+		fields := make([]struct {
+			Field   string `json:"field"`
+			Message string `json:"message"`
+		}, len(e.Fields))
+		for i, f := range e.Fields {
+			fields[i].Field = f.Field
+			fields[i].Message = f.Message
+		}
+		body.Error.Fields = &fields
+	}
+	return body
+}
@@ -0,0 +1,96 @@
+package handlers
+
+import (
+	"PullRequestService/domain"
+	"PullRequestService/internal/idempotencyService"
+	"PullRequestService/internal/rateLimitService"
+	"PullRequestService/openapi"
+	"encoding/json"
+	"errors"
+	"github.com/getkin/kin-openapi/openapi3"
+	echo "github.com/labstack/echo/v4"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+)
+
+// TestErrorCodesInSpec keeps the error kinds in line with the code enum of the
+// ErrorResponse schema, so no response carries a code clients don't know.
+func TestErrorCodesInSpec(t *testing.T) {
+	spec, err := openapi3.NewLoader().LoadFromData(openapi.Spec)
+	if err != nil {
+		t.Fatal(err)
+	}
+	code := spec.Components.Schemas["ErrorResponse"].Value.Properties["error"].Value.Properties["code"].Value
+
+	inSpec := make(map[string]bool, len(code.Enum))
+	for _, v := range code.Enum {
+		inSpec[v.(string)] = true
+	}
+
+	kinds := []*domain.Error{
+		domain.ErrBadRequest, domain.ErrUnauthorized, domain.ErrForbidden, domain.ErrNotFound,
+		domain.ErrTeamExists, domain.ErrPRExists, domain.ErrPRMerged, domain.ErrNotAssigned,
+		domain.ErrNoCandidate, domain.ErrVersionMismatch, domain.ErrInternal,
+		idempotencyService.ErrKeyReused, idempotencyService.ErrKeyInProgress,
+		rateLimitService.ErrRateLimited,
+	}
+	for _, k := range kinds {
+		if !inSpec[k.Code] {
+			t.Errorf("code %s is missing from the ErrorResponse enum", k.Code)
+		}
+	}
+}
+
+func TestErrorHandler(t *testing.T) {
+	type body struct {
+		Error struct {
+			Code      string              `json:"code"`
+			Message   string              `json:"message"`
+			RequestID string              `json:"request_id"`
+			Fields    []domain.FieldError `json:"fields"`
+		} `json:"error"`
+	}
+
+	invalid := domain.ErrBadRequest.Msg("invalid reviewer")
+	fields := []domain.FieldError{{Field: "members.1.user_id", Message: "must not be empty"}}
+
+	tests := []struct {
+		name    string
+		err     error
+		status  int
+		code    string
+		message string
+		fields  []domain.FieldError
+	}{
+		{"detail", invalid.Detailf("user %s is inactive", "u3"), http.StatusBadRequest, "BAD_REQUEST", "invalid reviewer: user u3 is inactive", nil},
+		{"fields", domain.ErrValidation.WithFields(fields...), http.StatusBadRequest, "BAD_REQUEST", "request validation failed", fields},
+		{"cause hidden", domain.ErrNotFound.Msg("PR not found").Wrap(errors.New("record not found")), http.StatusNotFound, "NOT_FOUND", "PR not found", nil},
+		{"internal", errors.New("connection refused"), http.StatusInternalServerError, "INTERNAL_ERROR", "internal error", nil},
+		{"echo", echo.NewHTTPError(http.StatusMethodNotAllowed, "method not allowed"), http.StatusMethodNotAllowed, "BAD_REQUEST", "method not allowed", nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rec := httptest.NewRecorder()
+			c := echo.New().NewContext(httptest.NewRequest(http.MethodPost, "/pullRequest/create", nil), rec)
+			c.Response().Header().Set(echo.HeaderXRequestID, "req-1")
+
+			ErrorHandler(tt.err, c)
+
+			if rec.Code != tt.status {
+				t.Errorf("status = %d, want %d", rec.Code, tt.status)
+			}
+			var got body
+			if err := json.Unmarshal(rec.Body.Bytes(), &got); err != nil {
+				t.Fatal(err)
+			}
+			if got.Error.Code != tt.code || got.Error.Message != tt.message || got.Error.RequestID != "req-1" {
+				t.Errorf("error = %+v, want %s %q for req-1", got.Error, tt.code, tt.message)
+			}
+			if !reflect.DeepEqual(got.Error.Fields, tt.fields) {
+				t.Errorf("fields = %+v, want %+v", got.Error.Fields, tt.fields)
+			}
+		})
+	}
+}
@@ -0,0 +1,58 @@
+package handlers
+
+import (
+	"PullRequestService/domain"
+	"PullRequestService/internal/ownershipService"
+	"PullRequestService/internal/web/ownership"
+	"context"
+	"strings"
+)
+
+type OwnershipHandler struct {
+	service ownershipService.OwnershipService
+}
+
+func NewOwnershipHandler(service ownershipService.OwnershipService) *OwnershipHandler {
+	return &OwnershipHandler{
+		service: service,
+	}
+}
+
+func (o *OwnershipHandler) PostOwnershipImport(ctx context.Context, request ownership.PostOwnershipImportRequestObject) (ownership.PostOwnershipImportResponseObject, error) {
+	if request.Body == nil {
+		return nil, errNoBody
+	}
+
+	rules, err := o.service.ImportCodeowners(ctx, strings.NewReader(*request.Body))
+	if err != nil {
+		return nil, err
+	}
+
+	return ownership.PostOwnershipImport200JSONResponse{
+		Rules: toOwnershipRules(rules),
+	}, nil
+}
+
+func (o *OwnershipHandler) GetOwnershipRules(ctx context.Context, request ownership.GetOwnershipRulesRequestObject) (ownership.GetOwnershipRulesResponseObject, error) {
+	rules, err := o.service.GetRules(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return ownership.GetOwnershipRules200JSONResponse{
+		Rules: toOwnershipRules(rules),
+	}, nil
+}
+
+func toOwnershipRules(rules []domain.OwnershipRule) []ownership.OwnershipRule {
+	result := make([]ownership.OwnershipRule, 0, len(rules))
+	for _, r := range rules {
+		result = append(result, ownership.OwnershipRule{
+			Position:   r.Position,
+			Pattern:    r.Pattern,
+			OwnerUsers: nonNil(r.OwnerUsers),
+			OwnerTeams: nonNil(r.OwnerTeams),
+		})
+	}
+	return result
+}
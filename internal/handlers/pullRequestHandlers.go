@@ -1,10 +1,10 @@
 package handlers
 
 import (
+	"PullRequestService/domain"
 	"PullRequestService/internal/pullRequestService"
 	"PullRequestService/internal/web/pullRequests"
 	"context"
-	"errors"
 )
 
 type PullRequestHandler struct {
@@ -20,164 +20,305 @@ func NewPullRequestHandler(service pullRequestService.PullRequestService) *PullR
 func (p *PullRequestHandler) PostPullRequestCreate(ctx context.Context, request pullRequests.PostPullRequestCreateRequestObject) (pullRequests.PostPullRequestCreateResponseObject, error) {
 	req := request.Body
 	if req == nil {
-		return nil, errors.New("invalid request body")
+		return nil, errNoBody
 	}
 
-	pr, err := p.service.CreatePR(req.PullRequestId, req.PullRequestName, req.AuthorId)
+	draft := domain.PullRequestDraft{
+		ID:       req.PullRequestId,
+		Name:     req.PullRequestName,
+		AuthorID: req.AuthorId,
+	}
+	if req.ChangedFiles != nil {
+		draft.ChangedFiles = *req.ChangedFiles
+	}
+	if req.Tags != nil {
+		draft.Tags = *req.Tags
+	}
+	if req.ExcludeReviewers != nil {
+		draft.ExcludeReviewers = *req.ExcludeReviewers
+	}
+	if req.RequestedReviewers != nil {
+		draft.RequestedReviewers = *req.RequestedReviewers
+	}
+	if req.Priority != nil {
+		draft.Priority = string(*req.Priority)
+	}
+	draft.DueAt = req.DueAt
+	draft.Metadata = domain.PullRequestMetadata{
+		Repository:   deref(req.Repository),
+		SourceBranch: deref(req.SourceBranch),
+		TargetBranch: deref(req.TargetBranch),
+		URL:          deref(req.Url),
+		Additions:    deref(req.Additions),
+		Deletions:    deref(req.Deletions),
+	}
+	if req.Labels != nil {
+		draft.Metadata.Labels = *req.Labels
+	}
+
+	pr, report, err := p.service.CreatePR(ctx, draft)
 	if err != nil {
-		switch err.Error() {
-		case "PR_EXISTS":
-			return pullRequests.PostPullRequestCreate409JSONResponse{
-				Error: struct {
-					Code    pullRequests.ErrorResponseErrorCode `json:"code"`
-					Message string                              `json:"message"`
-				}{
-					Code:    pullRequests.PREXISTS,
-					Message: "PR id already exists",
-				},
-			}, nil
-		case "NOT_FOUND":
-			return pullRequests.PostPullRequestCreate404JSONResponse{
-				Error: struct {
-					Code    pullRequests.ErrorResponseErrorCode `json:"code"`
-					Message string                              `json:"message"`
-				}{
-					Code:    pullRequests.NOTFOUND,
-					Message: "author not found",
-				},
-			}, nil
-		default:
-			return nil, err
-		}
+		return nil, err
 	}
 
-	reviewerIDs := make([]string, len(pr.AssignedReviewers))
-	for i, u := range pr.AssignedReviewers {
-		reviewerIDs[i] = u.ID
+	prResponse := toPullRequest(pr)
+	response := pullRequests.PostPullRequestCreate201JSONResponse{
+		Headers: pullRequests.PostPullRequestCreate201ResponseHeaders{ETag: domain.ETag(pr.Version)},
+	}
+	response.Body.Pr = &prResponse
+	response.Body.Assignment = &pullRequests.AssignmentReport{
+		RequestedReviewers: report.Requested,
+		AssignedReviewers:  report.Assigned,
+		CapacityLimited:    report.CapacityLimited,
+		ExternalReviewers:  nonNil(report.External),
+		Owners:             toOwnedAreas(report.Owners),
+		PairingMissing:     toSeniorities(report.PairingMissing),
 	}
+	return response, nil
+}
 
-	return pullRequests.PostPullRequestCreate201JSONResponse{
-		Pr: &pullRequests.PullRequest{
-			PullRequestId:     pr.ID,
-			PullRequestName:   pr.Name,
-			AuthorId:          pr.AuthorID,
-			Status:            pullRequests.OPEN,
-			AssignedReviewers: reviewerIDs,
-			CreatedAt:         &pr.CreatedAt,
-			MergedAt:          nil,
-		},
-	}, nil
+func (p *PullRequestHandler) PostPullRequestReviewersAdd(ctx context.Context, request pullRequests.PostPullRequestReviewersAddRequestObject) (pullRequests.PostPullRequestReviewersAddResponseObject, error) {
+	ctx = domain.WithIfMatch(ctx, request.Params.IfMatch)
+	req := request.Body
+	if req == nil {
+		return nil, errNoBody
+	}
+
+	pr, err := p.service.AddReviewer(ctx, req.PullRequestId, req.UserId)
+	if err != nil {
+		return nil, err
+	}
+
+	response := pullRequests.PostPullRequestReviewersAdd200JSONResponse{
+		Headers: pullRequests.PostPullRequestReviewersAdd200ResponseHeaders{ETag: domain.ETag(pr.Version)},
+	}
+	response.Body.Pr = toPullRequest(pr)
+	return response, nil
 }
 
-func (p *PullRequestHandler) PostPullRequestMerge(ctx context.Context, request pullRequests.PostPullRequestMergeRequestObject) (pullRequests.PostPullRequestMergeResponseObject, error) {
+func (p *PullRequestHandler) PostPullRequestReviewersRemove(ctx context.Context, request pullRequests.PostPullRequestReviewersRemoveRequestObject) (pullRequests.PostPullRequestReviewersRemoveResponseObject, error) {
+	ctx = domain.WithIfMatch(ctx, request.Params.IfMatch)
 	req := request.Body
 	if req == nil {
-		return nil, errors.New("invalid request body")
+		return nil, errNoBody
 	}
 
-	pr, err := p.service.MergePR(req.PullRequestId)
+	pr, err := p.service.RemoveReviewer(ctx, req.PullRequestId, req.UserId)
+	if err != nil {
+		return nil, err
+	}
+
+	response := pullRequests.PostPullRequestReviewersRemove200JSONResponse{
+		Headers: pullRequests.PostPullRequestReviewersRemove200ResponseHeaders{ETag: domain.ETag(pr.Version)},
+	}
+	response.Body.Pr = toPullRequest(pr)
+	return response, nil
+}
+
+func (p *PullRequestHandler) PostPullRequestDecline(ctx context.Context, request pullRequests.PostPullRequestDeclineRequestObject) (pullRequests.PostPullRequestDeclineResponseObject, error) {
+	ctx = domain.WithIfMatch(ctx, request.Params.IfMatch)
+	req := request.Body
+	if req == nil {
+		return nil, errNoBody
+	}
+
+	pr, report, err := p.service.DeclineReview(ctx, req.PullRequestId, req.UserId, req.Reason)
 	if err != nil {
-		if err.Error() == "NOT_FOUND" {
-			return pullRequests.PostPullRequestMerge404JSONResponse{
-				Error: struct {
-					Code    pullRequests.ErrorResponseErrorCode `json:"code"`
-					Message string                              `json:"message"`
-				}{
-					Code:    pullRequests.NOTFOUND,
-					Message: "PR not found",
-				},
-			}, nil
-		}
 		return nil, err
 	}
 
+	response := pullRequests.PostPullRequestDecline200JSONResponse{
+		Headers: pullRequests.PostPullRequestDecline200ResponseHeaders{ETag: domain.ETag(pr.Version)},
+	}
+	response.Body.Pr = toPullRequest(pr)
+	if report.ReplacedBy != "" {
+		response.Body.ReplacedBy = &report.ReplacedBy
+		response.Body.ReplacedByExternal = &report.External
+	}
+	return response, nil
+}
+
+func toPullRequest(pr domain.PullRequest) pullRequests.PullRequest {
 	reviewerIDs := make([]string, len(pr.AssignedReviewers))
 	for i, u := range pr.AssignedReviewers {
 		reviewerIDs[i] = u.ID
 	}
 
-	return pullRequests.PostPullRequestMerge200JSONResponse{
-		Pr: &pullRequests.PullRequest{
-			PullRequestId:     pr.ID,
-			PullRequestName:   pr.Name,
-			AuthorId:          pr.AuthorID,
-			Status:            pullRequests.MERGED,
-			AssignedReviewers: reviewerIDs,
-			CreatedAt:         &pr.CreatedAt,
-			MergedAt:          pr.MergedAt,
-		},
-	}, nil
+	result := pullRequests.PullRequest{
+		PullRequestId:     pr.ID,
+		PullRequestName:   pr.Name,
+		AuthorId:          pr.AuthorID,
+		Status:            pullRequests.PullRequestStatus(pr.Status),
+		AssignedReviewers: reviewerIDs,
+		CreatedAt:         &pr.CreatedAt,
+		MergedAt:          pr.MergedAt,
+		DueAt:             pr.DueAt,
+		Version:           &pr.Version,
+	}
+	if pr.Priority != "" {
+		priority := pullRequests.Priority(pr.Priority)
+		result.Priority = &priority
+	}
+	result.Repository = optional(pr.Repository)
+	result.SourceBranch = optional(pr.SourceBranch)
+	result.TargetBranch = optional(pr.TargetBranch)
+	result.Url = optional(pr.URL)
+	result.Additions = optional(pr.Additions)
+	result.Deletions = optional(pr.Deletions)
+	if len(pr.Labels) > 0 {
+		labels := pr.Labels
+		result.Labels = &labels
+	}
+	if len(pr.Tags) > 0 {
+		tags := pr.Tags
+		result.Tags = &tags
+	}
+	if len(pr.ExcludedReviewers) > 0 {
+		excluded := pr.ExcludedReviewers
+		result.ExcludedReviewers = &excluded
+	}
+	return result
+}
+
+// optional returns nil for the zero value, so unset metadata is left out of responses.
+func optional[T comparable](v T) *T {
+	var zero T
+	if v == zero {
+		return nil
+	}
+	return &v
+}
+
+func deref[T any](p *T) T {
+	var v T
+	if p != nil {
+		v = *p
+	}
+	return v
+}
+
+func toSeniorities(levels []string) *[]pullRequests.Seniority {
+	if len(levels) == 0 {
+		return nil
+	}
+
+	result := make([]pullRequests.Seniority, 0, len(levels))
+	for _, l := range levels {
+		result = append(result, pullRequests.Seniority(l))
+	}
+	return &result
+}
+
+func toOwnedAreas(areas []domain.OwnedArea) *[]pullRequests.OwnedArea {
+	if len(areas) == 0 {
+		return nil
+	}
+
+	result := make([]pullRequests.OwnedArea, 0, len(areas))
+	for _, a := range areas {
+		area := pullRequests.OwnedArea{Pattern: a.Pattern}
+		if a.ReviewerID != "" {
+			reviewerID := a.ReviewerID
+			area.ReviewerId = &reviewerID
+		}
+		result = append(result, area)
+	}
+	return &result
+}
+
+func (p *PullRequestHandler) PostPullRequestMerge(ctx context.Context, request pullRequests.PostPullRequestMergeRequestObject) (pullRequests.PostPullRequestMergeResponseObject, error) {
+	ctx = domain.WithIfMatch(ctx, request.Params.IfMatch)
+	req := request.Body
+	if req == nil {
+		return nil, errNoBody
+	}
+
+	pr, err := p.service.MergePR(ctx, req.PullRequestId)
+	if err != nil {
+		return nil, err
+	}
+
+	prResponse := toPullRequest(pr)
+	response := pullRequests.PostPullRequestMerge200JSONResponse{
+		Headers: pullRequests.PostPullRequestMerge200ResponseHeaders{ETag: domain.ETag(pr.Version)},
+	}
+	response.Body.Pr = &prResponse
+	return response, nil
 }
 
 func (p *PullRequestHandler) PostPullRequestReassign(ctx context.Context, request pullRequests.PostPullRequestReassignRequestObject) (pullRequests.PostPullRequestReassignResponseObject, error) {
+	ctx = domain.WithIfMatch(ctx, request.Params.IfMatch)
 	req := request.Body
 	if req == nil {
-		return nil, errors.New("invalid request body")
+		return nil, errNoBody
 	}
 
-	newPR, replacedBy, err := p.service.ReassignReviewer(req.PullRequestId, req.OldUserId)
+	var newUserID string
+	if req.NewUserId != nil {
+		newUserID = *req.NewUserId
+	}
+
+	newPR, report, err := p.service.ReassignReviewer(ctx, req.PullRequestId, req.OldUserId, newUserID)
 	if err != nil {
-		switch err.Error() {
-		case "PR_NOT_FOUND", "USER_NOT_FOUND":
-			return pullRequests.PostPullRequestReassign404JSONResponse{
-				Error: struct {
-					Code    pullRequests.ErrorResponseErrorCode `json:"code"`
-					Message string                              `json:"message"`
-				}{
-					Code:    pullRequests.NOTFOUND,
-					Message: "PR or user not found",
-				},
-			}, nil
-		case "PR_MERGED":
-			return pullRequests.PostPullRequestReassign409JSONResponse{
-				Error: struct {
-					Code    pullRequests.ErrorResponseErrorCode `json:"code"`
-					Message string                              `json:"message"`
-				}{
-					Code:    pullRequests.PRMERGED,
-					Message: "cannot reassign on merged PR",
-				},
-			}, nil
-		case "NOT_ASSIGNED":
-			return pullRequests.PostPullRequestReassign409JSONResponse{
-				Error: struct {
-					Code    pullRequests.ErrorResponseErrorCode `json:"code"`
-					Message string                              `json:"message"`
-				}{
-					Code:    pullRequests.NOTASSIGNED,
-					Message: "reviewer is not assigned to this PR",
-				},
-			}, nil
-		case "NO_CANDIDATE":
-			return pullRequests.PostPullRequestReassign409JSONResponse{
-				Error: struct {
-					Code    pullRequests.ErrorResponseErrorCode `json:"code"`
-					Message string                              `json:"message"`
-				}{
-					Code:    pullRequests.NOCANDIDATE,
-					Message: "no active replacement candidate in team",
-				},
-			}, nil
-		default:
-			return nil, err
-		}
+		return nil, err
 	}
 
-	reviewerIDs := make([]string, len(newPR.AssignedReviewers))
-	for i, u := range newPR.AssignedReviewers {
-		reviewerIDs[i] = u.ID
+	response := pullRequests.PostPullRequestReassign200JSONResponse{
+		Headers: pullRequests.PostPullRequestReassign200ResponseHeaders{ETag: domain.ETag(newPR.Version)},
 	}
+	response.Body.Pr = toPullRequest(newPR)
+	response.Body.ReplacedBy = report.ReplacedBy
+	response.Body.ReplacedByExternal = &report.External
+	response.Body.PairingBroken = &report.PairingBroken
+	return response, nil
+}
 
-	return pullRequests.PostPullRequestReassign200JSONResponse{
-		Pr: pullRequests.PullRequest{
-			PullRequestId:     newPR.ID,
-			PullRequestName:   newPR.Name,
-			AuthorId:          newPR.AuthorID,
-			Status:            pullRequests.PullRequestStatus(newPR.Status),
-			AssignedReviewers: reviewerIDs,
-			CreatedAt:         &newPR.CreatedAt,
-			MergedAt:          newPR.MergedAt,
-		},
-		ReplacedBy: replacedBy,
+func (p *PullRequestHandler) PostPullRequestUpdate(ctx context.Context, request pullRequests.PostPullRequestUpdateRequestObject) (pullRequests.PostPullRequestUpdateResponseObject, error) {
+	ctx = domain.WithIfMatch(ctx, request.Params.IfMatch)
+	req := request.Body
+	if req == nil {
+		return nil, errNoBody
+	}
+
+	pr, err := p.service.UpdateMetadata(ctx, req.PullRequestId, domain.PullRequestMetadataPatch{
+		Repository:   req.Repository,
+		SourceBranch: req.SourceBranch,
+		TargetBranch: req.TargetBranch,
+		URL:          req.Url,
+		Additions:    req.Additions,
+		Deletions:    req.Deletions,
+		Labels:       req.Labels,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	response := pullRequests.PostPullRequestUpdate200JSONResponse{
+		Headers: pullRequests.PostPullRequestUpdate200ResponseHeaders{ETag: domain.ETag(pr.Version)},
+	}
+	response.Body.Pr = toPullRequest(pr)
+	return response, nil
+}
+
+func (p *PullRequestHandler) GetPullRequestList(ctx context.Context, request pullRequests.GetPullRequestListRequestObject) (pullRequests.GetPullRequestListResponseObject, error) {
+	filter := domain.PullRequestFilter{
+		Repository: deref(request.Params.Repository),
+		Label:      deref(request.Params.Label),
+	}
+	if request.Params.Status != nil {
+		filter.Status = string(*request.Params.Status)
+	}
+
+	prs, err := p.service.ListPRs(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]pullRequests.PullRequest, 0, len(prs))
+	for _, pr := range prs {
+		result = append(result, toPullRequest(pr))
+	}
+	return pullRequests.GetPullRequestList200JSONResponse{
+		PullRequests: result,
 	}, nil
 }
@@ -1,6 +1,7 @@
 package handlers
 
 import (
+	"PullRequestService/domain"
 	"PullRequestService/internal/statsService"
 	"PullRequestService/internal/web/stats"
 	"context"
@@ -15,17 +16,14 @@ func NewStatsHandler(service statsService.StatsService) *StatsHandler {
 }
 
 func (h *StatsHandler) GetStats(ctx context.Context, request stats.GetStatsRequestObject) (stats.GetStatsResponseObject, error) {
-	result, err := h.service.GetStats()
+	filter := domain.PullRequestFilter{
+		Repository: deref(request.Params.Repository),
+		Label:      deref(request.Params.Label),
+	}
+
+	result, err := h.service.GetStats(ctx, filter)
 	if err != nil {
-		return stats.GetStats500JSONResponse{
-			Error: struct {
-				Code    stats.ErrorResponseErrorCode `json:"code"`
-				Message string                       `json:"message"`
-			}{
-				Code:    stats.NOTFOUND,
-				Message: err.Error(),
-			},
-		}, nil
+		return nil, err
 	}
 
 	return stats.GetStats200JSONResponse(result), nil
@@ -5,7 +5,6 @@ import (
 	"PullRequestService/internal/teamService"
 	"PullRequestService/internal/web/teams"
 	"context"
-	"fmt"
 )
 
 type TeamHandler struct {
@@ -20,34 +19,15 @@ func NewTeamHandler(service teamService.TeamService) *TeamHandler {
 
 func (t *TeamHandler) GetTeamGet(ctx context.Context, request teams.GetTeamGetRequestObject) (teams.GetTeamGetResponseObject, error) {
 
-	team, err := t.service.GetTeam(request.Params.TeamName)
+	team, err := t.service.GetTeam(ctx, request.Params.TeamName)
 	if err != nil {
-		return teams.GetTeamGet404JSONResponse{
-			Error: struct {
-				Code    teams.ErrorResponseErrorCode `json:"code"`
-				Message string                       `json:"message"`
-			}{
-				Code:    teams.NOTFOUND,
-				Message: "resource not found",
-			},
-		}, nil
+		return nil, err
 	}
 
-	members := make([]teams.TeamMember, 0, len(team.Members))
-	for _, m := range team.Members {
-		members = append(members, teams.TeamMember{
-			UserId:   m.ID,
-			Username: m.Username,
-			IsActive: m.IsActive,
-		})
-	}
-
-	response := teams.GetTeamGet200JSONResponse{
-		TeamName: team.Name,
-		Members:  members,
-	}
-
-	return response, nil
+	return teams.GetTeamGet200JSONResponse{
+		Body:    toTeam(team),
+		Headers: teams.GetTeamGet200ResponseHeaders{ETag: domain.ETag(team.Version)},
+	}, nil
 
 }
 
@@ -56,36 +36,204 @@ func (t *TeamHandler) PostTeamAdd(ctx context.Context, request teams.PostTeamAdd
 
 	members := make([]domain.User, 0, len(req.Members))
 	for _, m := range req.Members {
-		members = append(members, domain.User{
-			ID:       m.UserId,
-			Username: m.Username,
-			IsActive: m.IsActive,
-		})
+		member := domain.User{
+			ID:             m.UserId,
+			Username:       m.Username,
+			IsActive:       m.IsActive,
+			MaxOpenReviews: m.MaxOpenReviews,
+		}
+		if m.Seniority != nil {
+			member.Seniority = string(*m.Seniority)
+		}
+		members = append(members, member)
 	}
 
 	team := domain.Team{
-		Name:    req.TeamName,
-		Members: members,
+		Name:                  req.TeamName,
+		DefaultMaxOpenReviews: req.DefaultMaxOpenReviews,
+		Members:               members,
+	}
+	if req.ReviewPolicy != nil {
+		team.ReviewPolicy = string(*req.ReviewPolicy)
+	}
+	if req.SizeRules != nil {
+		team.SizeRules = fromSizeRules(*req.SizeRules)
 	}
 
-	err := t.service.PostTeam(team)
+	err := t.service.PostTeam(ctx, team)
 	if err != nil {
-		return teams.PostTeamAdd400JSONResponse{
-			Error: struct {
-				Code    teams.ErrorResponseErrorCode `json:"code"`
-				Message string                       `json:"message"`
-			}{
-				Code:    teams.TEAMEXISTS,
-				Message: fmt.Sprintf("%s already exists", team.Name),
-			},
-		}, nil
+		return nil, err
 	}
 
+	version := domain.FirstVersion
 	response := teams.PostTeamAdd201JSONResponse{
-		Team: &teams.Team{
-			TeamName: team.Name,
-			Members:  req.Members,
-		},
+		Headers: teams.PostTeamAdd201ResponseHeaders{ETag: domain.ETag(version)},
+	}
+	response.Body.Team = &teams.Team{
+		TeamName:              team.Name,
+		DefaultMaxOpenReviews: team.DefaultMaxOpenReviews,
+		ReviewPolicy:          req.ReviewPolicy,
+		SizeRules:             req.SizeRules,
+		Members:               req.Members,
+		Version:               &version,
+	}
+	return response, nil
+}
+
+func (t *TeamHandler) PostTeamSetDefaultMaxOpenReviews(ctx context.Context, request teams.PostTeamSetDefaultMaxOpenReviewsRequestObject) (teams.PostTeamSetDefaultMaxOpenReviewsResponseObject, error) {
+	ctx = domain.WithIfMatch(ctx, request.Params.IfMatch)
+	req := request.Body
+	if req == nil {
+		return nil, errNoBody
+	}
+
+	team, err := t.service.SetDefaultMaxOpenReviews(ctx, req.TeamName, req.DefaultMaxOpenReviews)
+	if err != nil {
+		return nil, err
+	}
+
+	teamResponse := toTeam(team)
+	response := teams.PostTeamSetDefaultMaxOpenReviews200JSONResponse{
+		Headers: teams.PostTeamSetDefaultMaxOpenReviews200ResponseHeaders{ETag: domain.ETag(team.Version)},
 	}
+	response.Body.Team = &teamResponse
 	return response, nil
 }
+
+func (t *TeamHandler) PostTeamSetReviewPolicy(ctx context.Context, request teams.PostTeamSetReviewPolicyRequestObject) (teams.PostTeamSetReviewPolicyResponseObject, error) {
+	ctx = domain.WithIfMatch(ctx, request.Params.IfMatch)
+	req := request.Body
+	if req == nil {
+		return nil, errNoBody
+	}
+
+	team, err := t.service.SetReviewPolicy(ctx, req.TeamName, string(req.ReviewPolicy))
+	if err != nil {
+		return nil, err
+	}
+
+	teamResponse := toTeam(team)
+	response := teams.PostTeamSetReviewPolicy200JSONResponse{
+		Headers: teams.PostTeamSetReviewPolicy200ResponseHeaders{ETag: domain.ETag(team.Version)},
+	}
+	response.Body.Team = &teamResponse
+	return response, nil
+}
+
+func (t *TeamHandler) PostTeamSetSizeRules(ctx context.Context, request teams.PostTeamSetSizeRulesRequestObject) (teams.PostTeamSetSizeRulesResponseObject, error) {
+	ctx = domain.WithIfMatch(ctx, request.Params.IfMatch)
+	req := request.Body
+	if req == nil {
+		return nil, errNoBody
+	}
+
+	team, err := t.service.SetSizeRules(ctx, req.TeamName, fromSizeRules(req.SizeRules))
+	if err != nil {
+		return nil, err
+	}
+
+	teamResponse := toTeam(team)
+	response := teams.PostTeamSetSizeRules200JSONResponse{
+		Headers: teams.PostTeamSetSizeRules200ResponseHeaders{ETag: domain.ETag(team.Version)},
+	}
+	response.Body.Team = &teamResponse
+	return response, nil
+}
+
+func (t *TeamHandler) PostTeamSetFallbacks(ctx context.Context, request teams.PostTeamSetFallbacksRequestObject) (teams.PostTeamSetFallbacksResponseObject, error) {
+	ctx = domain.WithIfMatch(ctx, request.Params.IfMatch)
+	req := request.Body
+	if req == nil {
+		return nil, errNoBody
+	}
+
+	team, err := t.service.SetFallbacks(ctx, req.TeamName, req.FallbackTeams, req.ReviewerPools)
+	if err != nil {
+		return nil, err
+	}
+
+	teamResponse := toTeam(team)
+	response := teams.PostTeamSetFallbacks200JSONResponse{
+		Headers: teams.PostTeamSetFallbacks200ResponseHeaders{ETag: domain.ETag(team.Version)},
+	}
+	response.Body.Team = &teamResponse
+	return response, nil
+}
+
+func (t *TeamHandler) PostPoolsSet(ctx context.Context, request teams.PostPoolsSetRequestObject) (teams.PostPoolsSetResponseObject, error) {
+	req := request.Body
+	if req == nil {
+		return nil, errNoBody
+	}
+
+	pool, err := t.service.SetPool(ctx, domain.ReviewerPool{
+		Name:    req.PoolName,
+		UserIDs: req.UserIds,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return teams.PostPoolsSet200JSONResponse{
+		Pool: teams.ReviewerPool{
+			PoolName: pool.Name,
+			UserIds:  nonNil(pool.UserIDs),
+		},
+	}, nil
+}
+
+func (t *TeamHandler) GetPoolsGet(ctx context.Context, request teams.GetPoolsGetRequestObject) (teams.GetPoolsGetResponseObject, error) {
+	pool, err := t.service.GetPool(ctx, request.Params.PoolName)
+	if err != nil {
+		return nil, err
+	}
+
+	return teams.GetPoolsGet200JSONResponse{
+		PoolName: pool.Name,
+		UserIds:  nonNil(pool.UserIDs),
+	}, nil
+}
+
+func toTeam(team domain.Team) teams.Team {
+	members := make([]teams.TeamMember, 0, len(team.Members))
+	for _, m := range team.Members {
+		skills := nonNil(m.Skills)
+		seniority := teams.Seniority(m.Seniority)
+		members = append(members, teams.TeamMember{
+			UserId:         m.ID,
+			Username:       m.Username,
+			IsActive:       m.IsActive,
+			MaxOpenReviews: m.MaxOpenReviews,
+			Skills:         &skills,
+			Seniority:      &seniority,
+		})
+	}
+
+	fallbackTeams := nonNil(team.FallbackTeams)
+	reviewerPools := nonNil(team.ReviewerPools)
+
+	reviewPolicy := teams.ReviewPolicy(team.ReviewPolicy)
+	sizeRules := make([]teams.SizeRule, 0, len(team.SizeRules))
+	for _, rule := range team.SizeRules {
+		sizeRules = append(sizeRules, teams.SizeRule{MinLines: rule.MinLines, Reviewers: rule.Reviewers})
+	}
+
+	return teams.Team{
+		TeamName:              team.Name,
+		ReviewPolicy:          &reviewPolicy,
+		DefaultMaxOpenReviews: team.DefaultMaxOpenReviews,
+		FallbackTeams:         &fallbackTeams,
+		ReviewerPools:         &reviewerPools,
+		SizeRules:             &sizeRules,
+		Members:               members,
+		Version:               &team.Version,
+	}
+}
+
+func fromSizeRules(rules []teams.SizeRule) []domain.SizeRule {
+	result := make([]domain.SizeRule, 0, len(rules))
+	for _, rule := range rules {
+		result = append(result, domain.SizeRule{MinLines: rule.MinLines, Reviewers: rule.Reviewers})
+	}
+	return result
+}
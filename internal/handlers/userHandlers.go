@@ -1,10 +1,10 @@
 package handlers
 
 import (
+	"PullRequestService/domain"
 	"PullRequestService/internal/userService"
 	"PullRequestService/internal/web/users"
 	"context"
-	"errors"
 )
 
 type UserHandler struct {
@@ -19,25 +19,33 @@ func NewUserHandler(service userService.UserService) *UserHandler {
 
 func (u *UserHandler) GetUsersGetReview(ctx context.Context, request users.GetUsersGetReviewRequestObject) (users.GetUsersGetReviewResponseObject, error) {
 
-	prs, err := u.service.GetPRsForReviewer(request.Params.UserId)
+	prs, err := u.service.GetPRsForReviewer(ctx, request.Params.UserId)
 	if err != nil {
-		if err.Error() == "NOT_FOUND" {
-			return users.GetUsersGetReview200JSONResponse{
-				UserId:       request.Params.UserId,
-				PullRequests: []users.PullRequestShort{},
-			}, nil
-		}
 		return nil, err
 	}
 
 	shortPRs := make([]users.PullRequestShort, 0, len(prs))
 	for _, pr := range prs {
-		shortPRs = append(shortPRs, users.PullRequestShort{
+		priority := users.Priority(pr.Priority)
+		short := users.PullRequestShort{
 			PullRequestId:   pr.ID,
 			PullRequestName: pr.Name,
 			AuthorId:        pr.AuthorID,
 			Status:          users.PullRequestShortStatus(pr.Status),
-		})
+			Priority:        &priority,
+			DueAt:           pr.DueAt,
+			Repository:      optional(pr.Repository),
+			SourceBranch:    optional(pr.SourceBranch),
+			TargetBranch:    optional(pr.TargetBranch),
+			Url:             optional(pr.URL),
+			Additions:       optional(pr.Additions),
+			Deletions:       optional(pr.Deletions),
+		}
+		if len(pr.Labels) > 0 {
+			labels := pr.Labels
+			short.Labels = &labels
+		}
+		shortPRs = append(shortPRs, short)
 	}
 
 	return users.GetUsersGetReview200JSONResponse{
@@ -49,60 +57,225 @@ func (u *UserHandler) GetUsersGetReview(ctx context.Context, request users.GetUs
 func (u *UserHandler) PostUsersSetIsActive(ctx context.Context, request users.PostUsersSetIsActiveRequestObject) (users.PostUsersSetIsActiveResponseObject, error) {
 	req := request.Body
 	if req == nil {
-		return nil, errors.New("invalid request body")
+		return nil, errNoBody
 	}
 
-	user, err := u.service.SetIsActive(req.IsActive, req.UserId)
+	reassignReviews := req.ReassignReviews != nil && *req.ReassignReviews
+	restoreReviews := req.RestoreReviews != nil && *req.RestoreReviews
+
+	result, err := u.service.SetIsActive(ctx, req.IsActive, req.UserId, reassignReviews, restoreReviews)
 	if err != nil {
-		return users.PostUsersSetIsActive404JSONResponse{
-			Error: struct {
-				Code    users.ErrorResponseErrorCode `json:"code"`
-				Message string                       `json:"message"`
-			}{
-				Code:    users.NOTFOUND,
-				Message: "resource not found",
-			},
-		}, nil
-	}
-
-	return users.PostUsersSetIsActive200JSONResponse{
-		User: &users.User{
-			UserId:   user.ID,
-			Username: user.Username,
-			TeamName: user.TeamName,
-			IsActive: user.IsActive,
-		},
-	}, nil
+		return nil, err
+	}
 
+	response := users.PostUsersSetIsActive200JSONResponse{
+		User: toUser(result.User),
+	}
+	if reassignReviews && !req.IsActive {
+		reassignments := toReviewerReassignments(result.Reassignments)
+		response.Reassignments = &reassignments
+	}
+	if restoreReviews && req.IsActive {
+		restored := nonNil(result.RestoredPRIDs)
+		response.RestoredPullRequests = &restored
+	}
+
+	return response, nil
 }
 
 func (u *UserHandler) PostUsersDeactivate(ctx context.Context, request users.PostUsersDeactivateRequestObject) (users.PostUsersDeactivateResponseObject, error) {
 	req := request.Body
 	if req == nil {
-		return nil, errors.New("invalid body")
+		return nil, errNoBody
 	}
 
-	result, err := u.service.DeactivateAndReassign(req.TeamName, req.UserIds)
-	if err != nil {
-
-		if err.Error() == "TEAM_NOT_FOUND" {
-			return users.PostUsersDeactivate404JSONResponse{
-				Error: struct {
-					Code    users.ErrorResponseErrorCode `json:"code"`
-					Message string                       `json:"message"`
-				}{
-					Code:    users.NOTFOUND,
-					Message: "team not found",
-				},
-			}, nil
-		}
+	dryRun := req.DryRun != nil && *req.DryRun
 
+	result, err := u.service.DeactivateAndReassign(ctx, req.TeamName, req.UserIds, dryRun)
+	if err != nil {
 		return nil, err
 	}
+
 	return users.PostUsersDeactivate200JSONResponse{
 		TeamName:                 result.TeamName,
+		DryRun:                   result.DryRun,
 		DeactivatedCount:         result.DeactivatedCount,
 		AffectedPrCount:          result.AffectedPRCount,
 		ReassignedReviewersCount: result.ReassignedReviewersCount,
+		Reassignments:            toReviewerReassignments(result.Reassignments),
+	}, nil
+}
+
+func (u *UserHandler) PostUsersSetMaxOpenReviews(ctx context.Context, request users.PostUsersSetMaxOpenReviewsRequestObject) (users.PostUsersSetMaxOpenReviewsResponseObject, error) {
+	req := request.Body
+	if req == nil {
+		return nil, errNoBody
+	}
+
+	user, err := u.service.SetMaxOpenReviews(ctx, req.UserId, req.MaxOpenReviews)
+	if err != nil {
+		return nil, err
+	}
+
+	return users.PostUsersSetMaxOpenReviews200JSONResponse{
+		User: toUser(*user),
+	}, nil
+}
+
+func (u *UserHandler) PostUsersSetSeniority(ctx context.Context, request users.PostUsersSetSeniorityRequestObject) (users.PostUsersSetSeniorityResponseObject, error) {
+	req := request.Body
+	if req == nil {
+		return nil, errNoBody
+	}
+
+	user, err := u.service.SetSeniority(ctx, req.UserId, string(req.Seniority))
+	if err != nil {
+		return nil, err
+	}
+
+	return users.PostUsersSetSeniority200JSONResponse{
+		User: toUser(*user),
+	}, nil
+}
+
+func (u *UserHandler) GetUsersSkills(ctx context.Context, request users.GetUsersSkillsRequestObject) (users.GetUsersSkillsResponseObject, error) {
+	skills, err := u.service.GetSkills(ctx, request.Params.UserId)
+	if err != nil {
+		return nil, err
+	}
+
+	return users.GetUsersSkills200JSONResponse{
+		UserId: request.Params.UserId,
+		Skills: nonNil(skills),
+	}, nil
+}
+
+func (u *UserHandler) PostUsersSkillsAdd(ctx context.Context, request users.PostUsersSkillsAddRequestObject) (users.PostUsersSkillsAddResponseObject, error) {
+	req := request.Body
+	if req == nil {
+		return nil, errNoBody
+	}
+
+	skills, err := u.service.AddSkills(ctx, req.UserId, req.Skills)
+	if err != nil {
+		return nil, err
+	}
+
+	return users.PostUsersSkillsAdd200JSONResponse{
+		UserId: req.UserId,
+		Skills: nonNil(skills),
 	}, nil
 }
+
+func (u *UserHandler) PostUsersSkillsRemove(ctx context.Context, request users.PostUsersSkillsRemoveRequestObject) (users.PostUsersSkillsRemoveResponseObject, error) {
+	req := request.Body
+	if req == nil {
+		return nil, errNoBody
+	}
+
+	skills, err := u.service.RemoveSkills(ctx, req.UserId, req.Skills)
+	if err != nil {
+		return nil, err
+	}
+
+	return users.PostUsersSkillsRemove200JSONResponse{
+		UserId: req.UserId,
+		Skills: nonNil(skills),
+	}, nil
+}
+
+func (u *UserHandler) GetUsersExclusions(ctx context.Context, request users.GetUsersExclusionsRequestObject) (users.GetUsersExclusionsResponseObject, error) {
+	exclusions, err := u.service.GetExclusions(ctx, request.Params.UserId)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]users.ReviewerExclusion, 0, len(exclusions))
+	for _, e := range exclusions {
+		result = append(result, toReviewerExclusion(e))
+	}
+
+	return users.GetUsersExclusions200JSONResponse{
+		Exclusions: result,
+	}, nil
+}
+
+func (u *UserHandler) PostUsersExclusionsAdd(ctx context.Context, request users.PostUsersExclusionsAddRequestObject) (users.PostUsersExclusionsAddResponseObject, error) {
+	req := request.Body
+	if req == nil {
+		return nil, errNoBody
+	}
+
+	exclusion := domain.ReviewerExclusion{
+		AuthorID:   req.AuthorId,
+		ReviewerID: req.ReviewerId,
+		Symmetric:  req.Symmetric != nil && *req.Symmetric,
+	}
+	if req.Reason != nil {
+		exclusion.Reason = *req.Reason
+	}
+
+	exclusion, err := u.service.AddExclusion(ctx, exclusion)
+	if err != nil {
+		return nil, err
+	}
+
+	return users.PostUsersExclusionsAdd200JSONResponse{
+		Exclusion: toReviewerExclusion(exclusion),
+	}, nil
+}
+
+func (u *UserHandler) PostUsersExclusionsDelete(ctx context.Context, request users.PostUsersExclusionsDeleteRequestObject) (users.PostUsersExclusionsDeleteResponseObject, error) {
+	req := request.Body
+	if req == nil {
+		return nil, errNoBody
+	}
+
+	if err := u.service.RemoveExclusion(ctx, req.AuthorId, req.ReviewerId); err != nil {
+		return nil, err
+	}
+
+	return users.PostUsersExclusionsDelete204Response{}, nil
+}
+
+func toReviewerExclusion(e domain.ReviewerExclusion) users.ReviewerExclusion {
+	return users.ReviewerExclusion{
+		AuthorId:   e.AuthorID,
+		ReviewerId: e.ReviewerID,
+		Symmetric:  e.Symmetric,
+		Reason:     e.Reason,
+		CreatedAt:  e.CreatedAt,
+	}
+}
+
+func toUser(user domain.User) *users.User {
+	seniority := users.Seniority(user.Seniority)
+	return &users.User{
+		UserId:         user.ID,
+		Username:       user.Username,
+		TeamName:       user.TeamName,
+		IsActive:       user.IsActive,
+		MaxOpenReviews: user.MaxOpenReviews,
+		Seniority:      &seniority,
+	}
+}
+
+func toReviewerReassignments(items []domain.ReviewerReassignment) []users.ReviewerReassignment {
+	reassignments := make([]users.ReviewerReassignment, 0, len(items))
+	for _, r := range items {
+		item := users.ReviewerReassignment{
+			PullRequestId: r.PullRequestID,
+			OldReviewerId: r.OldReviewerID,
+		}
+		if r.NewReviewerID != "" {
+			newID := r.NewReviewerID
+			item.NewReviewerId = &newID
+		}
+		if r.PairingBroken {
+			pairingBroken := true
+			item.PairingBroken = &pairingBroken
+		}
+		reassignments = append(reassignments, item)
+	}
+	return reassignments
+}
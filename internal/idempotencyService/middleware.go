@@ -0,0 +1,123 @@
+package idempotencyService
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	echo "github.com/labstack/echo/v4"
+	"io"
+	"log"
+	"net/http"
+	"slices"
+)
+
+// HeaderIdempotencyKey names the key a client sends to make a POST safe to retry.
+const HeaderIdempotencyKey = "Idempotency-Key"
+
+// headerReplayed marks a response replayed from an earlier request.
+const headerReplayed = "Idempotent-Replayed"
+
+// Middleware makes POST requests carrying an Idempotency-Key run at most once per key
+// and caller. The first response, with the headers the handler set, is stored and
+// replayed to retries with the same method, path, query and body; a different request
+// by the caller with the key gets 422, a
+// retry that arrives while the first request is still running gets 409. Server errors
+// are not stored, so the request can be retried. It must run after the organization
+// is resolved.
+func Middleware(service IdempotencyService) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			req := c.Request()
+			key := req.Header.Get(HeaderIdempotencyKey)
+			if req.Method != http.MethodPost || key == "" {
+				return next(c)
+			}
+
+			body, err := io.ReadAll(req.Body)
+			if err != nil {
+				return err
+			}
+			req.Body = io.NopCloser(bytes.NewReader(body))
+
+			ctx := req.Context()
+			stored, err := service.Begin(ctx, key, requestHash(req, body))
+			if err != nil {
+				return err
+			}
+
+			if stored != nil {
+				header := c.Response().Header()
+				var replayed http.Header
+				if err := json.Unmarshal([]byte(stored.Headers), &replayed); err != nil {
+					log.Printf("idempotency: read stored headers for key %q: %v", key, err)
+				}
+				for name, values := range replayed {
+					header[name] = values
+				}
+				header.Set(headerReplayed, "true")
+				return c.Blob(stored.Status, stored.ContentType, stored.Body)
+			}
+
+			before := c.Response().Header().Clone()
+			recorder := &bodyRecorder{ResponseWriter: c.Response().Writer}
+			c.Response().Writer = recorder
+			if err := next(c); err != nil {
+				c.Error(err)
+			}
+
+			res := c.Response()
+			if res.Status >= http.StatusInternalServerError {
+				err = service.Release(ctx, key)
+			} else {
+				err = service.Complete(ctx, key, res.Status, res.Header().Get(echo.HeaderContentType),
+					handlerHeaders(before, res.Header()), recorder.body.Bytes())
+			}
+			if err != nil {
+				log.Printf("idempotency: store response for key %q: %v", key, err)
+			}
+			return nil
+		}
+	}
+}
+
+// handlerHeaders returns, as a JSON object, the response headers set after before was
+// taken, i.e. by the handler rather than by middleware like the request ID or the rate
+// limit. Content-Type is stored on its own and Content-Length follows the body.
+func handlerHeaders(before, after http.Header) string {
+	set := make(http.Header)
+	for name, values := range after {
+		if name == echo.HeaderContentType || name == echo.HeaderContentLength {
+			continue
+		}
+		if slices.Equal(before[name], values) {
+			continue
+		}
+		set[name] = values
+	}
+
+	data, err := json.Marshal(set)
+	if err != nil {
+		return "{}"
+	}
+	return string(data)
+}
+
+// requestHash identifies a request by its method, path, query and body.
+func requestHash(req *http.Request, body []byte) string {
+	h := sha256.New()
+	io.WriteString(h, req.Method+" "+req.URL.Path+"?"+req.URL.RawQuery+"\n")
+	h.Write(body)
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// bodyRecorder keeps a copy of the response body while it is written.
+type bodyRecorder struct {
+	http.ResponseWriter
+	body bytes.Buffer
+}
+
+func (r *bodyRecorder) Write(b []byte) (int, error) {
+	r.body.Write(b)
+	return r.ResponseWriter.Write(b)
+}
@@ -0,0 +1,228 @@
+package idempotencyService
+
+import (
+	"PullRequestService/domain"
+	"encoding/json"
+	"errors"
+	echo "github.com/labstack/echo/v4"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+// memoryRepository keeps the records of one caller in a map, with the claim rules
+// of the Postgres repository.
+type memoryRepository struct {
+	mu      sync.Mutex
+	records map[string]*domain.IdempotencyRecord
+}
+
+func newMemoryRepository() *memoryRepository {
+	return &memoryRepository{records: make(map[string]*domain.IdempotencyRecord)}
+}
+
+func (r *memoryRepository) Reserve(org, actor, key, hash string, expiredBefore, abandonedBefore time.Time) (domain.IdempotencyRecord, bool, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	record, ok := r.records[key]
+	if !ok || record.CreatedAt.Before(expiredBefore) || (record.CompletedAt == nil && record.CreatedAt.Before(abandonedBefore)) {
+		r.records[key] = &domain.IdempotencyRecord{Organization: org, Actor: actor, Key: key, RequestHash: hash, Headers: "{}", CreatedAt: time.Now()}
+		return domain.IdempotencyRecord{}, true, nil
+	}
+	return *record, false, nil
+}
+
+func (r *memoryRepository) Complete(org, actor, key string, status int, contentType, headers string, body []byte) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if record, ok := r.records[key]; ok && record.CompletedAt == nil {
+		now := time.Now()
+		record.Status, record.ContentType, record.Headers, record.Body, record.CompletedAt = status, contentType, headers, body, &now
+	}
+	return nil
+}
+
+func (r *memoryRepository) Release(org, actor, key string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if record, ok := r.records[key]; ok && record.CompletedAt == nil {
+		delete(r.records, key)
+	}
+	return nil
+}
+
+func (r *memoryRepository) Prune(before time.Time) (int64, error) {
+	return 0, nil
+}
+
+func TestRequestHash(t *testing.T) {
+	hash := func(method, target, body string) string {
+		return requestHash(httptest.NewRequest(method, target, nil), []byte(body))
+	}
+
+	base := hash(http.MethodPost, "/pullRequest/create?dry_run=true", `{"id":"pr-1"}`)
+	if got := hash(http.MethodPost, "/pullRequest/create?dry_run=true", `{"id":"pr-1"}`); got != base {
+		t.Fatalf("the same request hashed to %s and %s", base, got)
+	}
+
+	for name, other := range map[string]string{
+		"method": hash(http.MethodPut, "/pullRequest/create?dry_run=true", `{"id":"pr-1"}`),
+		"path":   hash(http.MethodPost, "/pullRequest/merge?dry_run=true", `{"id":"pr-1"}`),
+		"query":  hash(http.MethodPost, "/pullRequest/create", `{"id":"pr-1"}`),
+		"body":   hash(http.MethodPost, "/pullRequest/create?dry_run=true", `{"id":"pr-2"}`),
+	} {
+		if other == base {
+			t.Errorf("a request with another %s has the same hash", name)
+		}
+	}
+}
+
+func TestHandlerHeaders(t *testing.T) {
+	before := http.Header{
+		echo.HeaderXRequestID: {"req-1"},
+		"Ratelimit-Limit":     {"100"},
+		"Ratelimit-Remaining": {"99"},
+	}
+	after := http.Header{
+		echo.HeaderXRequestID:    {"req-1"},
+		"Ratelimit-Limit":        {"100"},
+		"Ratelimit-Remaining":    {"98"},
+		echo.HeaderContentType:   {"application/json"},
+		echo.HeaderContentLength: {"42"},
+		echo.HeaderLocation:      {"/pullRequest/pr-1"},
+		"Etag":                   {`"1"`},
+	}
+
+	var got http.Header
+	if err := json.Unmarshal([]byte(handlerHeaders(before, after)), &got); err != nil {
+		t.Fatal(err)
+	}
+	want := http.Header{
+		"Ratelimit-Remaining": {"98"},
+		echo.HeaderLocation:   {"/pullRequest/pr-1"},
+		"Etag":                {`"1"`},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("handlerHeaders = %v, want %v", got, want)
+	}
+
+	if got := handlerHeaders(before, before); got != "{}" {
+		t.Errorf("handlerHeaders without changes = %s, want {}", got)
+	}
+}
+
+func TestMiddleware(t *testing.T) {
+	e := echo.New()
+	e.HTTPErrorHandler = func(err error, c echo.Context) {
+		var derr *domain.Error
+		if errors.As(err, &derr) {
+			c.String(derr.Status, derr.Code)
+			return
+		}
+		e.DefaultHTTPErrorHandler(err, c)
+	}
+
+	var (
+		calls  int
+		nested func() *httptest.ResponseRecorder
+		failed bool
+	)
+	e.POST("/pullRequest/create", func(c echo.Context) error {
+		calls++
+		if nested != nil {
+			// A retry arriving while this request still holds the key.
+			if rec := nested(); rec.Code != http.StatusConflict || rec.Body.String() != ErrKeyInProgress.Code {
+				t.Errorf("retry during the request = %d %s, want 409 %s", rec.Code, rec.Body, ErrKeyInProgress.Code)
+			}
+		}
+		if failed {
+			return errors.New("database is down")
+		}
+		c.Response().Header().Set(echo.HeaderLocation, "/pullRequest/pr-1")
+		return c.JSON(http.StatusCreated, map[string]int{"call": calls})
+	}, Middleware(NewIdempotencyService(newMemoryRepository(), time.Hour)))
+
+	send := func(key, body string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodPost, "/pullRequest/create", strings.NewReader(body))
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		if key != "" {
+			req.Header.Set(HeaderIdempotencyKey, key)
+		}
+		rec := httptest.NewRecorder()
+		e.ServeHTTP(rec, req)
+		return rec
+	}
+
+	t.Run("replay", func(t *testing.T) {
+		first := send("k1", `{"id":"pr-1"}`)
+		retry := send("k1", `{"id":"pr-1"}`)
+		if first.Code != http.StatusCreated || calls != 1 {
+			t.Fatalf("first request = %d after %d calls, want 201 after 1", first.Code, calls)
+		}
+		if retry.Code != http.StatusCreated || retry.Body.String() != first.Body.String() {
+			t.Errorf("retry = %d %s, want the stored 201 %s", retry.Code, retry.Body, first.Body)
+		}
+		if retry.Header().Get(headerReplayed) != "true" || retry.Header().Get(echo.HeaderLocation) != "/pullRequest/pr-1" {
+			t.Errorf("retry headers = %v, want the replay marker and the handler's Location", retry.Header())
+		}
+	})
+
+	t.Run("different body", func(t *testing.T) {
+		rec := send("k1", `{"id":"pr-2"}`)
+		if rec.Code != http.StatusUnprocessableEntity || rec.Body.String() != ErrKeyReused.Code {
+			t.Errorf("reused key = %d %s, want 422 %s", rec.Code, rec.Body, ErrKeyReused.Code)
+		}
+		if calls != 1 {
+			t.Errorf("handler ran %d times, want once", calls)
+		}
+	})
+
+	t.Run("in progress", func(t *testing.T) {
+		nested = func() *httptest.ResponseRecorder { return send("k2", `{"id":"pr-1"}`) }
+		defer func() { nested = nil }()
+
+		if rec := send("k2", `{"id":"pr-1"}`); rec.Code != http.StatusCreated {
+			t.Errorf("first request = %d, want 201", rec.Code)
+		}
+		if calls != 2 {
+			t.Errorf("handler ran %d times, want 2", calls)
+		}
+	})
+
+	t.Run("server error is not stored", func(t *testing.T) {
+		failed = true
+		if rec := send("k3", `{"id":"pr-1"}`); rec.Code != http.StatusInternalServerError {
+			t.Errorf("failing request = %d, want 500", rec.Code)
+		}
+		failed = false
+		if rec := send("k3", `{"id":"pr-1"}`); rec.Code != http.StatusCreated || rec.Header().Get(headerReplayed) != "" {
+			t.Errorf("retry = %d replayed %q, want a fresh 201", rec.Code, rec.Header().Get(headerReplayed))
+		}
+		if calls != 4 {
+			t.Errorf("handler ran %d times, want 4", calls)
+		}
+	})
+
+	t.Run("without key", func(t *testing.T) {
+		send("", `{"id":"pr-1"}`)
+		if rec := send("", `{"id":"pr-1"}`); rec.Code != http.StatusCreated || rec.Header().Get(headerReplayed) != "" {
+			t.Errorf("request without key = %d replayed %q, want a fresh 201", rec.Code, rec.Header().Get(headerReplayed))
+		}
+		if calls != 6 {
+			t.Errorf("handler ran %d times, want 6", calls)
+		}
+	})
+
+	t.Run("invalid key", func(t *testing.T) {
+		if rec := send(strings.Repeat("k", maxKeyLength+1), `{}`); rec.Code != http.StatusBadRequest {
+			t.Errorf("too long key = %d, want 400", rec.Code)
+		}
+	})
+}
@@ -0,0 +1,76 @@
+package idempotencyService
+
+import (
+	"PullRequestService/domain"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+type IdempotencyRepository interface {
+	Reserve(org, actor, key, hash string, expiredBefore, abandonedBefore time.Time) (domain.IdempotencyRecord, bool, error)
+	Complete(org, actor, key string, status int, contentType, headers string, body []byte) error
+	Release(org, actor, key string) error
+	Prune(before time.Time) (int64, error)
+}
+
+type idempotencyRepository struct {
+	db *gorm.DB
+}
+
+func NewIdempotencyRepository(db *gorm.DB) IdempotencyRepository {
+	return &idempotencyRepository{db: db}
+}
+
+// Reserve claims the caller's key for a new request. It succeeds when the key is unused,
+// expired or held by a request that was abandoned without a response; otherwise it
+// returns the stored record. The insert is a single statement, so of two concurrent
+// requests with the same key only one gets it.
+func (r *idempotencyRepository) Reserve(org, actor, key, hash string, expiredBefore, abandonedBefore time.Time) (domain.IdempotencyRecord, bool, error) {
+	var reserved []string
+	err := r.db.Raw(`
+		INSERT INTO idempotency_keys (organization, actor, key, request_hash, created_at)
+		VALUES (?, ?, ?, ?, NOW())
+		ON CONFLICT (organization, actor, key) DO UPDATE
+		SET request_hash = EXCLUDED.request_hash, status = 0, content_type = '', headers = '{}',
+			body = NULL, created_at = EXCLUDED.created_at, completed_at = NULL
+		WHERE idempotency_keys.created_at < ?
+			OR (idempotency_keys.completed_at IS NULL AND idempotency_keys.created_at < ?)
+		RETURNING key`,
+		org, actor, key, hash, expiredBefore, abandonedBefore).
+		Scan(&reserved).Error
+	if err != nil {
+		return domain.IdempotencyRecord{}, false, err
+	}
+	if len(reserved) > 0 {
+		return domain.IdempotencyRecord{}, true, nil
+	}
+
+	var record domain.IdempotencyRecord
+	err = r.db.First(&record, "organization = ? AND actor = ? AND key = ?", org, actor, key).Error
+	return record, false, err
+}
+
+func (r *idempotencyRepository) Complete(org, actor, key string, status int, contentType, headers string, body []byte) error {
+	return r.db.Model(&domain.IdempotencyRecord{}).
+		Where("organization = ? AND actor = ? AND key = ? AND completed_at IS NULL", org, actor, key).
+		Updates(map[string]any{
+			"status":       status,
+			"content_type": contentType,
+			"headers":      headers,
+			"body":         body,
+			"completed_at": gorm.Expr("NOW()"),
+		}).Error
+}
+
+// Release frees a key whose request has not completed, so it can be retried.
+func (r *idempotencyRepository) Release(org, actor, key string) error {
+	return r.db.
+		Where("organization = ? AND actor = ? AND key = ? AND completed_at IS NULL", org, actor, key).
+		Delete(&domain.IdempotencyRecord{}).Error
+}
+
+func (r *idempotencyRepository) Prune(before time.Time) (int64, error) {
+	res := r.db.Where("created_at < ?", before).Delete(&domain.IdempotencyRecord{})
+	return res.RowsAffected, res.Error
+}
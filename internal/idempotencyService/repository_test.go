@@ -0,0 +1,68 @@
+package idempotencyService
+
+import (
+	"PullRequestService/internal/testdb"
+	"testing"
+	"time"
+)
+
+func TestRepositoryReserve(t *testing.T) {
+	repo := NewIdempotencyRepository(testdb.Open(t))
+	now := time.Now()
+	past := now.Add(-time.Hour)
+
+	reserve := func(org, actor, key, hash string, expiredBefore, abandonedBefore time.Time, want bool) {
+		t.Helper()
+		record, reserved, err := repo.Reserve(org, actor, key, hash, expiredBefore, abandonedBefore)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if reserved != want {
+			t.Fatalf("Reserve(%s, %s, %s) = %v with %+v, want %v", org, actor, key, reserved, record, want)
+		}
+	}
+
+	reserve("acme", "alice", "k1", "h1", past, past, true)
+	// Held by a running request, then by a completed one.
+	reserve("acme", "alice", "k1", "h2", past, past, false)
+	if err := repo.Complete("acme", "alice", "k1", 201, "application/json", `{"Location":["/pr-1"]}`, []byte(`{}`)); err != nil {
+		t.Fatal(err)
+	}
+	record, reserved, err := repo.Reserve("acme", "alice", "k1", "h1", past, past)
+	if err != nil || reserved {
+		t.Fatalf("Reserve of a completed key = %v, %v", reserved, err)
+	}
+	if record.RequestHash != "h1" || record.Status != 201 || record.CompletedAt == nil || string(record.Body) != `{}` {
+		t.Errorf("stored record = %+v", record)
+	}
+
+	// Keys belong to the caller.
+	reserve("acme", "bob", "k1", "h1", past, past, true)
+	reserve("globex", "alice", "k1", "h1", past, past, true)
+
+	// An expired key and an abandoned one are taken over; a completed one is not abandoned.
+	reserve("acme", "alice", "k1", "h3", now.Add(time.Minute), past, true)
+	reserve("acme", "bob", "k1", "h3", past, now.Add(time.Minute), true)
+	if err := repo.Complete("globex", "alice", "k1", 200, "", "{}", nil); err != nil {
+		t.Fatal(err)
+	}
+	reserve("globex", "alice", "k1", "h3", past, now.Add(time.Minute), false)
+
+	// Release frees only a key without a response.
+	if err := repo.Release("acme", "alice", "k1"); err != nil {
+		t.Fatal(err)
+	}
+	reserve("acme", "alice", "k1", "h4", past, past, true)
+	if err := repo.Release("globex", "alice", "k1"); err != nil {
+		t.Fatal(err)
+	}
+	reserve("globex", "alice", "k1", "h4", past, past, false)
+
+	pruned, err := repo.Prune(now.Add(time.Minute))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if pruned != 3 {
+		t.Errorf("Prune deleted %d records, want 3", pruned)
+	}
+}
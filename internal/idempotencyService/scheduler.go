@@ -0,0 +1,26 @@
+package idempotencyService
+
+import (
+	"context"
+	"log"
+	"time"
+)
+
+// RunPruning periodically deletes the stored responses that outlived their TTL.
+func RunPruning(service IdempotencyService, interval time.Duration) {
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		for ; ; <-ticker.C {
+			count, err := service.Prune(context.Background())
+			if err != nil {
+				log.Printf("idempotency pruning failed: %v", err)
+				continue
+			}
+			if count > 0 {
+				log.Printf("idempotency pruning: %d keys deleted", count)
+			}
+		}
+	}()
+}
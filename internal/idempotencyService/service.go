@@ -0,0 +1,94 @@
+package idempotencyService
+
+import (
+	"PullRequestService/domain"
+	"context"
+	"errors"
+	"net/http"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+type IdempotencyService interface {
+	Begin(ctx context.Context, key, hash string) (*domain.IdempotencyRecord, error)
+	Complete(ctx context.Context, key string, status int, contentType, headers string, body []byte) error
+	Release(ctx context.Context, key string) error
+	Prune(ctx context.Context) (int64, error)
+}
+
+var (
+	// ErrInvalidKey is returned for an empty or too long Idempotency-Key.
+	ErrInvalidKey = domain.ErrBadRequest.Msg("invalid idempotency key")
+	// ErrKeyReused is returned when a key comes back with a different request.
+	ErrKeyReused = domain.NewError("IDEMPOTENCY_KEY_REUSED", http.StatusUnprocessableEntity,
+		"idempotency key was already used for a different request")
+	// ErrKeyInProgress is returned while the first request with the key is running.
+	ErrKeyInProgress = domain.NewError("IDEMPOTENCY_KEY_IN_PROGRESS", http.StatusConflict,
+		"a request with this idempotency key is still in progress")
+)
+
+const (
+	maxKeyLength = 255
+	// abandonAfter is how long a request may hold its key without a response before
+	// another request with the key may take over, e.g. after a crash.
+	abandonAfter = 5 * time.Minute
+)
+
+type idempotencyService struct {
+	repo IdempotencyRepository
+	ttl  time.Duration
+}
+
+// NewIdempotencyService builds the service. Stored responses are replayed for ttl
+// after the first request.
+func NewIdempotencyService(repo IdempotencyRepository, ttl time.Duration) IdempotencyService {
+	return &idempotencyService{repo: repo, ttl: ttl}
+}
+
+// Begin claims the key for the request with the given hash. Keys are scoped to the
+// caller, so other callers may use the same key. It returns nil when the request
+// should run, or the stored response of an earlier identical request.
+func (s *idempotencyService) Begin(ctx context.Context, key, hash string) (*domain.IdempotencyRecord, error) {
+	if key == "" || len(key) > maxKeyLength {
+		return nil, ErrInvalidKey.Detailf("key must be 1 to %d characters", maxKeyLength)
+	}
+
+	now := time.Now()
+	record, reserved, err := s.repo.Reserve(domain.OrganizationFrom(ctx), domain.ActorFrom(ctx), key, hash, now.Add(-s.ttl), now.Add(-abandonAfter))
+	if err != nil {
+		// The key was released between the insert and the read: the first request
+		// failed and a retry may go ahead soon.
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrKeyInProgress
+		}
+		return nil, err
+	}
+	if reserved {
+		return nil, nil
+	}
+
+	if record.RequestHash != hash {
+		return nil, ErrKeyReused
+	}
+	if record.CompletedAt == nil {
+		return nil, ErrKeyInProgress
+	}
+	return &record, nil
+}
+
+// Complete stores the response of the request holding the key; headers is a JSON
+// object of the response headers to replay.
+func (s *idempotencyService) Complete(ctx context.Context, key string, status int, contentType, headers string, body []byte) error {
+	return s.repo.Complete(domain.OrganizationFrom(ctx), domain.ActorFrom(ctx), key, status, contentType, headers, body)
+}
+
+// Release gives up the key without a response, so a retry runs the request again.
+func (s *idempotencyService) Release(ctx context.Context, key string) error {
+	return s.repo.Release(domain.OrganizationFrom(ctx), domain.ActorFrom(ctx), key)
+}
+
+// Prune deletes the records older than the TTL and returns how many were deleted.
+func (s *idempotencyService) Prune(ctx context.Context) (int64, error) {
+	return s.repo.Prune(time.Now().Add(-s.ttl))
+}
@@ -0,0 +1,94 @@
+package ownershipService
+
+import (
+	"PullRequestService/domain"
+	"bufio"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// codeownersLine is one parsed line of a CODEOWNERS file with its raw owner handles.
+type codeownersLine struct {
+	Pattern string
+	Owners  []string
+}
+
+// parseCodeowners reads a CODEOWNERS file. Owners are kept as written ("@alice",
+// "@org/backend"); resolving them is up to the caller.
+func parseCodeowners(r io.Reader) ([]codeownersLine, error) {
+	var lines []codeownersLine
+	scanner := bufio.NewScanner(r)
+	number := 0
+	for scanner.Scan() {
+		number++
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		if i := strings.Index(line, " #"); i >= 0 {
+			line = strings.TrimSpace(line[:i])
+		}
+
+		fields := strings.Fields(line)
+		if _, err := compilePattern(fields[0]); err != nil {
+			return nil, fmt.Errorf("line %d: %w", number, err)
+		}
+		lines = append(lines, codeownersLine{Pattern: fields[0], Owners: fields[1:]})
+	}
+	return lines, scanner.Err()
+}
+
+// resolveOwners turns owner handles into users and teams. "@org/team" always refers to
+// a team, "@name" to a user with that id or, if there is none, to a team with that name.
+func resolveOwners(lines []codeownersLine, users, teams map[string]struct{}) ([]domain.OwnershipRule, []string) {
+	var (
+		rules    []domain.OwnershipRule
+		unknown  []string
+		reported = make(map[string]struct{})
+	)
+
+	for i, l := range lines {
+		rule := domain.OwnershipRule{Position: i, Pattern: l.Pattern}
+		for _, owner := range l.Owners {
+			handle := strings.TrimPrefix(owner, "@")
+			if _, team, ok := strings.Cut(handle, "/"); ok {
+				if _, exists := teams[team]; exists {
+					rule.OwnerTeams = append(rule.OwnerTeams, team)
+					continue
+				}
+			} else if strings.HasPrefix(owner, "@") {
+				if _, exists := users[handle]; exists {
+					rule.OwnerUsers = append(rule.OwnerUsers, handle)
+					continue
+				}
+				if _, exists := teams[handle]; exists {
+					rule.OwnerTeams = append(rule.OwnerTeams, handle)
+					continue
+				}
+			}
+			if _, ok := reported[owner]; !ok {
+				reported[owner] = struct{}{}
+				unknown = append(unknown, owner)
+			}
+		}
+		rules = append(rules, rule)
+	}
+
+	return rules, unknown
+}
+
+// ownerHandles lists every handle mentioned in the file, without the "@" and org prefix.
+func ownerHandles(lines []codeownersLine) []string {
+	var handles []string
+	for _, l := range lines {
+		for _, owner := range l.Owners {
+			handle := strings.TrimPrefix(owner, "@")
+			if _, team, ok := strings.Cut(handle, "/"); ok {
+				handle = team
+			}
+			handles = append(handles, handle)
+		}
+	}
+	return handles
+}
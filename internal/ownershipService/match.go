@@ -0,0 +1,117 @@
+package ownershipService
+
+import (
+	"PullRequestService/domain"
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// compilePattern converts a CODEOWNERS pattern into a regular expression. A pattern
+// without a slash matches at any depth, a leading or inner slash anchors it to the
+// repository root, "*" and "?" stay within one path segment and "**" crosses them.
+// A pattern that names a directory matches everything below it. As in GitHub, a
+// pattern ending in "/*" matches the files of that directory but not the nested ones.
+// "/" alone names no file and is rejected; "*" owns every file.
+func compilePattern(pattern string) (*regexp.Regexp, error) {
+	p := strings.TrimSpace(pattern)
+	if p == "" {
+		return nil, fmt.Errorf("empty pattern")
+	}
+
+	anchored := strings.HasPrefix(p, "/")
+	p = strings.TrimPrefix(p, "/")
+	dirOnly := strings.HasSuffix(p, "/")
+	p = strings.TrimSuffix(p, "/")
+	if p == "" {
+		return nil, fmt.Errorf("pattern %q matches no file, use * for every file", pattern)
+	}
+	if strings.Contains(p, "/") {
+		anchored = true
+	}
+	filesOnly := strings.HasSuffix(p, "/*")
+
+	var b strings.Builder
+	if anchored {
+		b.WriteString("^")
+	} else {
+		b.WriteString("^(?:.*/)?")
+	}
+
+	for i := 0; i < len(p); i++ {
+		switch c := p[i]; c {
+		case '*':
+			if i+1 < len(p) && p[i+1] == '*' {
+				i++
+				if i+1 < len(p) && p[i+1] == '/' {
+					i++
+					b.WriteString("(?:.*/)?")
+				} else {
+					b.WriteString(".*")
+				}
+			} else {
+				b.WriteString("[^/]*")
+			}
+		case '?':
+			b.WriteString("[^/]")
+		case '\\':
+			if i+1 < len(p) {
+				i++
+				b.WriteString(regexp.QuoteMeta(string(p[i])))
+			}
+		default:
+			b.WriteString(regexp.QuoteMeta(string(c)))
+		}
+	}
+
+	switch {
+	case dirOnly:
+		b.WriteString("/.*$")
+	case filesOnly:
+		b.WriteString("$")
+	default:
+		b.WriteString("(?:/.*)?$")
+	}
+
+	return regexp.Compile(b.String())
+}
+
+// TouchedRules returns the rules that own at least one of the paths, in rule order.
+// For every path only the last matching rule counts. Patterns are checked when the
+// rules are imported; a "/" rule saved before it was rejected matches nothing, as it
+// always did, instead of failing every PR.
+func TouchedRules(rules []domain.OwnershipRule, paths []string) ([]domain.OwnershipRule, error) {
+	compiled := make([]*regexp.Regexp, len(rules))
+	for i, r := range rules {
+		if strings.Trim(strings.TrimSpace(r.Pattern), "/") == "" {
+			continue
+		}
+		re, err := compilePattern(r.Pattern)
+		if err != nil {
+			return nil, fmt.Errorf("rule %q: %w", r.Pattern, err)
+		}
+		compiled[i] = re
+	}
+
+	touched := make(map[int]struct{})
+	for _, path := range paths {
+		path = strings.TrimPrefix(strings.TrimPrefix(strings.TrimSpace(path), "./"), "/")
+		if path == "" {
+			continue
+		}
+		for i := len(rules) - 1; i >= 0; i-- {
+			if compiled[i] != nil && compiled[i].MatchString(path) {
+				touched[i] = struct{}{}
+				break
+			}
+		}
+	}
+
+	var result []domain.OwnershipRule
+	for i, r := range rules {
+		if _, ok := touched[i]; ok && (len(r.OwnerUsers) > 0 || len(r.OwnerTeams) > 0) {
+			result = append(result, r)
+		}
+	}
+	return result, nil
+}
@@ -0,0 +1,111 @@
+package ownershipService
+
+import (
+	"PullRequestService/domain"
+	"reflect"
+	"testing"
+)
+
+func TestCompilePattern(t *testing.T) {
+	tests := []struct {
+		pattern string
+		path    string
+		want    bool
+	}{
+		// Without a slash a pattern matches at any depth.
+		{"*.go", "main.go", true},
+		{"*.go", "internal/app/app.go", true},
+		{"*.go", "main.gohtml", false},
+		{"Makefile", "tools/Makefile", true},
+		// "*" and "?" stay within one segment.
+		{"/internal/*.go", "internal/app.go", true},
+		{"/internal/*.go", "internal/app/app.go", false},
+		{"/cmd/ma?n.go", "cmd/main.go", true},
+		{"/cmd/ma?n.go", "cmd/ma/n.go", false},
+		// A pattern ending in /* owns the files of the directory, not nested ones.
+		{"docs/*", "docs/getting-started.md", true},
+		{"docs/*", "docs/build-app/troubleshooting.md", false},
+		// "**/" matches any number of directories, "/**" everything below.
+		{"**/logs", "logs/today.log", true},
+		{"**/logs", "build/logs/today.log", true},
+		{"/build/**/out.txt", "build/out.txt", true},
+		{"/build/**/out.txt", "build/a/b/out.txt", true},
+		{"/vendor/**", "vendor/github.com/lib/x.go", true},
+		{"/vendor/**", "internal/vendor/x.go", false},
+		// A directory pattern owns everything below it but not a file of that name.
+		{"apps/", "apps/web/index.js", true},
+		{"apps/", "services/apps/api.go", true},
+		{"apps/", "apps", false},
+		{"/apps/", "services/apps/api.go", false},
+		// A leading or inner slash anchors to the root.
+		{"/README.md", "README.md", true},
+		{"/README.md", "docs/README.md", false},
+		{"internal/app", "internal/app/app.go", true},
+		{"internal/app", "x/internal/app/app.go", false},
+		// Everything.
+		{"*", "a/b/c.txt", true},
+		// Escapes and regexp metacharacters are literal.
+		{`\*.md`, "*.md", true},
+		{`\*.md`, "README.md", false},
+		{"a+b.txt", "a+b.txt", true},
+		{"a+b.txt", "aab.txt", false},
+	}
+	for _, tt := range tests {
+		re, err := compilePattern(tt.pattern)
+		if err != nil {
+			t.Fatalf("compilePattern(%q): %v", tt.pattern, err)
+		}
+		if got := re.MatchString(tt.path); got != tt.want {
+			t.Errorf("%q matches %q = %v, want %v", tt.pattern, tt.path, got, tt.want)
+		}
+	}
+}
+
+func TestCompilePatternRejects(t *testing.T) {
+	for _, pattern := range []string{"", "  ", "/", "//"} {
+		if _, err := compilePattern(pattern); err == nil {
+			t.Errorf("compilePattern(%q) succeeded", pattern)
+		}
+	}
+}
+
+func TestTouchedRules(t *testing.T) {
+	rules := []domain.OwnershipRule{
+		{Position: 0, Pattern: "*", OwnerTeams: []string{"core"}},
+		{Position: 1, Pattern: "/internal/", OwnerTeams: []string{"backend"}},
+		{Position: 2, Pattern: "*.md", OwnerUsers: []string{"writer"}},
+		{Position: 3, Pattern: "/internal/generated/"},
+		{Position: 4, Pattern: "/"},
+	}
+	positions := func(rules []domain.OwnershipRule) []int {
+		var result []int
+		for _, r := range rules {
+			result = append(result, r.Position)
+		}
+		return result
+	}
+
+	tests := []struct {
+		name  string
+		paths []string
+		want  []int
+	}{
+		{"last match wins", []string{"internal/app/app.go"}, []int{1}},
+		{"later rule for docs inside internal", []string{"internal/README.md"}, []int{2}},
+		{"catch-all", []string{"go.mod"}, []int{0}},
+		{"rule without owners removes ownership", []string{"internal/generated/api.gen.go"}, nil},
+		{"paths are normalized", []string{"./internal/app.go", "/docs/guide.md", " "}, []int{1, 2}},
+		{"rule order", []string{"README.md", "Dockerfile", "internal/db/db.go"}, []int{0, 1, 2}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := TouchedRules(rules, tt.paths)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(positions(got), tt.want) {
+				t.Errorf("TouchedRules = %v, want %v", positions(got), tt.want)
+			}
+		})
+	}
+}
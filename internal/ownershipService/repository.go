@@ -0,0 +1,120 @@
+package ownershipService
+
+import (
+	"PullRequestService/domain"
+	"gorm.io/gorm"
+)
+
+// OwnershipRepository works within one organization, passed as org to every method.
+type OwnershipRepository interface {
+	GetRules(org string) ([]domain.OwnershipRule, error)
+	ReplaceRules(org string, rules []domain.OwnershipRule) error
+	ExistingUsers(org string, ids []string) (map[string]struct{}, error)
+	ExistingTeams(org string, names []string) (map[string]struct{}, error)
+}
+
+type ownershipRepository struct {
+	db *gorm.DB
+}
+
+func NewOwnershipRepository(db *gorm.DB) OwnershipRepository {
+	return &ownershipRepository{db: db}
+}
+
+// ruleRow is one owner of a rule; a rule without owners is stored as a single row
+// with both owner columns empty.
+type ruleRow struct {
+	Organization string  `gorm:"column:organization"`
+	Position     int     `gorm:"column:position"`
+	Pattern      string  `gorm:"column:pattern"`
+	OwnerUserID  *string `gorm:"column:owner_user_id"`
+	OwnerTeam    *string `gorm:"column:owner_team"`
+}
+
+func (r *ownershipRepository) GetRules(org string) ([]domain.OwnershipRule, error) {
+	return GetRules(r.db, org)
+}
+
+// GetRules loads the organization's ownership rules in evaluation order. It is exported
+// so the PR assignment can read the rules without depending on this package's repository.
+func GetRules(db *gorm.DB, org string) ([]domain.OwnershipRule, error) {
+	var rows []ruleRow
+	err := db.Table("ownership_rules").
+		Select("position, pattern, owner_user_id, owner_team").
+		Where("organization = ?", org).
+		Order("position, id").
+		Scan(&rows).Error
+	if err != nil {
+		return nil, err
+	}
+
+	var rules []domain.OwnershipRule
+	for _, row := range rows {
+		if len(rules) == 0 || rules[len(rules)-1].Position != row.Position {
+			rules = append(rules, domain.OwnershipRule{Position: row.Position, Pattern: row.Pattern})
+		}
+		rule := &rules[len(rules)-1]
+		if row.OwnerUserID != nil {
+			rule.OwnerUsers = append(rule.OwnerUsers, *row.OwnerUserID)
+		}
+		if row.OwnerTeam != nil {
+			rule.OwnerTeams = append(rule.OwnerTeams, *row.OwnerTeam)
+		}
+	}
+
+	return rules, nil
+}
+
+func (r *ownershipRepository) ReplaceRules(org string, rules []domain.OwnershipRule) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Exec("DELETE FROM ownership_rules WHERE organization = ?", org).Error; err != nil {
+			return err
+		}
+
+		var rows []ruleRow
+		for _, rule := range rules {
+			if len(rule.OwnerUsers) == 0 && len(rule.OwnerTeams) == 0 {
+				rows = append(rows, ruleRow{Organization: org, Position: rule.Position, Pattern: rule.Pattern})
+			}
+			for _, id := range rule.OwnerUsers {
+				id := id
+				rows = append(rows, ruleRow{Organization: org, Position: rule.Position, Pattern: rule.Pattern, OwnerUserID: &id})
+			}
+			for _, name := range rule.OwnerTeams {
+				name := name
+				rows = append(rows, ruleRow{Organization: org, Position: rule.Position, Pattern: rule.Pattern, OwnerTeam: &name})
+			}
+		}
+
+		if len(rows) == 0 {
+			return nil
+		}
+		return tx.Table("ownership_rules").Create(&rows).Error
+	})
+}
+
+func (r *ownershipRepository) ExistingUsers(org string, ids []string) (map[string]struct{}, error) {
+	return r.existing(org, "users", "id", ids)
+}
+
+func (r *ownershipRepository) ExistingTeams(org string, names []string) (map[string]struct{}, error) {
+	return r.existing(org, "teams", "name", names)
+}
+
+func (r *ownershipRepository) existing(org, table, column string, values []string) (map[string]struct{}, error) {
+	result := make(map[string]struct{})
+	if len(values) == 0 {
+		return result, nil
+	}
+
+	var found []string
+	err := r.db.Table(table).Where("organization = ? AND "+column+" IN ?", org, values).Pluck(column, &found).Error
+	if err != nil {
+		return nil, err
+	}
+
+	for _, v := range found {
+		result[v] = struct{}{}
+	}
+	return result, nil
+}
@@ -0,0 +1,59 @@
+package ownershipService
+
+import (
+	"PullRequestService/domain"
+	"context"
+	"io"
+	"strings"
+)
+
+var ErrInvalidCodeowners = domain.ErrBadRequest.Msg("invalid CODEOWNERS")
+
+type OwnershipService interface {
+	ImportCodeowners(ctx context.Context, data io.Reader) ([]domain.OwnershipRule, error)
+	GetRules(ctx context.Context) ([]domain.OwnershipRule, error)
+}
+
+type ownershipService struct {
+	repo OwnershipRepository
+}
+
+func NewOwnershipService(repo OwnershipRepository) OwnershipService {
+	return &ownershipService{repo: repo}
+}
+
+// ImportCodeowners replaces all ownership rules with the ones from a CODEOWNERS file.
+// Every owner must resolve to an existing user or team, otherwise nothing is changed.
+func (s *ownershipService) ImportCodeowners(ctx context.Context, data io.Reader) ([]domain.OwnershipRule, error) {
+	org := domain.OrganizationFrom(ctx)
+	lines, err := parseCodeowners(data)
+	if err != nil {
+		return nil, ErrInvalidCodeowners.Detailf("%v", err)
+	}
+
+	handles := ownerHandles(lines)
+	users, err := s.repo.ExistingUsers(org, handles)
+	if err != nil {
+		return nil, err
+	}
+	teams, err := s.repo.ExistingTeams(org, handles)
+	if err != nil {
+		return nil, err
+	}
+
+	rules, unknown := resolveOwners(lines, users, teams)
+	if len(unknown) > 0 {
+		return nil, ErrInvalidCodeowners.Detailf("unknown owners %s", strings.Join(unknown, ", "))
+	}
+
+	if err := s.repo.ReplaceRules(org, rules); err != nil {
+		return nil, err
+	}
+
+	return rules, nil
+}
+
+func (s *ownershipService) GetRules(ctx context.Context) ([]domain.OwnershipRule, error) {
+	org := domain.OrganizationFrom(ctx)
+	return s.repo.GetRules(org)
+}
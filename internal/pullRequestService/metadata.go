@@ -0,0 +1,120 @@
+package pullRequestService
+
+import (
+	"PullRequestService/domain"
+	"PullRequestService/internal/candidates"
+	"gorm.io/gorm"
+	"strings"
+)
+
+// Labels returns the labels of the given PRs, sorted. PRs without labels are missing
+// from the result.
+func Labels(db *gorm.DB, org string, prIDs []string) (map[string][]string, error) {
+	return listByPR(db, org, "pull_request_labels", "label", prIDs)
+}
+
+// FilterScope limits a query over a table with a pull_request_id column to the PRs
+// of the organization matching the filter. The query itself must be limited to the
+// organization by the caller.
+func FilterScope(org string, filter domain.PullRequestFilter) func(*gorm.DB) *gorm.DB {
+	return func(q *gorm.DB) *gorm.DB {
+		if filter == (domain.PullRequestFilter{}) {
+			return q
+		}
+
+		prs := q.Session(&gorm.Session{NewDB: true}).Model(&domain.PullRequest{}).
+			Select("pull_request_id").
+			Where("organization = ?", org)
+		if filter.Repository != "" {
+			prs = prs.Where("repository = ?", filter.Repository)
+		}
+		if filter.Status != "" {
+			prs = prs.Where("status = ?", filter.Status)
+		}
+		if label := strings.ToLower(strings.TrimSpace(filter.Label)); label != "" {
+			labeled := q.Session(&gorm.Session{NewDB: true}).Table("pull_request_labels").
+				Select("pull_request_id").
+				Where("organization = ? AND label = ?", org, label)
+			prs = prs.Where("pull_request_id IN (?)", labeled)
+		}
+		return q.Where("pull_request_id IN (?)", prs)
+	}
+}
+
+func listByPR(db *gorm.DB, org, table, column string, prIDs []string) (map[string][]string, error) {
+	result := make(map[string][]string, len(prIDs))
+	if len(prIDs) == 0 {
+		return result, nil
+	}
+
+	var rows []struct {
+		PRID  string `gorm:"column:pull_request_id"`
+		Value string `gorm:"column:value"`
+	}
+	err := db.Table(table).
+		Select("pull_request_id, "+column+" AS value").
+		Where("organization = ? AND pull_request_id IN ?", org, prIDs).
+		Order("pull_request_id, " + column).
+		Scan(&rows).Error
+	if err != nil {
+		return nil, err
+	}
+
+	for _, row := range rows {
+		result[row.PRID] = append(result[row.PRID], row.Value)
+	}
+	return result, nil
+}
+
+const (
+	maxRepositoryLen = 200
+	maxBranchLen     = 255
+	maxLabelLen      = 50
+)
+
+// normalizeMetadata lower-cases and dedupes the labels and rejects values that don't
+// fit the columns.
+func normalizeMetadata(meta domain.PullRequestMetadata) (domain.PullRequestMetadata, error) {
+	meta.Repository = strings.TrimSpace(meta.Repository)
+	meta.Labels = candidates.NormalizeTags(meta.Labels)
+
+	switch {
+	case meta.Additions < 0 || meta.Deletions < 0:
+		return meta, ErrInvalidMetadata.Detailf("additions and deletions must not be negative")
+	case len(meta.Repository) > maxRepositoryLen:
+		return meta, ErrInvalidMetadata.Detailf("repository is longer than %d characters", maxRepositoryLen)
+	case len(meta.SourceBranch) > maxBranchLen || len(meta.TargetBranch) > maxBranchLen:
+		return meta, ErrInvalidMetadata.Detailf("branch name is longer than %d characters", maxBranchLen)
+	}
+	for _, label := range meta.Labels {
+		if len(label) > maxLabelLen {
+			return meta, ErrInvalidMetadata.Detailf("label %q is longer than %d characters", label, maxLabelLen)
+		}
+	}
+	return meta, nil
+}
+
+func applyMetadataPatch(meta domain.PullRequestMetadata, patch domain.PullRequestMetadataPatch) domain.PullRequestMetadata {
+	if patch.Repository != nil {
+		meta.Repository = *patch.Repository
+	}
+	if patch.SourceBranch != nil {
+		meta.SourceBranch = *patch.SourceBranch
+	}
+	if patch.TargetBranch != nil {
+		meta.TargetBranch = *patch.TargetBranch
+	}
+	if patch.URL != nil {
+		meta.URL = *patch.URL
+	}
+	if patch.Additions != nil {
+		meta.Additions = *patch.Additions
+	}
+	if patch.Deletions != nil {
+		meta.Deletions = *patch.Deletions
+	}
+	if patch.Labels != nil {
+		meta.Labels = *patch.Labels
+	}
+	return meta
+}
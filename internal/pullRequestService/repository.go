@@ -2,15 +2,32 @@ package pullRequestService
 
 import (
 	"PullRequestService/domain"
+	"PullRequestService/internal/candidates"
+	"PullRequestService/internal/ownershipService"
 	"gorm.io/gorm"
+	"time"
 )
 
+// PullRequestRepository works within one organization, passed as org to every method.
 type PullRequestRepository interface {
-	CreatePR(pr domain.PullRequest) error
-	GetPR(id string) (domain.PullRequest, error)
-	GetTeamMembers(teamName string) ([]domain.User, error)
-	GetUserByID(userID string) (domain.User, error)
-	UpdatePR(pr domain.PullRequest) error
+	CreatePR(org string, pr domain.PullRequest) error
+	GetPR(org, id string) (domain.PullRequest, error)
+	ListPRs(org string, filter domain.PullRequestFilter) ([]domain.PullRequest, error)
+	GetTeamMembers(org, teamName string) ([]domain.User, error)
+	GetFallbackTiers(org, teamName string) ([][]domain.User, error)
+	GetUserByID(org, userID string) (domain.User, error)
+	IsAvailable(org, userID string) (bool, error)
+	GetReviewPolicy(org, teamName string) (string, error)
+	GetSizeRules(org, teamName string) ([]domain.SizeRule, error)
+	GetWorkloads(org string, userIDs []string) (map[string]candidates.Workload, error)
+	GetSkills(org string, userIDs []string) (map[string][]string, error)
+	GetResponseTimes(org string, userIDs []string) (map[string]time.Duration, error)
+	GetExcluded(org, authorID string) (map[string]struct{}, error)
+	GetOwnershipRules(org string) ([]domain.OwnershipRule, error)
+	GetOwners(org string, rule domain.OwnershipRule) ([]domain.User, error)
+	UpdatePR(org string, pr *domain.PullRequest) error
+	UpdateMetadata(org string, pr *domain.PullRequest) error
+	DeclineReview(org string, pr *domain.PullRequest, decline domain.ReviewDecline) error
 }
 
 type pullRequestRepository struct {
@@ -21,49 +38,326 @@ func NewPullRequestRepository(db *gorm.DB) PullRequestRepository {
 	return &pullRequestRepository{db: db}
 }
 
-func (r *pullRequestRepository) CreatePR(pr domain.PullRequest) error {
-	return r.db.Create(&pr).Error
+func (r *pullRequestRepository) CreatePR(org string, pr domain.PullRequest) error {
+	pr.Organization = org
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(&pr).Error; err != nil {
+			return err
+		}
+
+		for _, u := range pr.AssignedReviewers {
+			err := tx.Exec("INSERT INTO pull_request_reviewers (organization, pull_request_id, reviewer_id) VALUES (?, ?, ?)",
+				org, pr.ID, u.ID).Error
+			if err != nil {
+				return err
+			}
+		}
+
+		for _, tag := range pr.Tags {
+			err := tx.Exec("INSERT INTO pull_request_tags (organization, pull_request_id, tag) VALUES (?, ?, ?)", org, pr.ID, tag).Error
+			if err != nil {
+				return err
+			}
+		}
+
+		if err := insertLabels(tx, org, pr.ID, pr.Labels); err != nil {
+			return err
+		}
+
+		for _, userID := range pr.ExcludedReviewers {
+			err := tx.Exec(`INSERT INTO pull_request_excluded_reviewers (organization, pull_request_id, user_id)
+				VALUES (?, ?, ?) ON CONFLICT DO NOTHING`, org, pr.ID, userID).Error
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	})
 }
 
-func (r *pullRequestRepository) GetPR(id string) (domain.PullRequest, error) {
+func (r *pullRequestRepository) GetPR(org, id string) (domain.PullRequest, error) {
 	var pr domain.PullRequest
-	err := r.db.Preload("AssignedReviewers").First(&pr, "pull_request_id = ?", id).Error
-	return pr, err
+	err := r.db.First(&pr, "organization = ? AND pull_request_id = ?", org, id).Error
+	if err != nil {
+		return pr, err
+	}
+
+	prs := []domain.PullRequest{pr}
+	err = r.loadDetails(org, prs)
+	return prs[0], err
+}
+
+// ListPRs returns the PRs matching the filter, newest first.
+func (r *pullRequestRepository) ListPRs(org string, filter domain.PullRequestFilter) ([]domain.PullRequest, error) {
+	var prs []domain.PullRequest
+	err := r.db.Where("organization = ?", org).
+		Scopes(FilterScope(org, filter)).
+		Order("created_at DESC, pull_request_id").
+		Find(&prs).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return prs, r.loadDetails(org, prs)
+}
+
+// loadDetails fills the reviewers, tags, labels and excluded reviewers kept outside
+// the pull_requests table.
+func (r *pullRequestRepository) loadDetails(org string, prs []domain.PullRequest) error {
+	ids := make([]string, 0, len(prs))
+	for _, pr := range prs {
+		ids = append(ids, pr.ID)
+	}
+
+	reviewers, err := r.reviewers(org, ids)
+	if err != nil {
+		return err
+	}
+	tags, err := listByPR(r.db, org, "pull_request_tags", "tag", ids)
+	if err != nil {
+		return err
+	}
+	labels, err := Labels(r.db, org, ids)
+	if err != nil {
+		return err
+	}
+	excluded, err := candidates.ExcludedForPRs(r.db, org, ids)
+	if err != nil {
+		return err
+	}
+
+	for i := range prs {
+		prs[i].AssignedReviewers = reviewers[prs[i].ID]
+		prs[i].Tags = tags[prs[i].ID]
+		prs[i].Labels = labels[prs[i].ID]
+		prs[i].ExcludedReviewers = excluded[prs[i].ID]
+	}
+	return nil
+}
+
+// reviewers returns the reviewers of the given PRs in the order they were assigned.
+func (r *pullRequestRepository) reviewers(org string, prIDs []string) (map[string][]domain.User, error) {
+	result := make(map[string][]domain.User, len(prIDs))
+	if len(prIDs) == 0 {
+		return result, nil
+	}
+
+	var rows []struct {
+		PRID string `gorm:"column:pull_request_id"`
+		domain.User
+	}
+	err := r.db.Table("pull_request_reviewers prr").
+		Select("prr.pull_request_id, u.*").
+		Joins("JOIN users u ON u.organization = prr.organization AND u.id = prr.reviewer_id").
+		Where("prr.organization = ? AND prr.pull_request_id IN ?", org, prIDs).
+		Order("prr.pull_request_id, prr.assigned_at, prr.reviewer_id").
+		Scan(&rows).Error
+	if err != nil {
+		return nil, err
+	}
+
+	for _, row := range rows {
+		result[row.PRID] = append(result[row.PRID], row.User)
+	}
+	return result, nil
 }
 
-func (r *pullRequestRepository) GetTeamMembers(teamName string) ([]domain.User, error) {
+func (r *pullRequestRepository) GetTeamMembers(org, teamName string) ([]domain.User, error) {
 	var users []domain.User
-	err := r.db.Where("team_name = ? AND is_active = true", teamName).Find(&users).Error
+	err := r.db.Scopes(candidates.Available(time.Now())).
+		Where("organization = ? AND team_name = ? AND is_active = true", org, teamName).
+		Find(&users).Error
 	return users, err
 }
 
-func (r *pullRequestRepository) GetUserByID(userID string) (domain.User, error) {
+func (r *pullRequestRepository) GetFallbackTiers(org, teamName string) ([][]domain.User, error) {
+	return candidates.FallbackTiers(r.db, org, teamName, time.Now())
+}
+
+func (r *pullRequestRepository) GetUserByID(org, userID string) (domain.User, error) {
 	var user domain.User
-	err := r.db.First(&user, "id = ?", userID).Error
+	err := r.db.First(&user, "organization = ? AND id = ?", org, userID).Error
 	return user, err
 }
 
-func (r *pullRequestRepository) UpdatePR(pr domain.PullRequest) error {
-	if err := r.db.Model(&pr).Updates(map[string]interface{}{
+// IsAvailable reports whether the user has no absence running right now.
+func (r *pullRequestRepository) IsAvailable(org, userID string) (bool, error) {
+	var count int64
+	err := r.db.Model(&domain.User{}).
+		Scopes(candidates.Available(time.Now())).
+		Where("organization = ? AND id = ?", org, userID).
+		Count(&count).Error
+	return count > 0, err
+}
+
+// GetReviewPolicy returns the team's review policy, or the standard one if the team
+// does not exist.
+func (r *pullRequestRepository) GetReviewPolicy(org, teamName string) (string, error) {
+	var policies []string
+	err := r.db.Model(&domain.Team{}).
+		Where("organization = ? AND name = ?", org, teamName).
+		Pluck("review_policy", &policies).Error
+	if err != nil || len(policies) == 0 {
+		return domain.ReviewPolicyStandard, err
+	}
+	return policies[0], nil
+}
+
+func (r *pullRequestRepository) GetSizeRules(org, teamName string) ([]domain.SizeRule, error) {
+	return SizeRules(r.db, org, teamName)
+}
+
+// SizeRules returns the size rules of the team, ordered by min_lines.
+func SizeRules(db *gorm.DB, org, teamName string) ([]domain.SizeRule, error) {
+	var rules []domain.SizeRule
+	err := db.Table("team_size_rules").
+		Where("organization = ? AND team_name = ?", org, teamName).
+		Order("min_lines").
+		Find(&rules).Error
+	return rules, err
+}
+
+func (r *pullRequestRepository) GetWorkloads(org string, userIDs []string) (map[string]candidates.Workload, error) {
+	return candidates.Workloads(r.db, org, userIDs)
+}
+
+func (r *pullRequestRepository) GetSkills(org string, userIDs []string) (map[string][]string, error) {
+	return candidates.Skills(r.db, org, userIDs)
+}
+
+func (r *pullRequestRepository) GetResponseTimes(org string, userIDs []string) (map[string]time.Duration, error) {
+	return candidates.ResponseTimes(r.db, org, userIDs)
+}
+
+func (r *pullRequestRepository) GetExcluded(org, authorID string) (map[string]struct{}, error) {
+	excluded, err := candidates.Excluded(r.db, org, []string{authorID})
+	if err != nil {
+		return nil, err
+	}
+	return excluded[authorID], nil
+}
+
+func (r *pullRequestRepository) GetOwnershipRules(org string) ([]domain.OwnershipRule, error) {
+	return ownershipService.GetRules(r.db, org)
+}
+
+// GetOwners returns the active, available users named by the rule directly or
+// through one of its teams.
+func (r *pullRequestRepository) GetOwners(org string, rule domain.OwnershipRule) ([]domain.User, error) {
+	var users []domain.User
+	if len(rule.OwnerUsers) == 0 && len(rule.OwnerTeams) == 0 {
+		return users, nil
+	}
+
+	owners := r.db.Where("id IN ?", append([]string{}, rule.OwnerUsers...)).
+		Or("team_name IN ?", append([]string{}, rule.OwnerTeams...))
+	err := r.db.Scopes(candidates.Available(time.Now())).
+		Where("organization = ? AND is_active = true", org).
+		Where(owners).
+		Find(&users).Error
+	return users, err
+}
+
+// DeclineReview saves the PR like UpdatePR and records the decline in the same
+// transaction, so the reviewer is never removed without the decline or the other way
+// round.
+func (r *pullRequestRepository) DeclineReview(org string, pr *domain.PullRequest, decline domain.ReviewDecline) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		if err := savePR(tx, org, pr); err != nil {
+			return err
+		}
+		decline.Organization = org
+		return tx.Omit("id").Create(&decline).Error
+	})
+}
+
+// UpdateMetadata overwrites the PR's metadata, labels included.
+func (r *pullRequestRepository) UpdateMetadata(org string, pr *domain.PullRequest) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		meta := pr.PullRequestMetadata
+		err := updatePR(tx, org, pr, map[string]interface{}{
+			"repository":    meta.Repository,
+			"source_branch": meta.SourceBranch,
+			"target_branch": meta.TargetBranch,
+			"url":           meta.URL,
+			"additions":     meta.Additions,
+			"deletions":     meta.Deletions,
+		})
+		if err != nil {
+			return err
+		}
+
+		err = tx.Exec("DELETE FROM pull_request_labels WHERE organization = ? AND pull_request_id = ?", org, pr.ID).Error
+		if err != nil {
+			return err
+		}
+		return insertLabels(tx, org, pr.ID, meta.Labels)
+	})
+}
+
+func insertLabels(tx *gorm.DB, org, prID string, labels []string) error {
+	for _, label := range labels {
+		err := tx.Exec("INSERT INTO pull_request_labels (organization, pull_request_id, label) VALUES (?, ?, ?)",
+			org, prID, label).Error
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// UpdatePR saves the status and the reviewers of the PR.
+func (r *pullRequestRepository) UpdatePR(org string, pr *domain.PullRequest) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		return savePR(tx, org, pr)
+	})
+}
+
+func savePR(tx *gorm.DB, org string, pr *domain.PullRequest) error {
+	err := updatePR(tx, org, pr, map[string]interface{}{
 		"status":    pr.Status,
 		"merged_at": pr.MergedAt,
-	}).Error; err != nil {
+	})
+	if err != nil {
 		return err
 	}
 
-	if err := r.db.Where("pull_request_id = ?", pr.ID).Delete(&domain.PullRequestReviewer{}).Error; err != nil {
+	// Reviewers that stay keep their row, so their assigned_at is not reset.
+	ids := make([]string, 0, len(pr.AssignedReviewers))
+	for _, u := range pr.AssignedReviewers {
+		ids = append(ids, u.ID)
+	}
+	stale := tx.Where("organization = ? AND pull_request_id = ?", org, pr.ID)
+	if len(ids) > 0 {
+		stale = stale.Where("reviewer_id NOT IN ?", ids)
+	}
+	if err := stale.Delete(&domain.PullRequestReviewer{}).Error; err != nil {
 		return err
 	}
 
-	for _, u := range pr.AssignedReviewers {
-		reviewer := domain.PullRequestReviewer{
-			PullRequestID: pr.ID,
-			ReviewerID:    u.ID,
-		}
-		if err := r.db.Create(&reviewer).Error; err != nil {
+	for _, id := range ids {
+		err := tx.Exec(`INSERT INTO pull_request_reviewers (organization, pull_request_id, reviewer_id)
+			VALUES (?, ?, ?) ON CONFLICT DO NOTHING`, org, pr.ID, id).Error
+		if err != nil {
 			return err
 		}
 	}
+	return nil
+}
 
+// updatePR sets the columns of a PR still at pr.Version and moves it to the next
+// version. A PR changed since it was read returns domain.ErrVersionMismatch.
+func updatePR(tx *gorm.DB, org string, pr *domain.PullRequest, columns map[string]interface{}) error {
+	columns["version"] = gorm.Expr("version + 1")
+	res := tx.Model(&domain.PullRequest{}).
+		Where("organization = ? AND pull_request_id = ? AND version = ?", org, pr.ID, pr.Version).
+		Updates(columns)
+	if res.Error != nil {
+		return res.Error
+	}
+	if res.RowsAffected == 0 {
+		return domain.ErrVersionMismatch
+	}
+	pr.Version++
 	return nil
 }
@@ -0,0 +1,82 @@
+package pullRequestService
+
+import (
+	"PullRequestService/domain"
+	"PullRequestService/internal/testdb"
+	"reflect"
+	"sort"
+	"testing"
+)
+
+func TestRepositoryStaysInOrganization(t *testing.T) {
+	db := testdb.Open(t)
+	testdb.Seed(t, db)
+	repo := NewPullRequestRepository(db)
+
+	t.Run("GetPR", func(t *testing.T) {
+		tests := []struct {
+			org       string
+			name      string
+			status    string
+			reviewers []string
+		}{
+			{testdb.Acme, "acme-pr-1", "OPEN", []string{"u2"}},
+			{testdb.Globex, "globex-pr-1", "MERGED", []string{"u2", "u3"}},
+		}
+		for _, tt := range tests {
+			pr, err := repo.GetPR(tt.org, "pr-1")
+			if err != nil {
+				t.Fatal(err)
+			}
+			got := testdb.IDs(pr.AssignedReviewers)
+			if pr.Name != tt.name || pr.Status != tt.status || !reflect.DeepEqual(got, tt.reviewers) {
+				t.Errorf("GetPR(%s) = %s %s %v, want %s %s %v",
+					tt.org, pr.Name, pr.Status, got, tt.name, tt.status, tt.reviewers)
+			}
+		}
+	})
+
+	t.Run("ListPRs", func(t *testing.T) {
+		tests := []struct {
+			org  string
+			want []string
+		}{
+			{testdb.Acme, []string{"acme-pr-1", "acme-pr-2"}},
+			{testdb.Globex, []string{"globex-pr-1"}},
+		}
+		for _, tt := range tests {
+			prs, err := repo.ListPRs(tt.org, domain.PullRequestFilter{})
+			if err != nil {
+				t.Fatal(err)
+			}
+			var names []string
+			for _, pr := range prs {
+				names = append(names, pr.Name)
+			}
+			sort.Strings(names)
+			if !reflect.DeepEqual(names, tt.want) {
+				t.Errorf("ListPRs(%s) = %v, want %v", tt.org, names, tt.want)
+			}
+		}
+	})
+
+	t.Run("UpdatePR", func(t *testing.T) {
+		pr, err := repo.GetPR(testdb.Acme, "pr-1")
+		if err != nil {
+			t.Fatal(err)
+		}
+		pr.AssignedReviewers = []domain.User{{ID: "u3"}}
+		if err := repo.UpdatePR(testdb.Acme, &pr); err != nil {
+			t.Fatal(err)
+		}
+
+		other, err := repo.GetPR(testdb.Globex, "pr-1")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got := testdb.IDs(other.AssignedReviewers); other.Version != domain.FirstVersion ||
+			!reflect.DeepEqual(got, []string{"u2", "u3"}) {
+			t.Errorf("Globex pr-1 changed to version %d with reviewers %v", other.Version, got)
+		}
+	})
+}
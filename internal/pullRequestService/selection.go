@@ -0,0 +1,360 @@
+package pullRequestService
+
+import (
+	"PullRequestService/domain"
+	"PullRequestService/internal/ownershipService"
+	"errors"
+	"gorm.io/gorm"
+	"math/rand"
+	"sort"
+	"time"
+)
+
+// selection is the outcome of picking reviewers for one PR.
+type selection struct {
+	reviewers  []domain.User
+	atCapacity int
+}
+
+// criteria narrows and orders the candidates: tags rank them by matching skills,
+// a non-empty seniority keeps only users of that level and urgent puts the least
+// loaded, fastest reviewers first.
+type criteria struct {
+	tags      []string
+	seniority string
+	urgent    bool
+}
+
+// pickReviewers takes up to need reviewers from the home team. When the team can't
+// fill the count it moves on to the team's fallback teams and reviewer pools. Users in
+// skip are never picked. Within a tier the order follows rankCandidates.
+func (s *pullRequestService) pickReviewers(org, teamName string, home []domain.User, skip map[string]struct{}, need int, c criteria) (selection, error) {
+	var result selection
+	if need <= 0 {
+		return result, nil
+	}
+
+	tiers := [][]domain.User{home}
+	for i := 0; i < len(tiers) && len(result.reviewers) < need; i++ {
+		var pool []domain.User
+		for _, u := range tiers[i] {
+			if _, ok := skip[u.ID]; ok {
+				continue
+			}
+			if c.seniority != "" && u.Seniority != c.seniority {
+				continue
+			}
+			pool = append(pool, u)
+		}
+
+		available, atCapacity, err := s.rankCandidates(org, pool, c)
+		if err != nil {
+			return selection{}, err
+		}
+		result.atCapacity += atCapacity
+
+		for _, u := range available {
+			if len(result.reviewers) == need {
+				break
+			}
+			result.reviewers = append(result.reviewers, u)
+			skip[u.ID] = struct{}{}
+		}
+
+		if i == 0 && len(result.reviewers) < need {
+			fallback, err := s.repo.GetFallbackTiers(org, teamName)
+			if err != nil {
+				return selection{}, err
+			}
+			tiers = append(tiers, fallback...)
+		}
+	}
+
+	return result, nil
+}
+
+// pickPairing fills the slots of a team with the pairing policy: one senior and one
+// junior, where reviewers already picked (the owners) count towards their level.
+// Levels nobody could cover are returned as missing and their slots go to anyone
+// available.
+func (s *pullRequestService) pickPairing(org, teamName string, home []domain.User, skip map[string]struct{}, picked []domain.User, need int, c criteria) (selection, []string, error) {
+	var (
+		result  selection
+		missing []string
+	)
+
+	for _, level := range []string{domain.SenioritySenior, domain.SeniorityJunior} {
+		if hasSeniority(picked, level) || hasSeniority(result.reviewers, level) {
+			continue
+		}
+		if len(result.reviewers) >= need {
+			missing = append(missing, level)
+			continue
+		}
+
+		c.seniority = level
+		got, err := s.pickReviewers(org, teamName, home, skip, 1, c)
+		if err != nil {
+			return selection{}, nil, err
+		}
+		result.atCapacity += got.atCapacity
+		if len(got.reviewers) == 0 {
+			missing = append(missing, level)
+			continue
+		}
+		result.reviewers = append(result.reviewers, got.reviewers...)
+	}
+
+	c.seniority = ""
+	rest, err := s.pickReviewers(org, teamName, home, skip, need-len(result.reviewers), c)
+	if err != nil {
+		return selection{}, nil, err
+	}
+	result.reviewers = append(result.reviewers, rest.reviewers...)
+	result.atCapacity += rest.atCapacity
+
+	return result, missing, nil
+}
+
+// ReviewersForSize returns how many reviewers a PR with the given number of changed
+// lines gets under the team's size rules. Without a matching rule, or when the size
+// is unknown, it is reviewersPerPR.
+func ReviewersForSize(rules []domain.SizeRule, lines int) int {
+	if lines <= 0 {
+		return reviewersPerPR
+	}
+
+	result, best := reviewersPerPR, -1
+	for _, rule := range rules {
+		if rule.MinLines <= lines && rule.MinLines > best {
+			result, best = rule.Reviewers, rule.MinLines
+		}
+	}
+	return result
+}
+
+func hasSeniority(users []domain.User, level string) bool {
+	for _, u := range users {
+		if u.Seniority == level {
+			return true
+		}
+	}
+	return false
+}
+
+// pickOwners makes sure every ownership area touched by the changed files gets one of
+// its owners as a reviewer. An owner that is pinned or already picked for another area
+// covers this one too. Areas whose owners are all skipped, away or at capacity stay
+// uncovered.
+func (s *pullRequestService) pickOwners(org string, changedFiles []string, skip map[string]struct{}, pinned []domain.User, c criteria) (selection, []domain.OwnedArea, error) {
+	var result selection
+	if len(changedFiles) == 0 {
+		return result, nil, nil
+	}
+
+	rules, err := s.repo.GetOwnershipRules(org)
+	if err != nil {
+		return selection{}, nil, err
+	}
+
+	touched, err := ownershipService.TouchedRules(rules, changedFiles)
+	if err != nil {
+		return selection{}, nil, err
+	}
+
+	areas := make([]domain.OwnedArea, 0, len(touched))
+	for _, rule := range touched {
+		owners, err := s.repo.GetOwners(org, rule)
+		if err != nil {
+			return selection{}, nil, err
+		}
+
+		area := domain.OwnedArea{Pattern: rule.Pattern}
+		var pool []domain.User
+		for _, u := range owners {
+			if area.ReviewerID == "" && (isPicked(pinned, u.ID) || isPicked(result.reviewers, u.ID)) {
+				area.ReviewerID = u.ID
+			}
+			if _, ok := skip[u.ID]; !ok {
+				pool = append(pool, u)
+			}
+		}
+
+		if area.ReviewerID == "" {
+			available, atCapacity, err := s.rankCandidates(org, pool, c)
+			if err != nil {
+				return selection{}, nil, err
+			}
+			result.atCapacity += atCapacity
+
+			if len(available) > 0 {
+				owner := available[0]
+				result.reviewers = append(result.reviewers, owner)
+				skip[owner.ID] = struct{}{}
+				area.ReviewerID = owner.ID
+			}
+		}
+
+		areas = append(areas, area)
+	}
+
+	return result, areas, nil
+}
+
+// checkManualReviewer verifies that a hand-picked user may review the PR: the user
+// exists, is active, is not the author, is not assigned yet, is not excluded, is not
+// away and has not reached their open review limit.
+func (s *pullRequestService) checkManualReviewer(org, userID, authorID string, assigned []domain.User, excluded map[string]struct{}) (domain.User, error) {
+	user, err := s.repo.GetUserByID(org, userID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return domain.User{}, ErrInvalidReviewer.Detailf("user %s not found", userID)
+		}
+		return domain.User{}, err
+	}
+
+	switch {
+	case user.ID == authorID:
+		return domain.User{}, ErrInvalidReviewer.Detailf("user %s is the author", userID)
+	case !user.IsActive:
+		return domain.User{}, ErrInvalidReviewer.Detailf("user %s is inactive", userID)
+	case isPicked(assigned, user.ID):
+		return domain.User{}, ErrInvalidReviewer.Detailf("user %s is already assigned", userID)
+	}
+	if _, ok := excluded[user.ID]; ok {
+		return domain.User{}, ErrInvalidReviewer.Detailf("user %s may not review this PR", userID)
+	}
+
+	available, err := s.repo.IsAvailable(org, user.ID)
+	if err != nil {
+		return domain.User{}, err
+	}
+	if !available {
+		return domain.User{}, ErrInvalidReviewer.Detailf("user %s is unavailable", userID)
+	}
+
+	workloads, err := s.repo.GetWorkloads(org, []string{user.ID})
+	if err != nil {
+		return domain.User{}, err
+	}
+	if workloads[user.ID].AtCapacity() {
+		return domain.User{}, ErrInvalidReviewer.Detailf("user %s is at capacity", userID)
+	}
+
+	return user, nil
+}
+
+func isPicked(users []domain.User, id string) bool {
+	for _, u := range users {
+		if u.ID == id {
+			return true
+		}
+	}
+	return false
+}
+
+// rankCandidates drops users that already have as many OPEN reviews as they may take,
+// reports how many were dropped and orders the rest best first. By default users with
+// a lower size-weighted load come first and equal loads are shuffled. When the PR has
+// tags, users sharing more tags with the PR come first and load breaks ties. Users
+// without matching skills stay in the list, so the team still covers PRs nobody is
+// tagged for. Urgent PRs are ordered by load first, then by historical response time,
+// and only then by matching tags.
+func (s *pullRequestService) rankCandidates(org string, users []domain.User, c criteria) ([]domain.User, int, error) {
+	ids := make([]string, 0, len(users))
+	for _, u := range users {
+		ids = append(ids, u.ID)
+	}
+
+	workloads, err := s.repo.GetWorkloads(org, ids)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	var available []domain.User
+	atCapacity := 0
+	for _, u := range users {
+		if workloads[u.ID].AtCapacity() {
+			atCapacity++
+			continue
+		}
+		available = append(available, u)
+	}
+
+	rand.Shuffle(len(available), func(a, b int) { available[a], available[b] = available[b], available[a] })
+	if len(c.tags) == 0 && !c.urgent {
+		sort.SliceStable(available, func(a, b int) bool {
+			return workloads[available[a].ID].Load < workloads[available[b].ID].Load
+		})
+		return available, atCapacity, nil
+	}
+	if len(available) == 0 {
+		return available, atCapacity, nil
+	}
+
+	overlap := make(map[string]int, len(available))
+	if len(c.tags) > 0 {
+		skills, err := s.repo.GetSkills(org, ids)
+		if err != nil {
+			return nil, 0, err
+		}
+		for _, u := range available {
+			overlap[u.ID] = countOverlap(skills[u.ID], c.tags)
+		}
+	}
+
+	if c.urgent {
+		responseTimes, err := s.repo.GetResponseTimes(org, ids)
+		if err != nil {
+			return nil, 0, err
+		}
+		sort.SliceStable(available, func(a, b int) bool {
+			ua, ub := available[a], available[b]
+			if workloads[ua.ID].Load != workloads[ub.ID].Load {
+				return workloads[ua.ID].Load < workloads[ub.ID].Load
+			}
+			if faster, ok := compareResponseTimes(responseTimes, ua.ID, ub.ID); ok {
+				return faster
+			}
+			return overlap[ua.ID] > overlap[ub.ID]
+		})
+		return available, atCapacity, nil
+	}
+
+	sort.SliceStable(available, func(a, b int) bool {
+		ua, ub := available[a], available[b]
+		if overlap[ua.ID] != overlap[ub.ID] {
+			return overlap[ua.ID] > overlap[ub.ID]
+		}
+		return workloads[ua.ID].Load < workloads[ub.ID].Load
+	})
+
+	return available, atCapacity, nil
+}
+
+// compareResponseTimes reports whether a responds faster than b. Users without merged
+// reviews come after those with a history. ok is false when the two can't be told apart.
+func compareResponseTimes(times map[string]time.Duration, a, b string) (faster, ok bool) {
+	ta, hasA := times[a]
+	tb, hasB := times[b]
+	switch {
+	case hasA && hasB && ta != tb:
+		return ta < tb, true
+	case hasA != hasB:
+		return hasA, true
+	}
+	return false, false
+}
+
+func countOverlap(skills, tags []string) int {
+	n := 0
+	for _, t := range tags {
+		for _, skill := range skills {
+			if skill == t {
+				n++
+				break
+			}
+		}
+	}
+	return n
+}
@@ -0,0 +1,84 @@
+package pullRequestService
+
+import (
+	"PullRequestService/domain"
+	"PullRequestService/internal/candidates"
+	"errors"
+	"gorm.io/gorm"
+	"strings"
+	"testing"
+)
+
+// selectionRepo answers the lookups checkManualReviewer makes from fixed data.
+type selectionRepo struct {
+	PullRequestRepository
+	users     map[string]domain.User
+	away      map[string]bool
+	workloads map[string]candidates.Workload
+}
+
+func (r selectionRepo) GetUserByID(org, userID string) (domain.User, error) {
+	user, ok := r.users[userID]
+	if !ok {
+		return domain.User{}, gorm.ErrRecordNotFound
+	}
+	return user, nil
+}
+
+func (r selectionRepo) IsAvailable(org, userID string) (bool, error) {
+	return !r.away[userID], nil
+}
+
+func (r selectionRepo) GetWorkloads(org string, userIDs []string) (map[string]candidates.Workload, error) {
+	return r.workloads, nil
+}
+
+func TestCheckManualReviewer(t *testing.T) {
+	limit := func(n int) *int { return &n }
+	repo := selectionRepo{
+		users: map[string]domain.User{
+			"author":   {ID: "author", IsActive: true},
+			"free":     {ID: "free", IsActive: true},
+			"inactive": {ID: "inactive"},
+			"assigned": {ID: "assigned", IsActive: true},
+			"excluded": {ID: "excluded", IsActive: true},
+			"away":     {ID: "away", IsActive: true},
+			"full":     {ID: "full", IsActive: true},
+		},
+		away: map[string]bool{"away": true},
+		workloads: map[string]candidates.Workload{
+			"free": {UserID: "free", OpenReviews: 1, MaxOpenReviews: limit(2)},
+			"full": {UserID: "full", OpenReviews: 2, MaxOpenReviews: limit(2)},
+		},
+	}
+	s := &pullRequestService{repo: repo}
+
+	tests := []struct {
+		userID string
+		reason string
+	}{
+		{"free", ""},
+		{"missing", "not found"},
+		{"author", "is the author"},
+		{"inactive", "is inactive"},
+		{"assigned", "is already assigned"},
+		{"excluded", "may not review"},
+		{"away", "is unavailable"},
+		{"full", "is at capacity"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.userID, func(t *testing.T) {
+			user, err := s.checkManualReviewer("acme", tt.userID, "author",
+				[]domain.User{{ID: "assigned"}}, map[string]struct{}{"excluded": {}})
+			if tt.reason == "" {
+				if err != nil || user.ID != tt.userID {
+					t.Fatalf("checkManualReviewer = %v, %v; want %s", user.ID, err, tt.userID)
+				}
+				return
+			}
+			if !errors.Is(err, ErrInvalidReviewer) || !strings.Contains(err.Error(), tt.reason) {
+				t.Fatalf("checkManualReviewer error = %v, want invalid reviewer that %s", err, tt.reason)
+			}
+		})
+	}
+}
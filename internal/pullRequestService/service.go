@@ -2,18 +2,34 @@ package pullRequestService
 
 import (
 	"PullRequestService/domain"
+	"PullRequestService/internal/candidates"
+	"context"
 	"errors"
 	"gorm.io/gorm"
-	"math/rand"
+	"strings"
 	"time"
 )
 
 type PullRequestService interface {
-	CreatePR(prID, prName, authorID string) (domain.PullRequest, error)
-	MergePR(prID string) (domain.PullRequest, error)
-	ReassignReviewer(prID, oldUserID string) (domain.PullRequest, string, error)
+	CreatePR(ctx context.Context, draft domain.PullRequestDraft) (domain.PullRequest, domain.AssignmentReport, error)
+	MergePR(ctx context.Context, prID string) (domain.PullRequest, error)
+	ReassignReviewer(ctx context.Context, prID, oldUserID, newUserID string) (domain.PullRequest, domain.ReassignReport, error)
+	AddReviewer(ctx context.Context, prID, userID string) (domain.PullRequest, error)
+	RemoveReviewer(ctx context.Context, prID, userID string) (domain.PullRequest, error)
+	DeclineReview(ctx context.Context, prID, userID, reason string) (domain.PullRequest, domain.ReassignReport, error)
+	UpdateMetadata(ctx context.Context, prID string, patch domain.PullRequestMetadataPatch) (domain.PullRequest, error)
+	ListPRs(ctx context.Context, filter domain.PullRequestFilter) ([]domain.PullRequest, error)
 }
 
+var (
+	// ErrInvalidReviewer is returned when a hand-picked reviewer can't review the PR.
+	ErrInvalidReviewer = domain.ErrBadRequest.Msg("invalid reviewer")
+	// ErrInvalidMetadata is returned when PR metadata doesn't fit the limits.
+	ErrInvalidMetadata = domain.ErrBadRequest.Msg("invalid metadata")
+
+	errPRNotFound = domain.ErrNotFound.Msg("PR not found")
+)
+
 type pullRequestService struct {
 	repo PullRequestRepository
 }
@@ -24,63 +40,327 @@ func NewPullRequestService(repo PullRequestRepository) PullRequestService {
 	}
 }
 
-func (s *pullRequestService) CreatePR(prID, prName, authorID string) (domain.PullRequest, error) {
-	if _, err := s.repo.GetPR(prID); err == nil {
-		return domain.PullRequest{}, errors.New("PR_EXISTS")
+const reviewersPerPR = 2
+
+// CreatePR opens a PR and assigns reviewers. Their number comes from the team's size
+// rules. Requested reviewers are pinned first, then owners of the areas touched by the
+// changed files are added, so a PR may get more reviewers than the rules ask for; the
+// rest is filled from the author's team. Tags steer
+// both steps towards reviewers with matching skills, urgent PRs go to the least
+// loaded and fastest reviewers. Teams with the pairing policy get one senior and one
+// junior reviewer where possible.
+func (s *pullRequestService) CreatePR(ctx context.Context, draft domain.PullRequestDraft) (domain.PullRequest, domain.AssignmentReport, error) {
+	org := domain.OrganizationFrom(ctx)
+	report := domain.AssignmentReport{Requested: reviewersPerPR}
+	tags := candidates.NormalizeTags(draft.Tags)
+
+	priority := draft.Priority
+	if priority == "" {
+		priority = domain.PriorityNormal
+	}
+	if priority != domain.PriorityLow && priority != domain.PriorityNormal && priority != domain.PriorityUrgent {
+		return domain.PullRequest{}, report, domain.ErrBadRequest.Msg("priority must be low, normal or urgent")
+	}
+
+	meta, err := normalizeMetadata(draft.Metadata)
+	if err != nil {
+		return domain.PullRequest{}, report, err
+	}
+
+	if _, err := s.repo.GetPR(org, draft.ID); err == nil {
+		return domain.PullRequest{}, report, domain.ErrPRExists
 	} else if !errors.Is(err, gorm.ErrRecordNotFound) {
-		return domain.PullRequest{}, err
+		return domain.PullRequest{}, report, err
 	}
 
-	author, err := s.repo.GetUserByID(authorID)
+	author, err := s.repo.GetUserByID(org, draft.AuthorID)
 	if err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
-			return domain.PullRequest{}, errors.New("NOT_FOUND")
+			return domain.PullRequest{}, report, domain.ErrNotFound.Msg("author not found")
+		}
+		return domain.PullRequest{}, report, err
+	}
+
+	sizeRules, err := s.repo.GetSizeRules(org, author.TeamName)
+	if err != nil {
+		return domain.PullRequest{}, report, err
+	}
+	target := ReviewersForSize(sizeRules, meta.Additions+meta.Deletions)
+	report.Requested = target
+
+	skip, err := s.excluded(org, author.ID, draft.ExcludeReviewers)
+	if err != nil {
+		return domain.PullRequest{}, report, err
+	}
+
+	var pinned []domain.User
+	for _, id := range draft.RequestedReviewers {
+		if isPicked(pinned, id) {
+			continue
+		}
+		user, err := s.checkManualReviewer(org, id, author.ID, pinned, skip)
+		if err != nil {
+			return domain.PullRequest{}, report, err
+		}
+		pinned = append(pinned, user)
+		skip[user.ID] = struct{}{}
+	}
+
+	c := criteria{tags: tags, urgent: priority == domain.PriorityUrgent}
+	owners, areas, err := s.pickOwners(org, draft.ChangedFiles, skip, pinned, c)
+	if err != nil {
+		return domain.PullRequest{}, report, err
+	}
+	report.Owners = areas
+
+	requested := len(pinned) + len(owners.reviewers)
+	for _, a := range areas {
+		if a.ReviewerID == "" {
+			requested++
+		}
+	}
+	if requested > report.Requested {
+		report.Requested = requested
+	}
+	chosen := append(pinned, owners.reviewers...)
+
+	members, err := s.repo.GetTeamMembers(org, author.TeamName)
+	if err != nil {
+		return domain.PullRequest{}, report, err
+	}
+
+	policy, err := s.repo.GetReviewPolicy(org, author.TeamName)
+	if err != nil {
+		return domain.PullRequest{}, report, err
+	}
+
+	var picked selection
+	need := target - len(chosen)
+	if policy == domain.ReviewPolicyPairing {
+		picked, report.PairingMissing, err = s.pickPairing(org, author.TeamName, members, skip, chosen, need, c)
+	} else {
+		picked, err = s.pickReviewers(org, author.TeamName, members, skip, need, c)
+	}
+	if err != nil {
+		return domain.PullRequest{}, report, err
+	}
+	reviewers := append(chosen, picked.reviewers...)
+
+	report.Assigned = len(reviewers)
+	report.CapacityLimited = report.Assigned < report.Requested && owners.atCapacity+picked.atCapacity > 0
+	for _, u := range reviewers {
+		if u.TeamName != author.TeamName {
+			report.External = append(report.External, u.ID)
 		}
+	}
+
+	pr := domain.PullRequest{
+		ID:                  draft.ID,
+		Name:                draft.Name,
+		AuthorID:            author.ID,
+		Status:              "OPEN",
+		AssignedReviewers:   reviewers,
+		CreatedAt:           time.Now(),
+		Tags:                tags,
+		ExcludedReviewers:   draft.ExcludeReviewers,
+		Priority:            priority,
+		DueAt:               draft.DueAt,
+		Version:             domain.FirstVersion,
+		PullRequestMetadata: meta,
+	}
+
+	if err := s.repo.CreatePR(org, pr); err != nil {
+		if errors.Is(err, gorm.ErrDuplicatedKey) {
+			return domain.PullRequest{}, report, domain.ErrPRExists.Wrap(err)
+		}
+		return domain.PullRequest{}, report, err
+	}
+
+	return pr, report, nil
+}
+
+// pickReplacement picks a random replacement for the old reviewer, keeping their
+// seniority when the pairing policy applies.
+func (s *pullRequestService) pickReplacement(org string, pr domain.PullRequest, oldUser domain.User, policy string, skip map[string]struct{}) (domain.User, error) {
+	members, err := s.repo.GetTeamMembers(org, oldUser.TeamName)
+	if err != nil {
+		return domain.User{}, err
+	}
+
+	skip[oldUser.ID] = struct{}{}
+	for _, r := range pr.AssignedReviewers {
+		skip[r.ID] = struct{}{}
+	}
+
+	var picked selection
+	c := criteria{tags: pr.Tags, urgent: pr.Priority == domain.PriorityUrgent}
+	if policy == domain.ReviewPolicyPairing {
+		c.seniority = oldUser.Seniority
+		picked, err = s.pickReviewers(org, oldUser.TeamName, members, skip, 1, c)
+		if err != nil {
+			return domain.User{}, err
+		}
+	}
+	if len(picked.reviewers) == 0 {
+		c.seniority = ""
+		picked, err = s.pickReviewers(org, oldUser.TeamName, members, skip, 1, c)
+		if err != nil {
+			return domain.User{}, err
+		}
+	}
+
+	if len(picked.reviewers) == 0 {
+		return domain.User{}, domain.ErrNoCandidate
+	}
+	return picked.reviewers[0], nil
+}
+
+// AddReviewer assigns a hand-picked reviewer to an OPEN PR on top of the current ones.
+func (s *pullRequestService) AddReviewer(ctx context.Context, prID, userID string) (domain.PullRequest, error) {
+	org := domain.OrganizationFrom(ctx)
+	pr, err := s.getOpenPR(ctx, org, prID)
+	if err != nil {
+		return domain.PullRequest{}, err
+	}
+
+	excluded, err := s.excluded(org, pr.AuthorID, pr.ExcludedReviewers)
+	if err != nil {
+		return domain.PullRequest{}, err
+	}
+
+	user, err := s.checkManualReviewer(org, userID, pr.AuthorID, pr.AssignedReviewers, excluded)
+	if err != nil {
 		return domain.PullRequest{}, err
 	}
 
-	candidates, err := s.repo.GetTeamMembers(author.TeamName)
+	pr.AssignedReviewers = append(pr.AssignedReviewers, user)
+	if err := s.repo.UpdatePR(org, &pr); err != nil {
+		return domain.PullRequest{}, err
+	}
+	return pr, nil
+}
+
+// RemoveReviewer unassigns a reviewer from an OPEN PR without picking a replacement.
+func (s *pullRequestService) RemoveReviewer(ctx context.Context, prID, userID string) (domain.PullRequest, error) {
+	org := domain.OrganizationFrom(ctx)
+	pr, err := s.getOpenPR(ctx, org, prID)
 	if err != nil {
 		return domain.PullRequest{}, err
 	}
+	if err := removeReviewer(&pr, userID); err != nil {
+		return domain.PullRequest{}, err
+	}
 
-	var reviewers []domain.User
-	for _, u := range candidates {
-		if u.ID != author.ID {
+	if err := s.repo.UpdatePR(org, &pr); err != nil {
+		return domain.PullRequest{}, err
+	}
+	return pr, nil
+}
+
+// removeReviewer drops the user from the reviewers of pr, without saving it.
+func removeReviewer(pr *domain.PullRequest, userID string) error {
+	reviewers := make([]domain.User, 0, len(pr.AssignedReviewers))
+	for _, u := range pr.AssignedReviewers {
+		if u.ID != userID {
 			reviewers = append(reviewers, u)
 		}
 	}
+	if len(reviewers) == len(pr.AssignedReviewers) {
+		return domain.ErrNotAssigned
+	}
+
+	pr.AssignedReviewers = reviewers
+	return nil
+}
 
-	rand.Seed(time.Now().UnixNano())
-	if len(reviewers) > 2 {
-		rand.Shuffle(len(reviewers), func(i, j int) { reviewers[i], reviewers[j] = reviewers[j], reviewers[i] })
-		reviewers = reviewers[:2]
+// DeclineReview lets an assigned reviewer refuse a PR. A replacement is picked like in
+// ReassignReviewer; if there is none the reviewer is simply removed. The decline is
+// recorded, so the user is not picked for this PR again. The new reviewers and the
+// decline are saved in one transaction.
+func (s *pullRequestService) DeclineReview(ctx context.Context, prID, userID, reason string) (domain.PullRequest, domain.ReassignReport, error) {
+	org := domain.OrganizationFrom(ctx)
+	reason = strings.TrimSpace(reason)
+	if reason == "" {
+		return domain.PullRequest{}, domain.ReassignReport{}, domain.ErrBadRequest.Msg("reason must not be empty")
 	}
 
-	pr := domain.PullRequest{
-		ID:                prID,
-		Name:              prName,
-		AuthorID:          author.ID,
-		Status:            "OPEN",
-		AssignedReviewers: reviewers,
-		CreatedAt:         time.Now(),
+	pr, report, err := s.replaceReviewer(ctx, org, prID, userID, "")
+	if errors.Is(err, domain.ErrNoCandidate) {
+		pr, err = s.getOpenPR(ctx, org, prID)
+		if err == nil {
+			err = removeReviewer(&pr, userID)
+		}
+	}
+	if err != nil {
+		return domain.PullRequest{}, report, err
 	}
 
-	if err := s.repo.CreatePR(pr); err != nil {
+	decline := domain.ReviewDecline{
+		PullRequestID: prID,
+		UserID:        userID,
+		Reason:        reason,
+		CreatedAt:     time.Now(),
+	}
+	if report.ReplacedBy != "" {
+		replacedBy := report.ReplacedBy
+		decline.ReplacedBy = &replacedBy
+	}
+	if err := s.repo.DeclineReview(org, &pr, decline); err != nil {
+		return domain.PullRequest{}, report, err
+	}
+	return pr, report, nil
+}
+
+// getOpenPR loads a PR to change the review of. The caller's If-Match is checked
+// before the status, so a stale client learns that the PR changed.
+func (s *pullRequestService) getOpenPR(ctx context.Context, org, prID string) (domain.PullRequest, error) {
+	pr, err := s.repo.GetPR(org, prID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return domain.PullRequest{}, errPRNotFound
+		}
+		return domain.PullRequest{}, err
+	}
+	if err := domain.CheckVersion(ctx, pr.Version); err != nil {
 		return domain.PullRequest{}, err
 	}
 
+	if pr.Status == "MERGED" {
+		return domain.PullRequest{}, domain.ErrPRMerged.Msg("cannot change reviewers of merged PR")
+	}
 	return pr, nil
 }
 
-func (s *pullRequestService) MergePR(prID string) (domain.PullRequest, error) {
-	pr, err := s.repo.GetPR(prID)
+// excluded builds the set of users that may not review a PR of the author: the
+// author, the reviewers excluded on the PR and the author's exclusion rules.
+func (s *pullRequestService) excluded(org, authorID string, perPR []string) (map[string]struct{}, error) {
+	skip, err := s.repo.GetExcluded(org, authorID)
+	if err != nil {
+		return nil, err
+	}
+
+	result := map[string]struct{}{authorID: {}}
+	for id := range skip {
+		result[id] = struct{}{}
+	}
+	for _, id := range perPR {
+		result[id] = struct{}{}
+	}
+	return result, nil
+}
+
+func (s *pullRequestService) MergePR(ctx context.Context, prID string) (domain.PullRequest, error) {
+	org := domain.OrganizationFrom(ctx)
+	pr, err := s.repo.GetPR(org, prID)
 	if err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
-			return domain.PullRequest{}, errors.New("NOT_FOUND")
+			return domain.PullRequest{}, errPRNotFound
 		}
 		return domain.PullRequest{}, err
 	}
+	if err := domain.CheckVersion(ctx, pr.Version); err != nil {
+		return domain.PullRequest{}, err
+	}
 
 	if pr.Status == "MERGED" {
 		return pr, nil
@@ -90,24 +370,37 @@ func (s *pullRequestService) MergePR(prID string) (domain.PullRequest, error) {
 	pr.Status = "MERGED"
 	pr.MergedAt = &now
 
-	if err := s.repo.UpdatePR(pr); err != nil {
+	if err := s.repo.UpdatePR(org, &pr); err != nil {
 		return domain.PullRequest{}, err
 	}
 
 	return pr, nil
 }
 
-func (s *pullRequestService) ReassignReviewer(prID, oldUserID string) (domain.PullRequest, string, error) {
-	pr, err := s.repo.GetPR(prID)
+// ReassignReviewer replaces a reviewer of an OPEN PR. With newUserID the replacement
+// is the named user, otherwise it is picked like on creation from the old
+// reviewer's team and its fallbacks.
+func (s *pullRequestService) ReassignReviewer(ctx context.Context, prID, oldUserID, newUserID string) (domain.PullRequest, domain.ReassignReport, error) {
+	org := domain.OrganizationFrom(ctx)
+	pr, report, err := s.replaceReviewer(ctx, org, prID, oldUserID, newUserID)
 	if err != nil {
-		if errors.Is(err, gorm.ErrRecordNotFound) {
-			return domain.PullRequest{}, "", errors.New("PR_NOT_FOUND")
-		}
-		return domain.PullRequest{}, "", err
+		return domain.PullRequest{}, report, err
 	}
 
-	if pr.Status == "MERGED" {
-		return domain.PullRequest{}, "", errors.New("PR_MERGED")
+	if err := s.repo.UpdatePR(org, &pr); err != nil {
+		return domain.PullRequest{}, report, err
+	}
+	return pr, report, nil
+}
+
+// replaceReviewer puts the replacement in place of the old reviewer, without saving
+// the PR.
+func (s *pullRequestService) replaceReviewer(ctx context.Context, org, prID, oldUserID, newUserID string) (domain.PullRequest, domain.ReassignReport, error) {
+	var report domain.ReassignReport
+
+	pr, err := s.getOpenPR(ctx, org, prID)
+	if err != nil {
+		return domain.PullRequest{}, report, err
 	}
 
 	var index int = -1
@@ -118,46 +411,81 @@ func (s *pullRequestService) ReassignReviewer(prID, oldUserID string) (domain.Pu
 		}
 	}
 	if index == -1 {
-		return domain.PullRequest{}, "", errors.New("NOT_ASSIGNED")
+		return domain.PullRequest{}, report, domain.ErrNotAssigned
 	}
 
-	oldUser, err := s.repo.GetUserByID(oldUserID)
+	oldUser, err := s.repo.GetUserByID(org, oldUserID)
 	if err != nil {
-		return domain.PullRequest{}, "", errors.New("USER_NOT_FOUND")
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return domain.PullRequest{}, report, domain.ErrNotFound.Msg("user not found")
+		}
+		return domain.PullRequest{}, report, err
 	}
 
-	candidates, err := s.repo.GetTeamMembers(oldUser.TeamName)
+	author, err := s.repo.GetUserByID(org, pr.AuthorID)
 	if err != nil {
-		return domain.PullRequest{}, "", err
+		return domain.PullRequest{}, report, err
 	}
 
-	var available []domain.User
-	for _, u := range candidates {
-		if u.ID != oldUserID {
-			skip := false
-			for _, r := range pr.AssignedReviewers {
-				if r.ID == u.ID {
-					skip = true
-					break
-				}
-			}
-			if !skip {
-				available = append(available, u)
-			}
-		}
+	skip, err := s.excluded(org, pr.AuthorID, pr.ExcludedReviewers)
+	if err != nil {
+		return domain.PullRequest{}, report, err
 	}
 
-	if len(available) == 0 {
-		return domain.PullRequest{}, "", errors.New("NO_CANDIDATE")
+	policy, err := s.repo.GetReviewPolicy(org, author.TeamName)
+	if err != nil {
+		return domain.PullRequest{}, report, err
+	}
+
+	var newReviewer domain.User
+	if newUserID != "" {
+		newReviewer, err = s.checkManualReviewer(org, newUserID, pr.AuthorID, pr.AssignedReviewers, skip)
+		if err != nil {
+			return domain.PullRequest{}, report, err
+		}
+	} else {
+		newReviewer, err = s.pickReplacement(org, pr, oldUser, policy, skip)
+		if err != nil {
+			return domain.PullRequest{}, report, err
+		}
 	}
 
-	rand.Seed(time.Now().UnixNano())
-	newReviewer := available[rand.Intn(len(available))]
 	pr.AssignedReviewers[index] = newReviewer
 
-	if err := s.repo.UpdatePR(pr); err != nil {
-		return domain.PullRequest{}, "", err
+	report.ReplacedBy = newReviewer.ID
+	report.External = newReviewer.TeamName != author.TeamName
+	report.PairingBroken = policy == domain.ReviewPolicyPairing && newReviewer.Seniority != oldUser.Seniority
+	return pr, report, nil
+}
+
+// UpdateMetadata changes the repository, branches, URL, labels or size of a PR. It
+// works on MERGED PRs too, since it doesn't touch the review.
+func (s *pullRequestService) UpdateMetadata(ctx context.Context, prID string, patch domain.PullRequestMetadataPatch) (domain.PullRequest, error) {
+	org := domain.OrganizationFrom(ctx)
+	pr, err := s.repo.GetPR(org, prID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return domain.PullRequest{}, errPRNotFound
+		}
+		return domain.PullRequest{}, err
+	}
+	if err := domain.CheckVersion(ctx, pr.Version); err != nil {
+		return domain.PullRequest{}, err
+	}
+
+	meta, err := normalizeMetadata(applyMetadataPatch(pr.PullRequestMetadata, patch))
+	if err != nil {
+		return domain.PullRequest{}, err
 	}
 
-	return pr, newReviewer.ID, nil
+	pr.PullRequestMetadata = meta
+	if err := s.repo.UpdateMetadata(org, &pr); err != nil {
+		return domain.PullRequest{}, err
+	}
+	return pr, nil
+}
+
+func (s *pullRequestService) ListPRs(ctx context.Context, filter domain.PullRequestFilter) ([]domain.PullRequest, error) {
+	org := domain.OrganizationFrom(ctx)
+	return s.repo.ListPRs(org, filter)
 }
@@ -0,0 +1,45 @@
+package rateLimitService
+
+import (
+	"math"
+	"time"
+)
+
+// Result is the state of a bucket after a request tried to take from it.
+type Result struct {
+	Allowed bool
+	Limit   int
+	// Remaining is how many more requests the bucket allows right now.
+	Remaining int
+	// Reset is how long the bucket takes to fill up completely.
+	Reset time.Duration
+	// RetryAfter is how long a refused request should wait for the next token.
+	RetryAfter time.Duration
+}
+
+// take refills a bucket that had tokens at last and spends one token if there is
+// one. It returns the tokens left at now.
+func take(tokens float64, last time.Time, limit Limit, now time.Time) (float64, Result) {
+	rate := limit.perSecond()
+	elapsed := now.Sub(last).Seconds()
+	if elapsed < 0 {
+		// Replicas sharing a bucket may disagree a little about the time.
+		elapsed = 0
+	}
+	tokens = math.Min(float64(limit.Requests), tokens+elapsed*rate)
+
+	result := Result{Limit: limit.Requests}
+	if tokens >= 1 {
+		tokens--
+		result.Allowed = true
+	} else {
+		result.RetryAfter = seconds((1 - tokens) / rate)
+	}
+	result.Remaining = int(tokens)
+	result.Reset = seconds((float64(limit.Requests) - tokens) / rate)
+	return tokens, result
+}
+
+func seconds(s float64) time.Duration {
+	return time.Duration(s * float64(time.Second))
+}
@@ -0,0 +1,60 @@
+package rateLimitService
+
+import (
+	"testing"
+	"time"
+)
+
+func TestTakeBurstAndRefill(t *testing.T) {
+	limit := Limit{Requests: 3, Period: 3 * time.Second} // one token per second
+	start := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	tokens := float64(limit.Requests)
+	var result Result
+	for i := 0; i < 3; i++ {
+		tokens, result = take(tokens, start, limit, start)
+		if !result.Allowed || result.Remaining != 2-i {
+			t.Fatalf("burst request %d = %+v, want allowed with %d left", i+1, result, 2-i)
+		}
+	}
+
+	tokens, result = take(tokens, start, limit, start)
+	if result.Allowed || result.RetryAfter != time.Second || result.Reset != 3*time.Second {
+		t.Fatalf("request over the burst = %+v, want refused, retry in 1s, full in 3s", result)
+	}
+
+	// Half a token doesn't allow a request yet, but halves the wait.
+	tokens, result = take(tokens, start, limit, start.Add(500*time.Millisecond))
+	if result.Allowed || result.RetryAfter != 500*time.Millisecond {
+		t.Fatalf("request after 0.5s = %+v, want refused, retry in 0.5s", result)
+	}
+
+	tokens, result = take(tokens, start.Add(500*time.Millisecond), limit, start.Add(time.Second))
+	if !result.Allowed || result.Remaining != 0 {
+		t.Fatalf("request after 1s = %+v, want allowed with none left", result)
+	}
+	if tokens != 0 {
+		t.Fatalf("tokens after the refilled request = %v, want 0", tokens)
+	}
+}
+
+func TestTakeCapsAtBurst(t *testing.T) {
+	limit := Limit{Requests: 10, Period: time.Minute}
+	start := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	tokens, result := take(0, start, limit, start.Add(time.Hour))
+	if !result.Allowed || tokens != 9 || result.Remaining != 9 {
+		t.Fatalf("after an idle hour tokens = %v, result %+v; want 9 left of 10", tokens, result)
+	}
+}
+
+func TestTakeIgnoresClockSkew(t *testing.T) {
+	limit := Limit{Requests: 10, Period: time.Minute}
+	start := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	// Another replica stamped the bucket a little in our future.
+	tokens, result := take(0.5, start.Add(time.Second), limit, start)
+	if result.Allowed || tokens != 0.5 {
+		t.Fatalf("tokens = %v, result %+v; want the bucket unchanged and the request refused", tokens, result)
+	}
+}
@@ -0,0 +1,150 @@
+package rateLimitService
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Limit lets a client make Requests requests per Period. Unused requests don't pile
+// up beyond Requests, so that is also the largest burst.
+type Limit struct {
+	Requests int
+	Period   time.Duration
+}
+
+// perSecond is the rate the bucket refills at.
+func (l Limit) perSecond() float64 {
+	return float64(l.Requests) / l.Period.Seconds()
+}
+
+// ParseLimit reads a limit like "600/m", "10/s", "1000/h" or "30/10s".
+func ParseLimit(value string) (Limit, error) {
+	count, period, ok := strings.Cut(strings.TrimSpace(value), "/")
+	if !ok {
+		return Limit{}, fmt.Errorf("invalid rate limit %q: want requests/period", value)
+	}
+	requests, err := strconv.Atoi(count)
+	if err != nil || requests <= 0 {
+		return Limit{}, fmt.Errorf("invalid rate limit %q: requests must be a positive number", value)
+	}
+	if period == "s" || period == "m" || period == "h" {
+		period = "1" + period
+	}
+	d, err := time.ParseDuration(period)
+	if err != nil || d <= 0 {
+		return Limit{}, fmt.Errorf("invalid rate limit %q: bad period", value)
+	}
+	return Limit{Requests: requests, Period: d}, nil
+}
+
+// Config sets the limit every client gets and the limits of routes that differ from
+// it. A route with its own limit has its own bucket; all other routes share one. IP
+// is the limit of all requests from one address, whoever they authenticate as.
+type Config struct {
+	// Disabled turns rate limiting off.
+	Disabled bool
+	Default  Limit
+	Routes   map[string]Limit
+	IP       Limit
+	// Store is "memory" for buckets kept in the process or "postgres" for buckets
+	// shared by all replicas.
+	Store string
+	// TrustProxy takes the client IP from X-Forwarded-For instead of the connection.
+	TrustProxy bool
+}
+
+// DefaultConfig is what ConfigFromEnv starts from. /stats and /users/deactivate are
+// expensive, so they get much less than the rest. An IP gets more than a client, as
+// several clients may share it.
+func DefaultConfig() Config {
+	return Config{
+		Default: Limit{Requests: 1200, Period: time.Minute},
+		IP:      Limit{Requests: 3000, Period: time.Minute},
+		Routes: map[string]Limit{
+			"/stats":            {Requests: 30, Period: time.Minute},
+			"/users/deactivate": {Requests: 10, Period: time.Minute},
+		},
+		Store: StoreMemory,
+	}
+}
+
+// Stores the buckets can be kept in.
+const (
+	StoreMemory   = "memory"
+	StorePostgres = "postgres"
+)
+
+// ConfigFromEnv reads the RATE_LIMIT_* variables on top of DefaultConfig.
+// RATE_LIMIT_ROUTES looks like "/stats=60/m,/pullRequest/create=100/m"; the routes it
+// names replace their defaults, the others keep them.
+func ConfigFromEnv() (Config, error) {
+	cfg := DefaultConfig()
+
+	if disabled := os.Getenv("RATE_LIMIT_DISABLED"); disabled != "" {
+		off, err := strconv.ParseBool(disabled)
+		if err != nil {
+			return Config{}, fmt.Errorf("invalid RATE_LIMIT_DISABLED: %w", err)
+		}
+		cfg.Disabled = off
+	}
+
+	if value := os.Getenv("RATE_LIMIT"); value != "" {
+		limit, err := ParseLimit(value)
+		if err != nil {
+			return Config{}, fmt.Errorf("invalid RATE_LIMIT: %w", err)
+		}
+		cfg.Default = limit
+	}
+
+	if value := os.Getenv("RATE_LIMIT_IP"); value != "" {
+		limit, err := ParseLimit(value)
+		if err != nil {
+			return Config{}, fmt.Errorf("invalid RATE_LIMIT_IP: %w", err)
+		}
+		cfg.IP = limit
+	}
+
+	if routes := os.Getenv("RATE_LIMIT_ROUTES"); routes != "" {
+		for _, pair := range strings.Split(routes, ",") {
+			route, value, ok := strings.Cut(strings.TrimSpace(pair), "=")
+			if !ok || !strings.HasPrefix(route, "/") {
+				return Config{}, fmt.Errorf("invalid RATE_LIMIT_ROUTES entry %q", pair)
+			}
+			limit, err := ParseLimit(value)
+			if err != nil {
+				return Config{}, fmt.Errorf("invalid RATE_LIMIT_ROUTES entry %q: %w", pair, err)
+			}
+			cfg.Routes[route] = limit
+		}
+	}
+
+	if store := os.Getenv("RATE_LIMIT_STORE"); store != "" {
+		if store != StoreMemory && store != StorePostgres {
+			return Config{}, fmt.Errorf("invalid RATE_LIMIT_STORE %q: want %s or %s", store, StoreMemory, StorePostgres)
+		}
+		cfg.Store = store
+	}
+
+	if trust := os.Getenv("RATE_LIMIT_TRUST_PROXY"); trust != "" {
+		on, err := strconv.ParseBool(trust)
+		if err != nil {
+			return Config{}, fmt.Errorf("invalid RATE_LIMIT_TRUST_PROXY: %w", err)
+		}
+		cfg.TrustProxy = on
+	}
+	return cfg, nil
+}
+
+// longestPeriod is how long any bucket takes at most to fill up again.
+func (c Config) longestPeriod() time.Duration {
+	longest := max(c.Default.Period, c.IP.Period)
+	for _, limit := range c.Routes {
+		if limit.Period > longest {
+			longest = limit.Period
+		}
+	}
+	return longest
+}
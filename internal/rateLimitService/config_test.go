@@ -0,0 +1,93 @@
+package rateLimitService
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestParseLimit(t *testing.T) {
+	tests := []struct {
+		value string
+		want  Limit
+	}{
+		{"600/m", Limit{Requests: 600, Period: time.Minute}},
+		{"10/s", Limit{Requests: 10, Period: time.Second}},
+		{"1000/h", Limit{Requests: 1000, Period: time.Hour}},
+		{"30/10s", Limit{Requests: 30, Period: 10 * time.Second}},
+		{" 5/1m30s ", Limit{Requests: 5, Period: 90 * time.Second}},
+	}
+	for _, tt := range tests {
+		got, err := ParseLimit(tt.value)
+		if err != nil || got != tt.want {
+			t.Errorf("ParseLimit(%q) = %+v, %v; want %+v", tt.value, got, err, tt.want)
+		}
+	}
+
+	for _, value := range []string{"", "600", "0/m", "-1/m", "x/m", "10/", "10/d", "10/0s", "10/-1s"} {
+		if got, err := ParseLimit(value); err == nil {
+			t.Errorf("ParseLimit(%q) = %+v, want an error", value, got)
+		}
+	}
+}
+
+func TestConfigFromEnv(t *testing.T) {
+	t.Setenv("RATE_LIMIT_DISABLED", "")
+	t.Setenv("RATE_LIMIT", "100/m")
+	t.Setenv("RATE_LIMIT_IP", "500/m")
+	t.Setenv("RATE_LIMIT_ROUTES", "/stats=60/m, /pullRequest/create=5/s")
+	t.Setenv("RATE_LIMIT_STORE", StorePostgres)
+	t.Setenv("RATE_LIMIT_TRUST_PROXY", "true")
+
+	cfg, err := ConfigFromEnv()
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := Config{
+		Default: Limit{Requests: 100, Period: time.Minute},
+		IP:      Limit{Requests: 500, Period: time.Minute},
+		Routes: map[string]Limit{
+			"/stats":              {Requests: 60, Period: time.Minute},
+			"/users/deactivate":   {Requests: 10, Period: time.Minute},
+			"/pullRequest/create": {Requests: 5, Period: time.Second},
+		},
+		Store:      StorePostgres,
+		TrustProxy: true,
+	}
+	if !reflect.DeepEqual(cfg, want) {
+		t.Errorf("ConfigFromEnv = %+v, want %+v", cfg, want)
+	}
+	if got := cfg.longestPeriod(); got != time.Minute {
+		t.Errorf("longestPeriod = %s, want 1m", got)
+	}
+}
+
+func TestConfigFromEnvErrors(t *testing.T) {
+	tests := []struct {
+		name, value, want string
+	}{
+		{"RATE_LIMIT_DISABLED", "sometimes", "invalid RATE_LIMIT_DISABLED"},
+		{"RATE_LIMIT", "fast", "invalid RATE_LIMIT"},
+		{"RATE_LIMIT_IP", "0/m", "invalid RATE_LIMIT_IP"},
+		{"RATE_LIMIT_ROUTES", "stats=1/m", "invalid RATE_LIMIT_ROUTES entry"},
+		{"RATE_LIMIT_ROUTES", "/stats=often", "invalid RATE_LIMIT_ROUTES entry"},
+		{"RATE_LIMIT_STORE", "redis", "invalid RATE_LIMIT_STORE"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name+"="+tt.value, func(t *testing.T) {
+			t.Setenv(tt.name, tt.value)
+			if _, err := ConfigFromEnv(); err == nil || !strings.Contains(err.Error(), tt.want) {
+				t.Fatalf("ConfigFromEnv error = %v, want %q", err, tt.want)
+			}
+		})
+	}
+}
+
+func TestConfigFromEnvDisabled(t *testing.T) {
+	t.Setenv("RATE_LIMIT_DISABLED", "true")
+	cfg, err := ConfigFromEnv()
+	if err != nil || !cfg.Disabled {
+		t.Fatalf("ConfigFromEnv = %+v, %v; want rate limiting disabled", cfg, err)
+	}
+}
@@ -0,0 +1,72 @@
+package rateLimitService
+
+import (
+	"PullRequestService/domain"
+	echo "github.com/labstack/echo/v4"
+	"log"
+	"math"
+	"strconv"
+	"time"
+)
+
+// Middleware limits how often a client may call the API. A client is its API token,
+// its JWT user, or without authentication its IP. Every response carries the
+// RateLimit-Limit, RateLimit-Remaining and RateLimit-Reset headers of the bucket the
+// request took from; a request over the limit gets 429 RATE_LIMITED with Retry-After.
+// When the buckets can't be reached, requests are let through. It must run after
+// authentication.
+func Middleware(service RateLimitService) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			result, err := service.Take(c.Request().Context(), clientKey(c), c.Path())
+			return apply(c, next, result, err)
+		}
+	}
+}
+
+// IPMiddleware limits how often one IP address may call the API, whoever it
+// authenticates as. It runs before authentication, so requests rejected with 401
+// count too, and answers like Middleware; for requests that get through, Middleware
+// replaces the headers with those of the client's bucket.
+func IPMiddleware(service RateLimitService) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			result, err := service.TakeIP(c.Request().Context(), c.RealIP())
+			return apply(c, next, result, err)
+		}
+	}
+}
+
+// apply sets the RateLimit headers of the bucket and passes the request on unless it
+// is over the limit.
+func apply(c echo.Context, next echo.HandlerFunc, result Result, err error) error {
+	if err != nil {
+		log.Printf("rate limit: %v", err)
+		return next(c)
+	}
+
+	header := c.Response().Header()
+	header.Set("RateLimit-Limit", strconv.Itoa(result.Limit))
+	header.Set("RateLimit-Remaining", strconv.Itoa(result.Remaining))
+	header.Set("RateLimit-Reset", ceilSeconds(result.Reset))
+	if !result.Allowed {
+		header.Set("Retry-After", ceilSeconds(result.RetryAfter))
+		return ErrRateLimited.Msgf("rate limit exceeded, retry in %s seconds", ceilSeconds(result.RetryAfter))
+	}
+	return next(c)
+}
+
+// clientKey names the bucket owner of the request.
+func clientKey(c echo.Context) string {
+	if identity, ok := domain.IdentityFrom(c.Request().Context()); ok {
+		if identity.TokenID != 0 {
+			return "token:" + strconv.FormatInt(identity.TokenID, 10)
+		}
+		return "user:" + identity.Organization + "/" + identity.UserID
+	}
+	return "ip:" + c.RealIP()
+}
+
+func ceilSeconds(d time.Duration) string {
+	return strconv.Itoa(int(math.Ceil(d.Seconds())))
+}
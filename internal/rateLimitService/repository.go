@@ -0,0 +1,100 @@
+package rateLimitService
+
+import (
+	"PullRequestService/domain"
+	"sync"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// RateLimitRepository keeps the token buckets. Keys name clients, not organizations,
+// so buckets are not scoped to one.
+type RateLimitRepository interface {
+	Take(key string, limit Limit, now time.Time) (Result, error)
+	Prune(before time.Time) (int64, error)
+}
+
+type rateLimitRepository struct {
+	db *gorm.DB
+}
+
+// NewRateLimitRepository keeps the buckets in Postgres, so replicas share them.
+func NewRateLimitRepository(db *gorm.DB) RateLimitRepository {
+	return &rateLimitRepository{db: db}
+}
+
+// Take locks the bucket row, so concurrent requests of a client on different replicas
+// take from it one after another.
+func (r *rateLimitRepository) Take(key string, limit Limit, now time.Time) (Result, error) {
+	var result Result
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		err := tx.Exec(`INSERT INTO rate_limit_buckets (key, tokens, updated_at) VALUES (?, ?, ?)
+			ON CONFLICT (key) DO NOTHING`, key, float64(limit.Requests), now).Error
+		if err != nil {
+			return err
+		}
+
+		var bucket domain.RateLimitBucket
+		if err := tx.Raw("SELECT key, tokens, updated_at FROM rate_limit_buckets WHERE key = ? FOR UPDATE", key).
+			Scan(&bucket).Error; err != nil {
+			return err
+		}
+
+		var tokens float64
+		tokens, result = take(bucket.Tokens, bucket.UpdatedAt, limit, now)
+		if bucket.UpdatedAt.After(now) {
+			now = bucket.UpdatedAt
+		}
+		return tx.Exec("UPDATE rate_limit_buckets SET tokens = ?, updated_at = ? WHERE key = ?", tokens, now, key).Error
+	})
+	return result, err
+}
+
+// Prune deletes buckets untouched since before. Once a bucket has been idle for its
+// period it is full, which is the same as having no bucket.
+func (r *rateLimitRepository) Prune(before time.Time) (int64, error) {
+	res := r.db.Where("updated_at < ?", before).Delete(&domain.RateLimitBucket{})
+	return res.RowsAffected, res.Error
+}
+
+type memoryRepository struct {
+	mu      sync.Mutex
+	buckets map[string]*domain.RateLimitBucket
+}
+
+// NewMemoryRepository keeps the buckets in the process. Each replica then limits
+// clients on its own.
+func NewMemoryRepository() RateLimitRepository {
+	return &memoryRepository{buckets: map[string]*domain.RateLimitBucket{}}
+}
+
+func (m *memoryRepository) Take(key string, limit Limit, now time.Time) (Result, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	bucket, ok := m.buckets[key]
+	if !ok {
+		bucket = &domain.RateLimitBucket{Key: key, Tokens: float64(limit.Requests), UpdatedAt: now}
+		m.buckets[key] = bucket
+	}
+
+	var result Result
+	bucket.Tokens, result = take(bucket.Tokens, bucket.UpdatedAt, limit, now)
+	bucket.UpdatedAt = now
+	return result, nil
+}
+
+func (m *memoryRepository) Prune(before time.Time) (int64, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	var count int64
+	for key, bucket := range m.buckets {
+		if bucket.UpdatedAt.Before(before) {
+			delete(m.buckets, key)
+			count++
+		}
+	}
+	return count, nil
+}
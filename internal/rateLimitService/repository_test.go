@@ -0,0 +1,83 @@
+package rateLimitService
+
+import (
+	"PullRequestService/internal/testdb"
+	"context"
+	"testing"
+	"time"
+)
+
+// testRepository runs the same requests against a bucket store: keys get their own
+// buckets, a bucket refills over time and Prune drops the idle ones.
+func testRepository(t *testing.T, repo RateLimitRepository) {
+	limit := Limit{Requests: 2, Period: 2 * time.Second}
+	start := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	takeAt := func(key string, at time.Time, allowed bool) {
+		t.Helper()
+		result, err := repo.Take(key, limit, at)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if result.Allowed != allowed {
+			t.Fatalf("Take(%s) at %s allowed = %v, want %v", key, at.Format(time.StampMilli), result.Allowed, allowed)
+		}
+	}
+
+	takeAt("a", start, true)
+	takeAt("a", start, true)
+	takeAt("a", start, false)
+	takeAt("b", start, true)
+	takeAt("a", start.Add(time.Second), true)
+	takeAt("a", start.Add(time.Second), false)
+
+	pruned, err := repo.Prune(start.Add(500 * time.Millisecond))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if pruned != 1 {
+		t.Fatalf("Prune dropped %d buckets, want only b", pruned)
+	}
+	takeAt("a", start.Add(time.Second), false)
+}
+
+func TestMemoryRepository(t *testing.T) {
+	testRepository(t, NewMemoryRepository())
+}
+
+func TestPostgresRepository(t *testing.T) {
+	testRepository(t, NewRateLimitRepository(testdb.Open(t)))
+}
+
+func TestServiceBuckets(t *testing.T) {
+	cfg := Config{
+		Default: Limit{Requests: 1, Period: time.Hour},
+		IP:      Limit{Requests: 1, Period: time.Hour},
+		Routes:  map[string]Limit{"/stats": {Requests: 1, Period: time.Hour}},
+	}
+	s := NewRateLimitService(NewMemoryRepository(), cfg)
+	ctx := context.Background()
+
+	steps := []struct {
+		name    string
+		take    func() (Result, error)
+		allowed bool
+	}{
+		{"client, shared bucket", func() (Result, error) { return s.Take(ctx, "token:1", "/team/get") }, true},
+		{"client, other route in the shared bucket", func() (Result, error) { return s.Take(ctx, "token:1", "/users/getReview") }, false},
+		{"client, route with its own bucket", func() (Result, error) { return s.Take(ctx, "token:1", "/stats") }, true},
+		{"other client", func() (Result, error) { return s.Take(ctx, "token:2", "/team/get") }, true},
+		{"IP of an unauthenticated client", func() (Result, error) { return s.Take(ctx, "ip:10.0.0.1", "/team/get") }, true},
+		{"same IP, IP bucket", func() (Result, error) { return s.TakeIP(ctx, "10.0.0.1") }, true},
+		{"same IP again", func() (Result, error) { return s.TakeIP(ctx, "10.0.0.1") }, false},
+	}
+	for _, step := range steps {
+		result, err := step.take()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if result.Allowed != step.allowed {
+			t.Errorf("%s: allowed = %v, want %v", step.name, result.Allowed, step.allowed)
+		}
+	}
+}
@@ -0,0 +1,26 @@
+package rateLimitService
+
+import (
+	"context"
+	"log"
+	"time"
+)
+
+// RunPruning periodically deletes the buckets of clients that went quiet.
+func RunPruning(service RateLimitService, interval time.Duration) {
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		for range ticker.C {
+			count, err := service.Prune(context.Background())
+			if err != nil {
+				log.Printf("rate limit pruning failed: %v", err)
+				continue
+			}
+			if count > 0 {
+				log.Printf("rate limit pruning: %d buckets deleted", count)
+			}
+		}
+	}()
+}
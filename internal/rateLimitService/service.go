@@ -0,0 +1,47 @@
+package rateLimitService
+
+import (
+	"PullRequestService/domain"
+	"context"
+	"net/http"
+	"time"
+)
+
+type RateLimitService interface {
+	Take(ctx context.Context, client, route string) (Result, error)
+	TakeIP(ctx context.Context, ip string) (Result, error)
+	Prune(ctx context.Context) (int64, error)
+}
+
+// ErrRateLimited is returned for a request over the client's limit.
+var ErrRateLimited = domain.NewError("RATE_LIMITED", http.StatusTooManyRequests, "rate limit exceeded")
+
+type rateLimitService struct {
+	repo RateLimitRepository
+	cfg  Config
+}
+
+func NewRateLimitService(repo RateLimitRepository, cfg Config) RateLimitService {
+	return &rateLimitService{repo: repo, cfg: cfg}
+}
+
+// Take spends one request of the client on the route: from the route's own bucket if
+// the route has a limit of its own, otherwise from the client's shared bucket.
+func (s *rateLimitService) Take(ctx context.Context, client, route string) (Result, error) {
+	key, limit := client, s.cfg.Default
+	if routeLimit, ok := s.cfg.Routes[route]; ok {
+		key, limit = client+" "+route, routeLimit
+	}
+	return s.repo.Take(key, limit, time.Now())
+}
+
+// TakeIP spends one request of the IP address. The bucket is separate from the
+// client buckets, including the ones of unauthenticated clients keyed by IP.
+func (s *rateLimitService) TakeIP(ctx context.Context, ip string) (Result, error) {
+	return s.repo.Take("source:"+ip, s.cfg.IP, time.Now())
+}
+
+// Prune forgets the buckets that have been idle long enough to be full again.
+func (s *rateLimitService) Prune(ctx context.Context) (int64, error) {
+	return s.repo.Prune(time.Now().Add(-s.cfg.longestPeriod()))
+}
@@ -0,0 +1,67 @@
+package rosterService
+
+import "PullRequestService/domain"
+
+// diffRoster compares the imported roster with the current state of the listed teams.
+// Members of a listed team that are missing from the roster are deactivated.
+func diffRoster(roster domain.Roster, existingTeams []domain.Team, existingUsers []domain.User) domain.RosterDiff {
+	diff := domain.RosterDiff{}
+
+	teams := make(map[string]struct{}, len(existingTeams))
+	for _, t := range existingTeams {
+		teams[t.Name] = struct{}{}
+	}
+
+	users := make(map[string]domain.User, len(existingUsers))
+	for _, u := range existingUsers {
+		users[u.ID] = u
+	}
+
+	rosterTeams := make(map[string]struct{}, len(roster.Teams))
+	listed := make(map[string]struct{})
+	for _, t := range roster.Teams {
+		rosterTeams[t.Name] = struct{}{}
+		if _, ok := teams[t.Name]; !ok {
+			diff.TeamsToCreate = append(diff.TeamsToCreate, t.Name)
+		}
+
+		for _, m := range t.Members {
+			listed[m.ID] = struct{}{}
+
+			cur, ok := users[m.ID]
+			if !ok {
+				m.TeamName = t.Name
+				diff.UsersToCreate = append(diff.UsersToCreate, m)
+				continue
+			}
+
+			if cur.TeamName != t.Name {
+				diff.UsersToMove = append(diff.UsersToMove, domain.RosterMove{
+					UserID:   m.ID,
+					FromTeam: cur.TeamName,
+					ToTeam:   t.Name,
+				})
+			}
+			if m.IsActive && !cur.IsActive {
+				diff.UsersToActivate = append(diff.UsersToActivate, m.ID)
+			}
+			if !m.IsActive && cur.IsActive {
+				diff.UsersToDeactivate = append(diff.UsersToDeactivate, m.ID)
+			}
+		}
+	}
+
+	for _, u := range existingUsers {
+		if _, ok := rosterTeams[u.TeamName]; !ok {
+			continue
+		}
+		if _, ok := listed[u.ID]; ok {
+			continue
+		}
+		if u.IsActive {
+			diff.UsersToDeactivate = append(diff.UsersToDeactivate, u.ID)
+		}
+	}
+
+	return diff
+}
@@ -0,0 +1,73 @@
+package rosterService
+
+import (
+	"PullRequestService/domain"
+	"reflect"
+	"testing"
+)
+
+func TestDiffRoster(t *testing.T) {
+	existingTeams := []domain.Team{{Name: "backend"}, {Name: "frontend"}, {Name: "data"}}
+	existingUsers := []domain.User{
+		{ID: "u1", TeamName: "backend", IsActive: true},
+		{ID: "u2", TeamName: "backend", IsActive: true},
+		{ID: "u3", TeamName: "frontend", IsActive: false},
+		{ID: "u4", TeamName: "frontend", IsActive: true},
+		{ID: "u5", TeamName: "data", IsActive: true},
+	}
+	member := func(id string, active bool) domain.User {
+		return domain.User{ID: id, IsActive: active}
+	}
+
+	tests := []struct {
+		name   string
+		roster []domain.Team
+		want   domain.RosterDiff
+	}{
+		{
+			name:   "unchanged",
+			roster: []domain.Team{{Name: "backend", Members: []domain.User{member("u1", true), member("u2", true)}}},
+		},
+		{
+			name: "moved user",
+			roster: []domain.Team{
+				{Name: "backend", Members: []domain.User{member("u1", true)}},
+				{Name: "frontend", Members: []domain.User{member("u2", true), member("u3", false), member("u4", true)}},
+			},
+			want: domain.RosterDiff{
+				UsersToMove: []domain.RosterMove{{UserID: "u2", FromTeam: "backend", ToTeam: "frontend"}},
+			},
+		},
+		{
+			name:   "deactivated user",
+			roster: []domain.Team{{Name: "backend", Members: []domain.User{member("u1", true), member("u2", false)}}},
+			want:   domain.RosterDiff{UsersToDeactivate: []string{"u2"}},
+		},
+		{
+			name:   "user left out of a listed team",
+			roster: []domain.Team{{Name: "frontend", Members: []domain.User{member("u3", false)}}},
+			want:   domain.RosterDiff{UsersToDeactivate: []string{"u4"}},
+		},
+		{
+			name:   "activated user",
+			roster: []domain.Team{{Name: "frontend", Members: []domain.User{member("u3", true), member("u4", true)}}},
+			want:   domain.RosterDiff{UsersToActivate: []string{"u3"}},
+		},
+		{
+			name:   "new team and user",
+			roster: []domain.Team{{Name: "mobile", Members: []domain.User{{ID: "u9", Username: "Dan", IsActive: true}}}},
+			want: domain.RosterDiff{
+				TeamsToCreate: []string{"mobile"},
+				UsersToCreate: []domain.User{{ID: "u9", Username: "Dan", TeamName: "mobile", IsActive: true}},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := diffRoster(domain.Roster{Teams: tt.roster}, existingTeams, existingUsers)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("diffRoster = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,137 @@
+package rosterService
+
+import (
+	"PullRequestService/domain"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+type yamlRoster struct {
+	Teams []struct {
+		TeamName string `yaml:"team_name"`
+		Members  []struct {
+			UserID   string `yaml:"user_id"`
+			Username string `yaml:"username"`
+			IsActive *bool  `yaml:"is_active"`
+		} `yaml:"members"`
+	} `yaml:"teams"`
+}
+
+func ParseRoster(format string, r io.Reader) (domain.Roster, error) {
+	switch format {
+	case "csv":
+		return parseCSV(r)
+	case "yaml", "yml":
+		return parseYAML(r)
+	default:
+		return domain.Roster{}, fmt.Errorf("unsupported roster format %q", format)
+	}
+}
+
+// parseCSV reads rows of team_name,user_id,username,is_active. A row with an empty
+// user_id only declares the team.
+func parseCSV(r io.Reader) (domain.Roster, error) {
+	reader := csv.NewReader(r)
+	reader.TrimLeadingSpace = true
+
+	header, err := reader.Read()
+	if err != nil {
+		return domain.Roster{}, fmt.Errorf("read csv header: %w", err)
+	}
+
+	columns := make(map[string]int, len(header))
+	for i, h := range header {
+		columns[strings.TrimSpace(h)] = i
+	}
+	for _, c := range []string{"team_name", "user_id", "username", "is_active"} {
+		if _, ok := columns[c]; !ok {
+			return domain.Roster{}, fmt.Errorf("csv column %q is missing", c)
+		}
+	}
+
+	var roster domain.Roster
+	teamIndex := make(map[string]int)
+	for {
+		record, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return domain.Roster{}, err
+		}
+
+		teamName := strings.TrimSpace(record[columns["team_name"]])
+		if teamName == "" {
+			return domain.Roster{}, errors.New("team_name is required")
+		}
+
+		i, ok := teamIndex[teamName]
+		if !ok {
+			i = len(roster.Teams)
+			teamIndex[teamName] = i
+			roster.Teams = append(roster.Teams, domain.Team{Name: teamName})
+		}
+
+		userID := strings.TrimSpace(record[columns["user_id"]])
+		if userID == "" {
+			continue
+		}
+
+		isActive := true
+		if v := strings.TrimSpace(record[columns["is_active"]]); v != "" {
+			isActive, err = strconv.ParseBool(v)
+			if err != nil {
+				return domain.Roster{}, fmt.Errorf("invalid is_active for user %s: %w", userID, err)
+			}
+		}
+
+		roster.Teams[i].Members = append(roster.Teams[i].Members, domain.User{
+			ID:       userID,
+			Username: strings.TrimSpace(record[columns["username"]]),
+			TeamName: teamName,
+			IsActive: isActive,
+		})
+	}
+
+	return roster, nil
+}
+
+func parseYAML(r io.Reader) (domain.Roster, error) {
+	var file yamlRoster
+	if err := yaml.NewDecoder(r).Decode(&file); err != nil {
+		return domain.Roster{}, fmt.Errorf("read yaml: %w", err)
+	}
+
+	roster := domain.Roster{Teams: make([]domain.Team, 0, len(file.Teams))}
+	for _, t := range file.Teams {
+		if t.TeamName == "" {
+			return domain.Roster{}, errors.New("team_name is required")
+		}
+
+		team := domain.Team{Name: t.TeamName}
+		for _, m := range t.Members {
+			if m.UserID == "" {
+				return domain.Roster{}, fmt.Errorf("user_id is required in team %s", t.TeamName)
+			}
+			isActive := true
+			if m.IsActive != nil {
+				isActive = *m.IsActive
+			}
+			team.Members = append(team.Members, domain.User{
+				ID:       m.UserID,
+				Username: m.Username,
+				TeamName: t.TeamName,
+				IsActive: isActive,
+			})
+		}
+		roster.Teams = append(roster.Teams, team)
+	}
+
+	return roster, nil
+}
@@ -0,0 +1,72 @@
+package rosterService
+
+import (
+	"PullRequestService/domain"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestParseRosterFormatsAgree(t *testing.T) {
+	csvData := `team_name,user_id,username,is_active
+backend,u1,Alice,true
+backend,u2,Bob,false
+frontend,u3,Carol,
+platform,,,
+`
+	yamlData := `teams:
+  - team_name: backend
+    members:
+      - {user_id: u1, username: Alice, is_active: true}
+      - {user_id: u2, username: Bob, is_active: false}
+  - team_name: frontend
+    members:
+      - {user_id: u3, username: Carol}
+  - team_name: platform
+`
+	want := domain.Roster{Teams: []domain.Team{
+		{Name: "backend", Members: []domain.User{
+			{ID: "u1", Username: "Alice", TeamName: "backend", IsActive: true},
+			{ID: "u2", Username: "Bob", TeamName: "backend", IsActive: false},
+		}},
+		{Name: "frontend", Members: []domain.User{
+			{ID: "u3", Username: "Carol", TeamName: "frontend", IsActive: true},
+		}},
+		{Name: "platform"},
+	}}
+
+	for format, data := range map[string]string{"csv": csvData, "yaml": yamlData, "yml": yamlData} {
+		got, err := ParseRoster(format, strings.NewReader(data))
+		if err != nil {
+			t.Fatalf("ParseRoster(%s): %v", format, err)
+		}
+		if !reflect.DeepEqual(got, want) {
+			t.Errorf("ParseRoster(%s) = %+v, want %+v", format, got, want)
+		}
+	}
+}
+
+func TestParseRosterErrors(t *testing.T) {
+	tests := []struct {
+		name   string
+		format string
+		data   string
+		want   string
+	}{
+		{"missing team column", "csv", "user_id,username,is_active\nu1,Alice,true\n", `csv column "team_name" is missing`},
+		{"missing is_active column", "csv", "team_name,user_id,username\nbackend,u1,Alice\n", `csv column "is_active" is missing`},
+		{"empty csv team", "csv", "team_name,user_id,username,is_active\n,u1,Alice,true\n", "team_name is required"},
+		{"bad is_active", "csv", "team_name,user_id,username,is_active\nbackend,u1,Alice,maybe\n", "invalid is_active for user u1"},
+		{"empty yaml team", "yaml", "teams:\n  - members: [{user_id: u1}]\n", "team_name is required"},
+		{"yaml member without id", "yaml", "teams:\n  - team_name: backend\n    members: [{username: Alice}]\n", "user_id is required in team backend"},
+		{"unknown format", "json", "{}", `unsupported roster format "json"`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := ParseRoster(tt.format, strings.NewReader(tt.data))
+			if err == nil || !strings.Contains(err.Error(), tt.want) {
+				t.Fatalf("ParseRoster error = %v, want %q", err, tt.want)
+			}
+		})
+	}
+}
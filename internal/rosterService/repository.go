@@ -0,0 +1,154 @@
+package rosterService
+
+import (
+	"PullRequestService/domain"
+	"PullRequestService/internal/userService"
+	"gorm.io/gorm"
+)
+
+// RosterRepository imports the roster of the organization passed as org.
+type RosterRepository interface {
+	ImportRoster(org string, roster domain.Roster, dryRun bool, actor string) (*domain.ImportResult, error)
+}
+
+type rosterRepository struct {
+	db *gorm.DB
+}
+
+func NewRosterRepository(db *gorm.DB) RosterRepository {
+	return &rosterRepository{db: db}
+}
+
+func (r *rosterRepository) ImportRoster(org string, roster domain.Roster, dryRun bool, actor string) (*domain.ImportResult, error) {
+	result := &domain.ImportResult{DryRun: dryRun}
+
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		teamNames := make([]string, 0, len(roster.Teams))
+		var userIDs []string
+		for _, t := range roster.Teams {
+			teamNames = append(teamNames, t.Name)
+			for _, m := range t.Members {
+				userIDs = append(userIDs, m.ID)
+			}
+		}
+
+		var existingTeams []domain.Team
+		if err := tx.Where("organization = ? AND name IN ?", org, teamNames).Find(&existingTeams).Error; err != nil {
+			return err
+		}
+
+		var existingUsers []domain.User
+		members := tx.Where("team_name IN ?", teamNames)
+		if len(userIDs) > 0 {
+			members = members.Or("id IN ?", userIDs)
+		}
+		if err := tx.Where("organization = ?", org).Where(members).Find(&existingUsers).Error; err != nil {
+			return err
+		}
+
+		result.Diff = diffRoster(roster, existingTeams, existingUsers)
+		if dryRun {
+			return nil
+		}
+
+		return applyRoster(tx, org, roster, actor, result)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+func applyRoster(tx *gorm.DB, org string, roster domain.Roster, actor string, result *domain.ImportResult) error {
+	diff := result.Diff
+
+	for _, name := range diff.TeamsToCreate {
+		if err := tx.Create(&domain.Team{Organization: org, Name: name}).Error; err != nil {
+			return err
+		}
+	}
+
+	for _, u := range diff.UsersToCreate {
+		u.Organization = org
+		if err := tx.Create(&u).Error; err != nil {
+			return err
+		}
+	}
+
+	activate := make(map[string]struct{}, len(diff.UsersToActivate))
+	for _, id := range diff.UsersToActivate {
+		activate[id] = struct{}{}
+	}
+
+	for _, t := range roster.Teams {
+		for _, m := range t.Members {
+			updates := map[string]any{
+				"team_name": t.Name,
+			}
+			if m.Username != "" {
+				updates["username"] = m.Username
+			}
+			if _, ok := activate[m.ID]; ok {
+				updates["is_active"] = true
+			}
+			if err := tx.Model(&domain.User{}).
+				Where("organization = ? AND id = ?", org, m.ID).
+				Updates(updates).Error; err != nil {
+				return err
+			}
+		}
+	}
+
+	// Users who moved leave their reviews on the old team's PRs to the old team, as
+	// if they had been deactivated there; reviews nobody can take are released.
+	movedFrom := make(map[string][]string)
+	var fromOrder []string
+	for _, m := range diff.UsersToMove {
+		if m.FromTeam == "" {
+			continue
+		}
+		if _, ok := movedFrom[m.FromTeam]; !ok {
+			fromOrder = append(fromOrder, m.FromTeam)
+		}
+		movedFrom[m.FromTeam] = append(movedFrom[m.FromTeam], m.UserID)
+	}
+
+	for _, teamName := range fromOrder {
+		res, err := userService.ReassignTeamReviewsTx(tx, org, teamName, movedFrom[teamName], actor)
+		if err != nil {
+			return err
+		}
+		result.AffectedPRCount += res.AffectedPRCount
+		result.ReassignedReviewersCount += res.ReassignedReviewersCount
+	}
+
+	if len(diff.UsersToDeactivate) == 0 {
+		return nil
+	}
+
+	var deactivating []domain.User
+	if err := tx.Where("organization = ? AND id IN ?", org, diff.UsersToDeactivate).Find(&deactivating).Error; err != nil {
+		return err
+	}
+
+	byTeam := make(map[string][]string)
+	var order []string
+	for _, u := range deactivating {
+		if _, ok := byTeam[u.TeamName]; !ok {
+			order = append(order, u.TeamName)
+		}
+		byTeam[u.TeamName] = append(byTeam[u.TeamName], u.ID)
+	}
+
+	for _, teamName := range order {
+		res, err := userService.DeactivateAndReassignTx(tx, org, teamName, byTeam[teamName], actor)
+		if err != nil {
+			return err
+		}
+		result.AffectedPRCount += res.AffectedPRCount
+		result.ReassignedReviewersCount += res.ReassignedReviewersCount
+	}
+
+	return nil
+}
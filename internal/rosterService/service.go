@@ -0,0 +1,64 @@
+package rosterService
+
+import (
+	"PullRequestService/domain"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+)
+
+var ErrInvalidRoster = domain.ErrBadRequest.Msg("invalid roster")
+
+type RosterService interface {
+	Import(ctx context.Context, format string, data io.Reader, dryRun bool) (*domain.ImportResult, error)
+}
+
+type rosterService struct {
+	repo RosterRepository
+}
+
+func NewRosterService(repo RosterRepository) RosterService {
+	return &rosterService{repo: repo}
+}
+
+func (s *rosterService) Import(ctx context.Context, format string, data io.Reader, dryRun bool) (*domain.ImportResult, error) {
+	org := domain.OrganizationFrom(ctx)
+	roster, err := ParseRoster(format, data)
+	if err != nil {
+		return nil, ErrInvalidRoster.Detailf("%v", err)
+	}
+
+	if err := validateRoster(roster); err != nil {
+		return nil, ErrInvalidRoster.Detailf("%v", err)
+	}
+
+	return s.repo.ImportRoster(org, roster, dryRun, domain.ActorFrom(ctx))
+}
+
+func validateRoster(roster domain.Roster) error {
+	if len(roster.Teams) == 0 {
+		return errors.New("roster has no teams")
+	}
+
+	teams := make(map[string]struct{}, len(roster.Teams))
+	users := make(map[string]string)
+	for _, t := range roster.Teams {
+		if _, ok := teams[t.Name]; ok {
+			return fmt.Errorf("team %s is listed twice", t.Name)
+		}
+		teams[t.Name] = struct{}{}
+
+		for _, m := range t.Members {
+			if prev, ok := users[m.ID]; ok {
+				if prev == t.Name {
+					return fmt.Errorf("user %s is listed twice in %s", m.ID, t.Name)
+				}
+				return fmt.Errorf("user %s is listed in both %s and %s", m.ID, prev, t.Name)
+			}
+			users[m.ID] = t.Name
+		}
+	}
+
+	return nil
+}
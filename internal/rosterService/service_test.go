@@ -0,0 +1,55 @@
+package rosterService
+
+import (
+	"PullRequestService/domain"
+	"strings"
+	"testing"
+)
+
+func TestValidateRoster(t *testing.T) {
+	member := func(id string) domain.User { return domain.User{ID: id, IsActive: true} }
+
+	tests := []struct {
+		name  string
+		teams []domain.Team
+		want  string
+	}{
+		{"valid", []domain.Team{{Name: "backend", Members: []domain.User{member("u1")}}, {Name: "frontend"}}, ""},
+		{"no teams", nil, "roster has no teams"},
+		{"team twice", []domain.Team{{Name: "backend"}, {Name: "backend"}}, "team backend is listed twice"},
+		{"user in two teams", []domain.Team{
+			{Name: "backend", Members: []domain.User{member("u1")}},
+			{Name: "frontend", Members: []domain.User{member("u1")}},
+		}, "user u1 is listed in both backend and frontend"},
+		{"user twice in a team", []domain.Team{
+			{Name: "backend", Members: []domain.User{member("u1"), member("u1")}},
+		}, "user u1 is listed twice in backend"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateRoster(domain.Roster{Teams: tt.teams})
+			if tt.want == "" {
+				if err != nil {
+					t.Fatalf("validateRoster: %v", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tt.want) {
+				t.Fatalf("validateRoster error = %v, want %q", err, tt.want)
+			}
+		})
+	}
+}
+
+func TestValidateRosterDuplicatesFromCSV(t *testing.T) {
+	roster, err := ParseRoster("csv", strings.NewReader(`team_name,user_id,username,is_active
+backend,u1,Alice,true
+frontend,u1,Alice,true
+`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := validateRoster(roster); err == nil || !strings.Contains(err.Error(), "user u1 is listed in both") {
+		t.Fatalf("validateRoster error = %v, want the duplicate user", err)
+	}
+}
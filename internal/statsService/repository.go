@@ -2,15 +2,18 @@ package statsService
 
 import (
 	"PullRequestService/domain"
+	"PullRequestService/internal/pullRequestService"
 	"PullRequestService/internal/web/stats"
 	"gorm.io/gorm"
 )
 
+// StatsRepository works within one organization, passed as org to every method.
 type StatsRepository interface {
-	CountAssignmentsByUser() ([]stats.AssignmentsByUserItem, error)
-	CountAssignmentsByPR() ([]stats.AssignmentsByPRItem, error)
-	CountOpenPRs() (int, error)
-	CountMergedPRs() (int, error)
+	CountAssignmentsByUser(org string, filter domain.PullRequestFilter) ([]stats.AssignmentsByUserItem, error)
+	CountAssignmentsByPR(org string, filter domain.PullRequestFilter) ([]stats.AssignmentsByPRItem, error)
+	CountOpenPRs(org string, filter domain.PullRequestFilter) (int, error)
+	CountMergedPRs(org string, filter domain.PullRequestFilter) (int, error)
+	CountDeclinesByUser(org string, filter domain.PullRequestFilter) ([]stats.DeclinesByUserItem, error)
 }
 
 type statsRepository struct {
@@ -21,11 +24,13 @@ func NewStatsRepository(db *gorm.DB) StatsRepository {
 	return &statsRepository{db: db}
 }
 
-func (r *statsRepository) CountAssignmentsByUser() ([]stats.AssignmentsByUserItem, error) {
+func (r *statsRepository) CountAssignmentsByUser(org string, filter domain.PullRequestFilter) ([]stats.AssignmentsByUserItem, error) {
 	var rows []stats.AssignmentsByUserItem
 
 	err := r.db.
 		Table("pull_request_reviewers").
+		Where("organization = ?", org).
+		Scopes(pullRequestService.FilterScope(org, filter)).
 		Select("reviewer_id as user_id, COUNT(*) as count").
 		Group("reviewer_id").
 		Scan(&rows).Error
@@ -33,11 +38,13 @@ func (r *statsRepository) CountAssignmentsByUser() ([]stats.AssignmentsByUserIte
 	return rows, err
 }
 
-func (r *statsRepository) CountAssignmentsByPR() ([]stats.AssignmentsByPRItem, error) {
+func (r *statsRepository) CountAssignmentsByPR(org string, filter domain.PullRequestFilter) ([]stats.AssignmentsByPRItem, error) {
 	var rows []stats.AssignmentsByPRItem
 
 	err := r.db.
 		Table("pull_request_reviewers").
+		Where("organization = ?", org).
+		Scopes(pullRequestService.FilterScope(org, filter)).
 		Select("pull_request_id, COUNT(*) as count").
 		Group("pull_request_id").
 		Scan(&rows).Error
@@ -45,17 +52,36 @@ func (r *statsRepository) CountAssignmentsByPR() ([]stats.AssignmentsByPRItem, e
 	return rows, err
 }
 
-func (r *statsRepository) CountOpenPRs() (int, error) {
+func (r *statsRepository) CountDeclinesByUser(org string, filter domain.PullRequestFilter) ([]stats.DeclinesByUserItem, error) {
+	var rows []stats.DeclinesByUserItem
+
+	err := r.db.
+		Table("review_declines").
+		Where("organization = ?", org).
+		Scopes(pullRequestService.FilterScope(org, filter)).
+		Select("user_id, COUNT(*) as count").
+		Group("user_id").
+		Order("count DESC, user_id").
+		Scan(&rows).Error
+
+	return rows, err
+}
+
+func (r *statsRepository) CountOpenPRs(org string, filter domain.PullRequestFilter) (int, error) {
 	var count int64
 	err := r.db.Model(&domain.PullRequest{}).
+		Where("organization = ?", org).
+		Scopes(pullRequestService.FilterScope(org, filter)).
 		Where("status = ?", "OPEN").
 		Count(&count).Error
 	return int(count), err
 }
 
-func (r *statsRepository) CountMergedPRs() (int, error) {
+func (r *statsRepository) CountMergedPRs(org string, filter domain.PullRequestFilter) (int, error) {
 	var count int64
 	err := r.db.Model(&domain.PullRequest{}).
+		Where("organization = ?", org).
+		Scopes(pullRequestService.FilterScope(org, filter)).
 		Where("status = ?", "MERGED").
 		Count(&count).Error
 	return int(count), err
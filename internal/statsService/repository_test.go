@@ -0,0 +1,70 @@
+package statsService
+
+import (
+	"PullRequestService/domain"
+	"PullRequestService/internal/testdb"
+	"PullRequestService/internal/web/stats"
+	"reflect"
+	"sort"
+	"testing"
+)
+
+func TestRepositoryStaysInOrganization(t *testing.T) {
+	db := testdb.Open(t)
+	testdb.Seed(t, db)
+	repo := NewStatsRepository(db)
+	all := domain.PullRequestFilter{}
+
+	tests := []struct {
+		org      string
+		open     int
+		merged   int
+		byPR     []stats.AssignmentsByPRItem
+		declines []stats.DeclinesByUserItem
+	}{
+		{
+			org:  testdb.Acme,
+			open: 2,
+			byPR: []stats.AssignmentsByPRItem{{PullRequestId: "pr-1", Count: 1}, {PullRequestId: "pr-2", Count: 1}},
+		},
+		{
+			org:      testdb.Globex,
+			merged:   1,
+			byPR:     []stats.AssignmentsByPRItem{{PullRequestId: "pr-1", Count: 2}},
+			declines: []stats.DeclinesByUserItem{{UserId: "u4", Count: 1}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.org, func(t *testing.T) {
+			open, err := repo.CountOpenPRs(tt.org, all)
+			if err != nil {
+				t.Fatal(err)
+			}
+			merged, err := repo.CountMergedPRs(tt.org, all)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if open != tt.open || merged != tt.merged {
+				t.Errorf("open, merged = %d, %d, want %d, %d", open, merged, tt.open, tt.merged)
+			}
+
+			byPR, err := repo.CountAssignmentsByPR(tt.org, all)
+			if err != nil {
+				t.Fatal(err)
+			}
+			sort.Slice(byPR, func(a, b int) bool { return byPR[a].PullRequestId < byPR[b].PullRequestId })
+			if !reflect.DeepEqual(byPR, tt.byPR) {
+				t.Errorf("CountAssignmentsByPR = %+v, want %+v", byPR, tt.byPR)
+			}
+
+			declines, err := repo.CountDeclinesByUser(tt.org, all)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(declines) != len(tt.declines) || (len(declines) > 0 && !reflect.DeepEqual(declines, tt.declines)) {
+				t.Errorf("CountDeclinesByUser = %+v, want %+v", declines, tt.declines)
+			}
+		})
+	}
+}
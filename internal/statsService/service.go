@@ -1,11 +1,13 @@
 package statsService
 
 import (
+	"PullRequestService/domain"
 	"PullRequestService/internal/web/stats"
+	"context"
 )
 
 type StatsService interface {
-	GetStats() (stats.StatsResponse, error)
+	GetStats(ctx context.Context, filter domain.PullRequestFilter) (stats.StatsResponse, error)
 }
 
 type statsService struct {
@@ -16,23 +18,31 @@ func NewStatsService(repo StatsRepository) StatsService {
 	return &statsService{repo: repo}
 }
 
-func (s *statsService) GetStats() (stats.StatsResponse, error) {
-	byUser, err := s.repo.CountAssignmentsByUser()
+// GetStats counts PRs and assignments. The filter limits all numbers to PRs of one
+// repository or with one label.
+func (s *statsService) GetStats(ctx context.Context, filter domain.PullRequestFilter) (stats.StatsResponse, error) {
+	org := domain.OrganizationFrom(ctx)
+	byUser, err := s.repo.CountAssignmentsByUser(org, filter)
 	if err != nil {
 		return stats.StatsResponse{}, err
 	}
 
-	byPR, err := s.repo.CountAssignmentsByPR()
+	byPR, err := s.repo.CountAssignmentsByPR(org, filter)
 	if err != nil {
 		return stats.StatsResponse{}, err
 	}
 
-	openCount, err := s.repo.CountOpenPRs()
+	openCount, err := s.repo.CountOpenPRs(org, filter)
 	if err != nil {
 		return stats.StatsResponse{}, err
 	}
 
-	mergedCount, err := s.repo.CountMergedPRs()
+	mergedCount, err := s.repo.CountMergedPRs(org, filter)
+	if err != nil {
+		return stats.StatsResponse{}, err
+	}
+
+	declines, err := s.repo.CountDeclinesByUser(org, filter)
 	if err != nil {
 		return stats.StatsResponse{}, err
 	}
@@ -42,5 +52,6 @@ func (s *statsService) GetStats() (stats.StatsResponse, error) {
 		AssignmentsByPr:   byPR,
 		OpenPrsCount:      openCount,
 		MergedPrsCount:    mergedCount,
+		DeclinesByUser:    declines,
 	}, nil
 }
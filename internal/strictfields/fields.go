@@ -0,0 +1,59 @@
+// Package strictfields reads the parameters and JSON body of the request objects
+// built by the oapi-codegen strict server.
+package strictfields
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"reflect"
+)
+
+// Of flattens the Params and JSON Body of a strict request object into one map keyed
+// by their JSON names. Numbers are kept as json.Number; text bodies such as CSV or
+// iCalendar files are left out.
+func Of(request interface{}) map[string]interface{} {
+	fields := map[string]interface{}{}
+
+	v := reflect.Indirect(reflect.ValueOf(request))
+	if v.Kind() != reflect.Struct {
+		return fields
+	}
+
+	for _, name := range []string{"Params", "Body"} {
+		part := v.FieldByName(name)
+		if !part.IsValid() || (part.Kind() == reflect.Pointer && part.IsNil()) {
+			continue
+		}
+		if _, ok := part.Interface().(io.Reader); ok {
+			continue
+		}
+
+		raw, err := json.Marshal(part.Interface())
+		if err != nil {
+			continue
+		}
+		var values map[string]interface{}
+		dec := json.NewDecoder(bytes.NewReader(raw))
+		dec.UseNumber()
+		if err := dec.Decode(&values); err != nil {
+			continue
+		}
+		for key, value := range values {
+			fields[key] = value
+		}
+	}
+	return fields
+}
+
+// String returns a string or number field as text. ok is false for other values,
+// nested ones included.
+func String(value interface{}) (string, bool) {
+	switch value := value.(type) {
+	case string:
+		return value, true
+	case json.Number:
+		return value.String(), true
+	}
+	return "", false
+}
@@ -2,12 +2,23 @@ package teamService
 
 import (
 	"PullRequestService/domain"
+	"PullRequestService/internal/candidates"
 	"gorm.io/gorm"
 )
 
+// TeamRepository works within one organization, passed as org to every method. The
+// setters change a team only while it is still at the given version and move it to
+// the next one; otherwise they return VERSION_MISMATCH.
 type TeamRepository interface {
-	PostTeam(team domain.Team) error
-	GetTeam(teamName string) (domain.Team, error)
+	PostTeam(org string, team domain.Team) error
+	GetTeam(org, teamName string) (domain.Team, error)
+	GetTeamVersion(org, teamName string) (int64, error)
+	SetDefaultMaxOpenReviews(org, teamName string, version int64, maxOpenReviews *int) error
+	SetReviewPolicy(org, teamName string, version int64, policy string) error
+	SetSizeRules(org, teamName string, version int64, rules []domain.SizeRule) error
+	SetFallbacks(org, teamName string, version int64, fallbackTeams, reviewerPools []string) error
+	SetPool(org string, pool domain.ReviewerPool) error
+	GetPool(org, name string) (domain.ReviewerPool, error)
 }
 
 type teamRepository struct {
@@ -18,42 +29,259 @@ func NewTeamRepository(db *gorm.DB) TeamRepository {
 	return &teamRepository{db: db}
 }
 
-func (t *teamRepository) GetTeam(teamName string) (domain.Team, error) {
+func (t *teamRepository) GetTeam(org, teamName string) (domain.Team, error) {
 	var team domain.Team
-	err := t.db.First(&team, "name = ?", teamName).Error
+	err := t.db.First(&team, "organization = ? AND name = ?", org, teamName).Error
 	if err != nil {
 		return domain.Team{}, err
 	}
 	var members []domain.User
-	err = t.db.Where("team_name = ?", teamName).Find(&members).Error
+	err = t.db.Where("organization = ? AND team_name = ?", org, teamName).Find(&members).Error
 	if err != nil {
 		return domain.Team{}, err
 	}
 
+	ids := make([]string, 0, len(members))
+	for _, m := range members {
+		ids = append(ids, m.ID)
+	}
+	skills, err := candidates.Skills(t.db, org, ids)
+	if err != nil {
+		return domain.Team{}, err
+	}
+	for i := range members {
+		members[i].Skills = skills[members[i].ID]
+	}
+
 	team.Members = members
 
+	err = t.db.Table("team_fallbacks").
+		Where("organization = ? AND team_name = ?", org, teamName).
+		Order("position").
+		Pluck("fallback_team_name", &team.FallbackTeams).Error
+	if err != nil {
+		return domain.Team{}, err
+	}
+
+	err = t.db.Table("team_reviewer_pools").
+		Where("organization = ? AND team_name = ?", org, teamName).
+		Order("pool_name").
+		Pluck("pool_name", &team.ReviewerPools).Error
+	if err != nil {
+		return domain.Team{}, err
+	}
+
+	err = t.db.Table("team_size_rules").
+		Where("organization = ? AND team_name = ?", org, teamName).
+		Order("min_lines").
+		Find(&team.SizeRules).Error
+	if err != nil {
+		return domain.Team{}, err
+	}
+
 	return team, err
 }
 
-func (t *teamRepository) PostTeam(team domain.Team) error {
-	err := t.db.Create(&team).Error
-	if err != nil {
-		return err
+// PostTeam creates the team with its size rules and members in one transaction, so a
+// failed write leaves no half-created team behind.
+func (t *teamRepository) PostTeam(org string, team domain.Team) error {
+	team.Organization = org
+	return t.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(&team).Error; err != nil {
+			return err
+		}
+
+		for _, rule := range team.SizeRules {
+			err := tx.Exec(
+				"INSERT INTO team_size_rules (organization, team_name, min_lines, reviewers) VALUES (?, ?, ?, ?)",
+				org, team.Name, rule.MinLines, rule.Reviewers,
+			).Error
+			if err != nil {
+				return err
+			}
+		}
+
+		for _, m := range team.Members {
+			m.Organization = org
+			err := tx.
+				Where("organization = ? AND id = ?", org, m.ID).
+				Assign(domain.User{
+					Username:       m.Username,
+					TeamName:       team.Name,
+					IsActive:       m.IsActive,
+					MaxOpenReviews: m.MaxOpenReviews,
+					Seniority:      m.Seniority,
+				}).
+				FirstOrCreate(&m).Error
+			if err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+}
+
+func (t *teamRepository) GetTeamVersion(org, teamName string) (int64, error) {
+	var team domain.Team
+	err := t.db.Select("version").First(&team, "organization = ? AND name = ?", org, teamName).Error
+	return team.Version, err
+}
+
+// updateTeam sets the columns of a team still at version and moves it to the next
+// version.
+func updateTeam(tx *gorm.DB, org, teamName string, version int64, columns map[string]interface{}) error {
+	columns["version"] = gorm.Expr("version + 1")
+	res := tx.Model(&domain.Team{}).
+		Where("organization = ? AND name = ? AND version = ?", org, teamName, version).
+		Updates(columns)
+	if res.Error != nil {
+		return res.Error
+	}
+	if res.RowsAffected == 0 {
+		return domain.ErrVersionMismatch
 	}
+	return nil
+}
+
+func (t *teamRepository) SetDefaultMaxOpenReviews(org, teamName string, version int64, maxOpenReviews *int) error {
+	return updateTeam(t.db, org, teamName, version, map[string]interface{}{
+		"default_max_open_reviews": maxOpenReviews,
+	})
+}
+
+func (t *teamRepository) SetReviewPolicy(org, teamName string, version int64, policy string) error {
+	return updateTeam(t.db, org, teamName, version, map[string]interface{}{
+		"review_policy": policy,
+	})
+}
+
+// SetSizeRules replaces the team's size rules.
+func (t *teamRepository) SetSizeRules(org, teamName string, version int64, rules []domain.SizeRule) error {
+	return t.db.Transaction(func(tx *gorm.DB) error {
+		if err := updateTeam(tx, org, teamName, version, map[string]interface{}{}); err != nil {
+			return err
+		}
+
+		if err := tx.Exec("DELETE FROM team_size_rules WHERE organization = ? AND team_name = ?", org, teamName).Error; err != nil {
+			return err
+		}
+		for _, rule := range rules {
+			if err := tx.Exec(
+				"INSERT INTO team_size_rules (organization, team_name, min_lines, reviewers) VALUES (?, ?, ?, ?)",
+				org, teamName, rule.MinLines, rule.Reviewers,
+			).Error; err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+func (t *teamRepository) SetFallbacks(org, teamName string, version int64, fallbackTeams, reviewerPools []string) error {
+	return t.db.Transaction(func(tx *gorm.DB) error {
+		var found int64
+		names := append([]string{teamName}, fallbackTeams...)
+		if err := tx.Model(&domain.Team{}).Where("organization = ? AND name IN ?", org, names).Count(&found).Error; err != nil {
+			return err
+		}
+		if int(found) != countUnique(names) {
+			return domain.ErrNotFound.Msg("team not found")
+		}
+
+		if err := updateTeam(tx, org, teamName, version, map[string]interface{}{}); err != nil {
+			return err
+		}
+
+		if len(reviewerPools) > 0 {
+			if err := tx.Table("reviewer_pools").Where("organization = ? AND name IN ?", org, reviewerPools).Count(&found).Error; err != nil {
+				return err
+			}
+			if int(found) != countUnique(reviewerPools) {
+				return domain.ErrNotFound.Msg("reviewer pool not found")
+			}
+		}
+
+		if err := tx.Exec("DELETE FROM team_fallbacks WHERE organization = ? AND team_name = ?", org, teamName).Error; err != nil {
+			return err
+		}
+		for i, name := range fallbackTeams {
+			if err := tx.Exec(
+				"INSERT INTO team_fallbacks (organization, team_name, fallback_team_name, position) VALUES (?, ?, ?, ?) ON CONFLICT DO NOTHING",
+				org, teamName, name, i,
+			).Error; err != nil {
+				return err
+			}
+		}
 
-	for _, m := range team.Members {
-		err = t.db.
-			Where("id = ?", m.ID).
-			Assign(domain.User{
-				Username: m.Username,
-				TeamName: team.Name,
-				IsActive: m.IsActive,
-			}).
-			FirstOrCreate(&m).Error
-		if err != nil {
+		if err := tx.Exec("DELETE FROM team_reviewer_pools WHERE organization = ? AND team_name = ?", org, teamName).Error; err != nil {
 			return err
 		}
+		for _, name := range reviewerPools {
+			if err := tx.Exec(
+				"INSERT INTO team_reviewer_pools (organization, team_name, pool_name) VALUES (?, ?, ?) ON CONFLICT DO NOTHING",
+				org, teamName, name,
+			).Error; err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+}
+
+func (t *teamRepository) SetPool(org string, pool domain.ReviewerPool) error {
+	return t.db.Transaction(func(tx *gorm.DB) error {
+		if len(pool.UserIDs) > 0 {
+			var found int64
+			if err := tx.Model(&domain.User{}).Where("organization = ? AND id IN ?", org, pool.UserIDs).Count(&found).Error; err != nil {
+				return err
+			}
+			if int(found) != countUnique(pool.UserIDs) {
+				return domain.ErrNotFound.Msg("user not found")
+			}
+		}
+
+		if err := tx.Exec("INSERT INTO reviewer_pools (organization, name) VALUES (?, ?) ON CONFLICT DO NOTHING", org, pool.Name).Error; err != nil {
+			return err
+		}
+		if err := tx.Exec("DELETE FROM reviewer_pool_members WHERE organization = ? AND pool_name = ?", org, pool.Name).Error; err != nil {
+			return err
+		}
+		for _, id := range pool.UserIDs {
+			if err := tx.Exec(
+				"INSERT INTO reviewer_pool_members (organization, pool_name, user_id) VALUES (?, ?, ?) ON CONFLICT DO NOTHING",
+				org, pool.Name, id,
+			).Error; err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+}
+
+func (t *teamRepository) GetPool(org, name string) (domain.ReviewerPool, error) {
+	var count int64
+	if err := t.db.Table("reviewer_pools").Where("organization = ? AND name = ?", org, name).Count(&count).Error; err != nil {
+		return domain.ReviewerPool{}, err
+	}
+	if count == 0 {
+		return domain.ReviewerPool{}, gorm.ErrRecordNotFound
 	}
 
-	return nil
+	pool := domain.ReviewerPool{Name: name, UserIDs: []string{}}
+	err := t.db.Table("reviewer_pool_members").
+		Where("organization = ? AND pool_name = ?", org, name).
+		Order("user_id").
+		Pluck("user_id", &pool.UserIDs).Error
+	return pool, err
+}
+
+func countUnique(values []string) int {
+	seen := make(map[string]struct{}, len(values))
+	for _, v := range values {
+		seen[v] = struct{}{}
+	}
+	return len(seen)
 }
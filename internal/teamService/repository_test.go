@@ -0,0 +1,52 @@
+package teamService
+
+import (
+	"PullRequestService/domain"
+	"PullRequestService/internal/testdb"
+	"errors"
+	"gorm.io/gorm"
+	"testing"
+)
+
+func TestGetTeamStaysInOrganization(t *testing.T) {
+	db := testdb.Open(t)
+	testdb.Seed(t, db)
+	repo := NewTeamRepository(db)
+
+	for _, org := range []string{testdb.Acme, testdb.Globex} {
+		team, err := repo.GetTeam(org, "backend")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(team.Members) != 3 {
+			t.Fatalf("GetTeam(%s) has %d members, want 3", org, len(team.Members))
+		}
+		for _, m := range team.Members {
+			if want := org + "-" + m.ID; m.Username != want {
+				t.Errorf("GetTeam(%s) member %s is %s, want %s", org, m.ID, m.Username, want)
+			}
+		}
+	}
+}
+
+func TestPostTeamRollsBackOnFailedWrite(t *testing.T) {
+	db := testdb.Open(t)
+	repo := NewTeamRepository(db)
+
+	team := domain.Team{
+		Name:      "platform",
+		SizeRules: []domain.SizeRule{{MinLines: 0, Reviewers: 2}, {MinLines: 0, Reviewers: 3}},
+		Members:   []domain.User{{ID: "u1", Username: "u1", IsActive: true}},
+	}
+	if err := repo.PostTeam(testdb.Acme, team); err == nil {
+		t.Fatal("PostTeam with a repeated threshold succeeded")
+	}
+	if _, err := repo.GetTeam(testdb.Acme, "platform"); !errors.Is(err, gorm.ErrRecordNotFound) {
+		t.Fatalf("GetTeam after the failed PostTeam = %v, want not found", err)
+	}
+
+	team.SizeRules = team.SizeRules[:1]
+	if err := repo.PostTeam(testdb.Acme, team); err != nil {
+		t.Fatalf("retried PostTeam: %v", err)
+	}
+}
@@ -1,12 +1,30 @@
 package teamService
 
-import "PullRequestService/domain"
+import (
+	"PullRequestService/domain"
+	"context"
+	"errors"
+	"fmt"
+	"gorm.io/gorm"
+)
 
 type TeamService interface {
-	PostTeam(team domain.Team) error
-	GetTeam(teamName string) (domain.Team, error)
+	PostTeam(ctx context.Context, team domain.Team) error
+	GetTeam(ctx context.Context, teamName string) (domain.Team, error)
+	SetDefaultMaxOpenReviews(ctx context.Context, teamName string, maxOpenReviews *int) (domain.Team, error)
+	SetReviewPolicy(ctx context.Context, teamName, policy string) (domain.Team, error)
+	SetSizeRules(ctx context.Context, teamName string, rules []domain.SizeRule) (domain.Team, error)
+	SetFallbacks(ctx context.Context, teamName string, fallbackTeams, reviewerPools []string) (domain.Team, error)
+	SetPool(ctx context.Context, pool domain.ReviewerPool) (domain.ReviewerPool, error)
+	GetPool(ctx context.Context, name string) (domain.ReviewerPool, error)
 }
 
+var (
+	errTeamNotFound     = domain.ErrNotFound.Msg("team not found")
+	errInvalidPolicy    = domain.ErrBadRequest.Msg("review_policy must be standard or pairing")
+	errInvalidSizeRules = domain.ErrBadRequest.Msg("size rules need distinct min_lines >= 0 and reviewers between 1 and 10")
+)
+
 type teamService struct {
 	repo TeamRepository
 }
@@ -17,17 +35,186 @@ func NewTeamService(repo TeamRepository) TeamService {
 	}
 }
 
-func (ts *teamService) PostTeam(team domain.Team) error {
-	if err := ts.repo.PostTeam(team); err != nil {
+func (ts *teamService) PostTeam(ctx context.Context, team domain.Team) error {
+	org := domain.OrganizationFrom(ctx)
+	if team.ReviewPolicy != "" && team.ReviewPolicy != domain.ReviewPolicyStandard && team.ReviewPolicy != domain.ReviewPolicyPairing {
+		return errInvalidPolicy
+	}
+	for _, m := range team.Members {
+		if m.Seniority != "" && m.Seniority != domain.SeniorityJunior && m.Seniority != domain.SenioritySenior {
+			return domain.ErrBadRequest.Msg("seniority must be junior or senior")
+		}
+	}
+	if err := checkMembers(team.Members); err != nil {
+		return err
+	}
+	if err := checkSizeRules(team.SizeRules); err != nil {
+		return err
+	}
+
+	team.Version = domain.FirstVersion
+	if err := ts.repo.PostTeam(org, team); err != nil {
+		if errors.Is(err, gorm.ErrDuplicatedKey) {
+			return domain.ErrTeamExists.Msgf("%s already exists", team.Name).Wrap(err)
+		}
 		return err
 	}
 	return nil
 }
 
-func (ts *teamService) GetTeam(teamName string) (domain.Team, error) {
-	team, err := ts.repo.GetTeam(teamName)
+func (ts *teamService) GetTeam(ctx context.Context, teamName string) (domain.Team, error) {
+	org := domain.OrganizationFrom(ctx)
+	team, err := ts.repo.GetTeam(org, teamName)
 	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return domain.Team{}, errTeamNotFound
+		}
 		return domain.Team{}, err
 	}
 	return team, nil
 }
+
+func (ts *teamService) SetDefaultMaxOpenReviews(ctx context.Context, teamName string, maxOpenReviews *int) (domain.Team, error) {
+	org := domain.OrganizationFrom(ctx)
+	if maxOpenReviews != nil && *maxOpenReviews < 0 {
+		return domain.Team{}, domain.ErrBadRequest.Msg("default_max_open_reviews must not be negative")
+	}
+
+	version, err := ts.checkVersion(ctx, org, teamName)
+	if err != nil {
+		return domain.Team{}, err
+	}
+	if err := ts.repo.SetDefaultMaxOpenReviews(org, teamName, version, maxOpenReviews); err != nil {
+		return domain.Team{}, err
+	}
+
+	return ts.repo.GetTeam(org, teamName)
+}
+
+func (ts *teamService) SetReviewPolicy(ctx context.Context, teamName, policy string) (domain.Team, error) {
+	org := domain.OrganizationFrom(ctx)
+	if policy != domain.ReviewPolicyStandard && policy != domain.ReviewPolicyPairing {
+		return domain.Team{}, errInvalidPolicy
+	}
+
+	version, err := ts.checkVersion(ctx, org, teamName)
+	if err != nil {
+		return domain.Team{}, err
+	}
+	if err := ts.repo.SetReviewPolicy(org, teamName, version, policy); err != nil {
+		return domain.Team{}, err
+	}
+
+	return ts.repo.GetTeam(org, teamName)
+}
+
+// checkVersion returns the current version of the team, or VERSION_MISMATCH when the
+// caller's If-Match names another one.
+func (ts *teamService) checkVersion(ctx context.Context, org, teamName string) (int64, error) {
+	version, err := ts.repo.GetTeamVersion(org, teamName)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return 0, errTeamNotFound
+		}
+		return 0, err
+	}
+	if err := domain.CheckVersion(ctx, version); err != nil {
+		return 0, err
+	}
+	return version, nil
+}
+
+// maxReviewersPerRule caps the reviewer count a size rule may ask for.
+const maxReviewersPerRule = 10
+
+// SetSizeRules replaces the team's size rules. An empty list brings back the default
+// of two reviewers for every PR.
+func (ts *teamService) SetSizeRules(ctx context.Context, teamName string, rules []domain.SizeRule) (domain.Team, error) {
+	org := domain.OrganizationFrom(ctx)
+	if err := checkSizeRules(rules); err != nil {
+		return domain.Team{}, err
+	}
+
+	version, err := ts.checkVersion(ctx, org, teamName)
+	if err != nil {
+		return domain.Team{}, err
+	}
+	if err := ts.repo.SetSizeRules(org, teamName, version, rules); err != nil {
+		return domain.Team{}, err
+	}
+
+	return ts.repo.GetTeam(org, teamName)
+}
+
+func checkSizeRules(rules []domain.SizeRule) error {
+	seen := make(map[int]struct{}, len(rules))
+	for _, rule := range rules {
+		if rule.MinLines < 0 || rule.Reviewers < 1 || rule.Reviewers > maxReviewersPerRule {
+			return errInvalidSizeRules
+		}
+		if _, ok := seen[rule.MinLines]; ok {
+			return errInvalidSizeRules
+		}
+		seen[rule.MinLines] = struct{}{}
+	}
+	return nil
+}
+
+// checkMembers rejects a team that lists a user twice; the second insert would fail
+// on the users primary key.
+func checkMembers(members []domain.User) error {
+	seen := make(map[string]struct{}, len(members))
+	var fields []domain.FieldError
+	for i, m := range members {
+		if _, ok := seen[m.ID]; ok {
+			fields = append(fields, domain.FieldError{
+				Field:   fmt.Sprintf("members.%d.user_id", i),
+				Message: fmt.Sprintf("duplicate user_id %s", m.ID),
+			})
+		}
+		seen[m.ID] = struct{}{}
+	}
+	if len(fields) > 0 {
+		return domain.ErrValidation.WithFields(fields...)
+	}
+	return nil
+}
+
+func (ts *teamService) SetFallbacks(ctx context.Context, teamName string, fallbackTeams, reviewerPools []string) (domain.Team, error) {
+	org := domain.OrganizationFrom(ctx)
+	for _, name := range fallbackTeams {
+		if name == teamName {
+			return domain.Team{}, domain.ErrBadRequest.Msg("team cannot be its own fallback")
+		}
+	}
+
+	version, err := ts.checkVersion(ctx, org, teamName)
+	if err != nil {
+		return domain.Team{}, err
+	}
+	if err := ts.repo.SetFallbacks(org, teamName, version, fallbackTeams, reviewerPools); err != nil {
+		return domain.Team{}, err
+	}
+
+	return ts.repo.GetTeam(org, teamName)
+}
+
+func (ts *teamService) SetPool(ctx context.Context, pool domain.ReviewerPool) (domain.ReviewerPool, error) {
+	org := domain.OrganizationFrom(ctx)
+	if err := ts.repo.SetPool(org, pool); err != nil {
+		return domain.ReviewerPool{}, err
+	}
+	return ts.repo.GetPool(org, pool.Name)
+}
+
+func (ts *teamService) GetPool(ctx context.Context, name string) (domain.ReviewerPool, error) {
+	org := domain.OrganizationFrom(ctx)
+	pool, err := ts.repo.GetPool(org, name)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return domain.ReviewerPool{}, domain.ErrNotFound.Msg("reviewer pool not found")
+		}
+		return domain.ReviewerPool{}, err
+	}
+	return pool, nil
+}
@@ -2,17 +2,34 @@ package userService
 
 import (
 	"PullRequestService/domain"
+	"PullRequestService/internal/candidates"
+	"PullRequestService/internal/pullRequestService"
 	"errors"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+	"math/rand"
+	"time"
 )
 
+// UserRepository works within one organization, passed as org to every method.
 type UserRepository interface {
-	GetUserByID(id string) (domain.User, error)
-	SetIsActive(user domain.User) error
-	GetPRsForReviewer(userID string) ([]domain.PullRequest, error)
-	DeactivateAndReassign(teamName string, userIDs []string) (*domain.DeactivateResult, error)
+	GetUserByID(org, id string) (domain.User, error)
+	SetIsActive(org string, user domain.User) error
+	SetMaxOpenReviews(org, userID string, maxOpenReviews *int) error
+	SetSeniority(org, userID, seniority string) error
+	GetSkills(org, userID string) ([]string, error)
+	AddSkills(org, userID string, skills []string) error
+	RemoveSkills(org, userID string, skills []string) error
+	AddExclusion(org string, exclusion domain.ReviewerExclusion) error
+	RemoveExclusion(org, authorID, reviewerID string) (bool, error)
+	GetExclusions(org, userID string) ([]domain.ReviewerExclusion, error)
+	ActivateAndRestore(org string, user domain.User) ([]string, error)
+	GetPRsForReviewer(org, userID string) ([]domain.PullRequest, error)
+	DeactivateAndReassign(org, teamName string, userIDs []string, dryRun bool, actor string) (*domain.DeactivateResult, error)
 }
 
+var errDryRun = errors.New("dry run")
+
 type userRepository struct {
 	db *gorm.DB
 }
@@ -21,161 +38,647 @@ func NewUserRepository(db *gorm.DB) UserRepository {
 	return &userRepository{db: db}
 }
 
-func (u *userRepository) GetUserByID(id string) (domain.User, error) {
+func (u *userRepository) GetUserByID(org, id string) (domain.User, error) {
 	var user domain.User
-	err := u.db.First(&user, "id = ?", id).Error
+	err := u.db.First(&user, "organization = ? AND id = ?", org, id).Error
 	return user, err
 }
 
-func (u *userRepository) SetIsActive(user domain.User) error {
+func (u *userRepository) SetIsActive(org string, user domain.User) error {
+	user.Organization = org
 	return u.db.Save(&user).Error
 }
 
-func (u *userRepository) GetPRsForReviewer(userID string) ([]domain.PullRequest, error) {
+func (u *userRepository) SetMaxOpenReviews(org, userID string, maxOpenReviews *int) error {
+	return u.db.Model(&domain.User{}).
+		Where("organization = ? AND id = ?", org, userID).
+		Update("max_open_reviews", maxOpenReviews).Error
+}
+
+func (u *userRepository) SetSeniority(org, userID, seniority string) error {
+	return u.db.Model(&domain.User{}).
+		Where("organization = ? AND id = ?", org, userID).
+		Update("seniority", seniority).Error
+}
+
+func (u *userRepository) GetSkills(org, userID string) ([]string, error) {
+	skills, err := candidates.Skills(u.db, org, []string{userID})
+	if err != nil {
+		return nil, err
+	}
+	return skills[userID], nil
+}
+
+func (u *userRepository) AddSkills(org, userID string, skills []string) error {
+	for _, skill := range skills {
+		err := u.db.Exec(`INSERT INTO user_skills (organization, user_id, skill) VALUES (?, ?, ?)
+			ON CONFLICT DO NOTHING`, org, userID, skill).Error
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (u *userRepository) RemoveSkills(org, userID string, skills []string) error {
+	return u.db.Exec("DELETE FROM user_skills WHERE organization = ? AND user_id = ? AND skill IN ?",
+		org, userID, skills).Error
+}
+
+func (u *userRepository) AddExclusion(org string, exclusion domain.ReviewerExclusion) error {
+	exclusion.Organization = org
+	return u.db.Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "organization"}, {Name: "author_id"}, {Name: "reviewer_id"}},
+		DoUpdates: clause.AssignmentColumns([]string{"symmetric", "reason"}),
+	}).Create(&exclusion).Error
+}
+
+// RemoveExclusion deletes the exclusion of the pair, including a symmetric one that
+// was added with the users the other way round.
+func (u *userRepository) RemoveExclusion(org, authorID, reviewerID string) (bool, error) {
+	pair := u.db.Where("author_id = ? AND reviewer_id = ?", authorID, reviewerID).
+		Or("symmetric AND author_id = ? AND reviewer_id = ?", reviewerID, authorID)
+	res := u.db.Where("organization = ?", org).
+		Where(pair).
+		Delete(&domain.ReviewerExclusion{})
+	return res.RowsAffected > 0, res.Error
+}
+
+func (u *userRepository) GetExclusions(org, userID string) ([]domain.ReviewerExclusion, error) {
+	var exclusions []domain.ReviewerExclusion
+	err := u.db.Where("organization = ? AND (author_id = ? OR reviewer_id = ?)", org, userID, userID).
+		Order("created_at").
+		Find(&exclusions).Error
+	return exclusions, err
+}
+
+func (u *userRepository) GetPRsForReviewer(org, userID string) ([]domain.PullRequest, error) {
 	var prs []domain.PullRequest
-	err := u.db.Joins("JOIN pull_request_reviewers prr ON prr.pull_request_id = pull_requests.pull_request_id").
-		Where("prr.reviewer_id = ?", userID).
+	err := u.db.Joins(`JOIN pull_request_reviewers prr
+			ON prr.organization = pull_requests.organization AND prr.pull_request_id = pull_requests.pull_request_id`).
+		Where("pull_requests.organization = ? AND prr.reviewer_id = ?", org, userID).
+		Order(`CASE pull_requests.priority WHEN 'urgent' THEN 0 WHEN 'normal' THEN 1 ELSE 2 END`).
+		Order("pull_requests.due_at ASC NULLS LAST").
+		Order("pull_requests.created_at").
 		Find(&prs).Error
-	return prs, err
+	if err != nil {
+		return nil, err
+	}
+
+	ids := make([]string, 0, len(prs))
+	for _, pr := range prs {
+		ids = append(ids, pr.ID)
+	}
+	labels, err := pullRequestService.Labels(u.db, org, ids)
+	if err != nil {
+		return nil, err
+	}
+	for i := range prs {
+		prs[i].Labels = labels[prs[i].ID]
+	}
+	return prs, nil
 }
 
-func (u *userRepository) DeactivateAndReassign(teamName string, userIDs []string) (*domain.DeactivateResult, error) {
+// DeactivateAndReassign runs the whole algorithm in a transaction. With dryRun the
+// transaction is rolled back and only the plan is returned.
+func (u *userRepository) DeactivateAndReassign(org, teamName string, userIDs []string, dryRun bool, actor string) (*domain.DeactivateResult, error) {
+	var result *domain.DeactivateResult
+	err := u.db.Transaction(func(tx *gorm.DB) error {
+		var err error
+		result, err = DeactivateAndReassignTx(tx, org, teamName, userIDs, actor)
+		if err != nil {
+			return err
+		}
+		if dryRun {
+			return errDryRun
+		}
+		return nil
+	})
+	if err != nil && !errors.Is(err, errDryRun) {
+		return nil, err
+	}
+
+	result.DryRun = dryRun
+	return result, nil
+}
+
+// DeactivateAndReassignTx deactivates users of the organization's team and reassigns
+// their OPEN reviews within the given transaction, so other services can reuse it as
+// part of their own.
+func DeactivateAndReassignTx(tx *gorm.DB, org, teamName string, userIDs []string, actor string) (*domain.DeactivateResult, error) {
 	result := &domain.DeactivateResult{
 		TeamName: teamName,
 	}
 
-	return result, u.db.Transaction(func(tx *gorm.DB) error {
+	var teamCount int64
+	if err := tx.Table("teams").
+		Where("organization = ? AND name = ?", org, teamName).
+		Count(&teamCount).Error; err != nil {
+		return nil, err
+	}
+	if teamCount == 0 {
+		return nil, domain.ErrNotFound.Msg("team not found")
+	}
 
-		var teamCount int64
-		if err := tx.Table("teams").
-			Where("name = ?", teamName).
-			Count(&teamCount).Error; err != nil {
-			return err
-		}
-		if teamCount == 0 {
-			return errors.New("TEAM_NOT_FOUND")
-		}
+	if len(userIDs) == 0 {
+		return result, nil
+	}
+
+	var deactivated []domain.User
+	if err := tx.Model(&domain.User{}).
+		Where("organization = ? AND team_name = ? AND id IN ?", org, teamName, userIDs).
+		Updates(map[string]any{"is_active": false}).
+		Error; err != nil {
+		return nil, err
+	}
 
-		if len(userIDs) == 0 {
-			return nil
+	if err := tx.Where("organization = ? AND team_name = ? AND id IN ?", org, teamName, userIDs).
+		Find(&deactivated).Error; err != nil {
+		return nil, err
+	}
+	result.DeactivatedCount = len(deactivated)
+
+	if result.DeactivatedCount == 0 {
+		return result, nil
+	}
+
+	if err := reassignReviewsTx(tx, org, teamName, userIDs, false, actor, result); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// ReassignReviewsTx moves the OPEN reviews of the users to other active and available
+// members of the team without deactivating anyone.
+func ReassignReviewsTx(tx *gorm.DB, org, teamName string, userIDs []string, actor string) (*domain.DeactivateResult, error) {
+	result := &domain.DeactivateResult{
+		TeamName: teamName,
+	}
+
+	if len(userIDs) == 0 {
+		return result, nil
+	}
+
+	if err := reassignReviewsTx(tx, org, teamName, userIDs, false, actor, result); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// ReassignTeamReviewsTx is ReassignReviewsTx limited to the reviews on PRs whose
+// authors are in the team, e.g. for users who moved from it to another team.
+func ReassignTeamReviewsTx(tx *gorm.DB, org, teamName string, userIDs []string, actor string) (*domain.DeactivateResult, error) {
+	result := &domain.DeactivateResult{
+		TeamName: teamName,
+	}
+
+	if len(userIDs) == 0 {
+		return result, nil
+	}
+
+	if err := reassignReviewsTx(tx, org, teamName, userIDs, true, actor, result); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// reassignReviewsTx moves the OPEN reviews of the users, only those on PRs of the
+// team's authors when teamPRsOnly is set.
+func reassignReviewsTx(tx *gorm.DB, org, teamName string, userIDs []string, teamPRsOnly bool, actor string, result *domain.DeactivateResult) error {
+	var members []domain.User
+	if err := tx.Scopes(candidates.Available(time.Now())).
+		Where("organization = ? AND team_name = ? AND is_active = true AND id NOT IN ?", org, teamName, userIDs).
+		Find(&members).Error; err != nil {
+		return err
+	}
+
+	fallback, err := candidates.FallbackTiers(tx, org, teamName, time.Now())
+	if err != nil {
+		return err
+	}
+
+	leaving := make(map[string]struct{}, len(userIDs))
+	for _, id := range userIDs {
+		leaving[id] = struct{}{}
+	}
+
+	// tier is 0 for the team and 1+ for its fallback teams and pools in order. A user in
+	// several tiers counts in the first one.
+	activeCandidates := make([]string, 0, len(members))
+	seniority := make(map[string]string, len(members))
+	tier := make(map[string]int, len(members))
+	for i, users := range append([][]domain.User{members}, fallback...) {
+		for _, u := range users {
+			if _, ok := leaving[u.ID]; ok {
+				continue
+			}
+			if _, ok := tier[u.ID]; ok {
+				continue
+			}
+			activeCandidates = append(activeCandidates, u.ID)
+			seniority[u.ID] = u.Seniority
+			tier[u.ID] = i
 		}
+	}
+	rand.Shuffle(len(activeCandidates), func(a, b int) {
+		activeCandidates[a], activeCandidates[b] = activeCandidates[b], activeCandidates[a]
+	})
 
-		var deactivated []domain.User
-		if err := tx.Model(&domain.User{}).
-			Where("team_name = ? AND id IN ?", teamName, userIDs).
-			Updates(map[string]any{"is_active": false}).
-			Error; err != nil {
-			return err
+	workloads, err := candidates.Workloads(tx, org, activeCandidates)
+	if err != nil {
+		return err
+	}
+
+	type Row struct {
+		PRID              string `gorm:"column:pull_request_id"`
+		AuthorID          string `gorm:"column:author_id"`
+		Reviewer          string `gorm:"column:reviewer_id"`
+		ReviewerSeniority string `gorm:"column:reviewer_seniority"`
+		ReviewPolicy      string `gorm:"column:review_policy"`
+	}
+
+	var rows []Row
+	query := tx.Table("pull_requests as pr").
+		Select(`pr.pull_request_id, pr.author_id, prr.reviewer_id,
+			r.seniority AS reviewer_seniority,
+			COALESCE(t.review_policy, ?) AS review_policy`, domain.ReviewPolicyStandard).
+		Joins("JOIN pull_request_reviewers prr ON prr.organization = pr.organization AND prr.pull_request_id = pr.pull_request_id").
+		Joins("JOIN users r ON r.organization = pr.organization AND r.id = prr.reviewer_id").
+		Joins("JOIN users a ON a.organization = pr.organization AND a.id = pr.author_id").
+		Joins("LEFT JOIN teams t ON t.organization = pr.organization AND t.name = a.team_name").
+		Where("pr.organization = ? AND pr.status = 'OPEN' AND prr.reviewer_id IN ?", org, userIDs)
+	if teamPRsOnly {
+		query = query.Where("a.team_name = ?", teamName)
+	}
+	if err := query.Order("pr.pull_request_id, prr.reviewer_id").Scan(&rows).Error; err != nil {
+		return err
+	}
+
+	if len(rows) == 0 {
+		return nil
+	}
+
+	result.AffectedPRCount = len(rows)
+
+	var prIDs []string
+	prSet := map[string]struct{}{}
+	for _, r := range rows {
+		if _, ok := prSet[r.PRID]; !ok {
+			prSet[r.PRID] = struct{}{}
+			prIDs = append(prIDs, r.PRID)
 		}
+	}
 
-		if err := tx.Where("team_name = ? AND id IN ?", teamName, userIDs).
-			Find(&deactivated).Error; err != nil {
-			return err
+	type ReviewerRow struct {
+		PRID     string `gorm:"column:pull_request_id"`
+		Reviewer string `gorm:"column:reviewer_id"`
+	}
+
+	var revRows []ReviewerRow
+	if err := tx.Table("pull_request_reviewers").
+		Where("organization = ? AND pull_request_id IN ?", org, prIDs).
+		Scan(&revRows).Error; err != nil {
+		return err
+	}
+
+	reviewers := make(map[string]map[string]struct{})
+	for _, r := range revRows {
+		if reviewers[r.PRID] == nil {
+			reviewers[r.PRID] = make(map[string]struct{})
 		}
-		result.DeactivatedCount = len(deactivated)
+		reviewers[r.PRID][r.Reviewer] = struct{}{}
+	}
 
-		if result.DeactivatedCount == 0 {
-			return nil
+	authorIDs := make([]string, 0, len(rows))
+	for _, r := range rows {
+		authorIDs = append(authorIDs, r.AuthorID)
+	}
+	excludedByAuthor, err := candidates.Excluded(tx, org, authorIDs)
+	if err != nil {
+		return err
+	}
+	excludedByPR, err := candidates.ExcludedForPRs(tx, org, prIDs)
+	if err != nil {
+		return err
+	}
+	weights, err := candidates.ReviewWeights(tx, org, prIDs)
+	if err != nil {
+		return err
+	}
+
+	excluded := func(row Row, id string) bool {
+		if _, ok := excludedByAuthor[row.AuthorID][id]; ok {
+			return true
+		}
+		for _, e := range excludedByPR[row.PRID] {
+			if e == id {
+				return true
+			}
 		}
+		return false
+	}
 
-		var candidates []domain.User
-		if err := tx.Where("team_name = ? AND is_active = true", teamName).
-			Find(&candidates).Error; err != nil {
-			return err
+	// pickCandidate returns the eligible candidate for the PR with the lowest load,
+	// limited to the given seniority unless it is empty. Like rankCandidates for new PRs
+	// it keeps to the team while the team has someone and breaks equal loads at random.
+	pickCandidate := func(row Row, current map[string]struct{}, level string) (string, bool) {
+		best, found := "", false
+		for _, c := range activeCandidates {
+			if c == row.AuthorID || excluded(row, c) {
+				continue
+			}
+			if _, used := current[c]; used {
+				continue
+			}
+			if workloads[c].AtCapacity() {
+				continue
+			}
+			if level != "" && seniority[c] != level {
+				continue
+			}
+			if !found || tier[c] < tier[best] ||
+				tier[c] == tier[best] && workloads[c].Load < workloads[best].Load {
+				best, found = c, true
+			}
 		}
+		return best, found
+	}
 
-		activeCandidates := make([]string, 0, len(candidates))
-		for _, u := range candidates {
-			activeCandidates = append(activeCandidates, u.ID)
+	for _, row := range rows {
+		cur := reviewers[row.PRID]
+		pairing := row.ReviewPolicy == domain.ReviewPolicyPairing
+
+		var (
+			newID string
+			ok    bool
+		)
+		if pairing {
+			newID, ok = pickCandidate(row, cur, row.ReviewerSeniority)
+		}
+		if !ok {
+			newID, ok = pickCandidate(row, cur, "")
 		}
 
-		type Row struct {
-			PRID     string
-			AuthorID string
-			Reviewer string
+		if ok {
+			if err := tx.Table("pull_request_reviewers").
+				Where("organization = ? AND pull_request_id = ? AND reviewer_id = ?", org, row.PRID, row.Reviewer).
+				Updates(map[string]any{"reviewer_id": newID, "assigned_at": time.Now()}).Error; err != nil {
+				return err
+			}
+			if err := recordReassignment(tx, org, row.PRID, row.Reviewer, newID, actor); err != nil {
+				return err
+			}
+			delete(cur, row.Reviewer)
+			cur[newID] = struct{}{}
+			w := workloads[newID]
+			w.OpenReviews++
+			w.Load += weights[row.PRID]
+			workloads[newID] = w
+			result.ReassignedReviewersCount++
+			result.Reassignments = append(result.Reassignments, domain.ReviewerReassignment{
+				PullRequestID: row.PRID,
+				OldReviewerID: row.Reviewer,
+				NewReviewerID: newID,
+				PairingBroken: pairing && seniority[newID] != row.ReviewerSeniority,
+			})
+
+		} else {
+			if err := tx.Table("pull_request_reviewers").
+				Where("organization = ? AND pull_request_id = ? AND reviewer_id = ?", org, row.PRID, row.Reviewer).
+				Delete(nil).Error; err != nil {
+				return err
+			}
+			if err := recordReassignment(tx, org, row.PRID, row.Reviewer, "", actor); err != nil {
+				return err
+			}
+			delete(cur, row.Reviewer)
+			result.Reassignments = append(result.Reassignments, domain.ReviewerReassignment{
+				PullRequestID: row.PRID,
+				OldReviewerID: row.Reviewer,
+				PairingBroken: pairing,
+			})
 		}
+	}
+
+	return bumpPRVersions(tx, org, prIDs)
+}
 
-		var rows []Row
-		if err := tx.Table("pull_requests as pr").
-			Select("pr.pull_request_id, pr.author_id, prr.reviewer_id").
-			Joins("JOIN pull_request_reviewers prr ON prr.pull_request_id = pr.pull_request_id").
-			Where("pr.status = 'OPEN' AND prr.reviewer_id IN ?", userIDs).
-			Scan(&rows).Error; err != nil {
+// bumpPRVersions moves PRs whose reviewers changed to their next version, so clients
+// holding the old ETag don't overwrite the change.
+func bumpPRVersions(tx *gorm.DB, org string, prIDs []string) error {
+	if len(prIDs) == 0 {
+		return nil
+	}
+	return tx.Model(&domain.PullRequest{}).
+		Where("organization = ? AND pull_request_id IN ?", org, prIDs).
+		Update("version", gorm.Expr("version + 1")).Error
+}
+
+// recordReassignment remembers who took over the review so it can be handed back
+// when the old reviewer is activated again, and who caused the change.
+func recordReassignment(tx *gorm.DB, org, prID, oldReviewerID, newReviewerID, actor string) error {
+	var newReviewer *string
+	if newReviewerID != "" {
+		newReviewer = &newReviewerID
+	}
+	return tx.Table("reviewer_reassignments").Create(map[string]any{
+		"organization":    org,
+		"pull_request_id": prID,
+		"old_reviewer_id": oldReviewerID,
+		"new_reviewer_id": newReviewer,
+		"actor":           actor,
+	}).Error
+}
+
+// ActivateAndRestore saves the user as active and hands the OPEN reviews taken from
+// them back in the same transaction. It returns the PRs the user got back.
+func (u *userRepository) ActivateAndRestore(org string, user domain.User) ([]string, error) {
+	var restored []string
+	err := u.db.Transaction(func(tx *gorm.DB) error {
+		user.Organization = org
+		if err := tx.Save(&user).Error; err != nil {
 			return err
 		}
 
-		if len(rows) == 0 {
-			return nil
-		}
+		var err error
+		restored, err = restoreReviews(tx, org, user.ID)
+		return err
+	})
+	return restored, err
+}
 
-		result.AffectedPRCount = len(rows)
+// restoreReviews hands the OPEN reviews taken from the user back to them. The user
+// replaces whoever took over the review, or takes a free slot if that reviewer is gone
+// and the PR has fewer reviewers than its team's size rules ask for. Reviews are
+// only handed back under the rules used for picking reviewers: an absent user gets
+// none, the user's open review limit is respected and PRs the user is excluded from
+// or declined stay with their current reviewers. Reviews that were not handed back
+// stay pending, so a later activation tries them again.
+func restoreReviews(tx *gorm.DB, org, userID string) ([]string, error) {
+	type Row struct {
+		ID            int64   `gorm:"column:id"`
+		PRID          string  `gorm:"column:pull_request_id"`
+		NewReviewerID *string `gorm:"column:new_reviewer_id"`
+		AuthorID      string  `gorm:"column:author_id"`
+		AuthorTeam    string  `gorm:"column:author_team"`
+		Lines         int     `gorm:"column:lines"`
+	}
 
-		var prIDs []string
-		prSet := map[string]struct{}{}
-		for _, r := range rows {
-			if _, ok := prSet[r.PRID]; !ok {
-				prSet[r.PRID] = struct{}{}
-				prIDs = append(prIDs, r.PRID)
-			}
-		}
+	var rows []Row
+	if err := tx.Table("reviewer_reassignments AS rr").
+		Select(`rr.id, rr.pull_request_id, rr.new_reviewer_id, pr.author_id,
+			a.team_name AS author_team, pr.additions + pr.deletions AS lines`).
+		Joins("JOIN pull_requests pr ON pr.organization = rr.organization AND pr.pull_request_id = rr.pull_request_id").
+		Joins("JOIN users a ON a.organization = pr.organization AND a.id = pr.author_id").
+		Where("rr.organization = ? AND rr.old_reviewer_id = ?", org, userID).
+		Where("rr.restored_at IS NULL AND pr.status = 'OPEN'").
+		Order("rr.created_at DESC, rr.id DESC").
+		Scan(&rows).Error; err != nil {
+		return nil, err
+	}
+	if len(rows) == 0 {
+		return nil, nil
+	}
+
+	authorIDs := make([]string, 0, len(rows))
+	prIDs := make([]string, 0, len(rows))
+	for _, r := range rows {
+		authorIDs = append(authorIDs, r.AuthorID)
+		prIDs = append(prIDs, r.PRID)
+	}
 
-		type ReviewerRow struct {
-			PRID     string
-			Reviewer string
+	var available int64
+	if err := tx.Table("users").
+		Scopes(candidates.Available(time.Now())).
+		Where("organization = ? AND id = ?", org, userID).
+		Count(&available).Error; err != nil {
+		return nil, err
+	}
+
+	var restored []string
+	// done holds the PRs whose reassignments are settled: the user got the review
+	// back or already has it. The rest stay pending for a later activation.
+	done := make(map[string]struct{})
+	if available > 0 {
+		workloads, err := candidates.Workloads(tx, org, []string{userID})
+		if err != nil {
+			return nil, err
 		}
+		workload := workloads[userID]
 
-		var revRows []ReviewerRow
-		if err := tx.Table("pull_request_reviewers").
-			Where("pull_request_id IN ?", prIDs).
-			Scan(&revRows).Error; err != nil {
-			return err
+		excludedByAuthor, err := candidates.Excluded(tx, org, authorIDs)
+		if err != nil {
+			return nil, err
+		}
+		excludedByPR, err := candidates.ExcludedForPRs(tx, org, prIDs)
+		if err != nil {
+			return nil, err
 		}
 
-		reviewers := make(map[string]map[string]struct{})
-		for _, r := range revRows {
-			if reviewers[r.PRID] == nil {
-				reviewers[r.PRID] = make(map[string]struct{})
+		excluded := func(r Row) bool {
+			if _, ok := excludedByAuthor[r.AuthorID][userID]; ok {
+				return true
+			}
+			for _, id := range excludedByPR[r.PRID] {
+				if id == userID {
+					return true
+				}
 			}
-			reviewers[r.PRID][r.Reviewer] = struct{}{}
+			return false
 		}
 
-		pickCandidate := func(author string, current map[string]struct{}) (string, bool) {
-			for _, c := range activeCandidates {
-				if c == author {
-					continue
-				}
-				if _, used := current[c]; used {
-					continue
+		sizeRules := make(map[string][]domain.SizeRule)
+		target := func(r Row) (int, error) {
+			rules, ok := sizeRules[r.AuthorTeam]
+			if !ok {
+				var err error
+				rules, err = pullRequestService.SizeRules(tx, org, r.AuthorTeam)
+				if err != nil {
+					return 0, err
 				}
-				return c, true
+				sizeRules[r.AuthorTeam] = rules
 			}
-			return "", false
+			return pullRequestService.ReviewersForSize(rules, r.Lines), nil
 		}
 
-		for _, row := range rows {
-			cur := reviewers[row.PRID]
-			newID, ok := pickCandidate(row.AuthorID, cur)
+		handled := make(map[string]struct{})
+		for _, r := range rows {
+			if _, ok := handled[r.PRID]; ok {
+				continue
+			}
+			handled[r.PRID] = struct{}{}
 
-			if ok {
-				if err := tx.Table("pull_request_reviewers").
-					Where("pull_request_id = ? AND reviewer_id = ?", row.PRID, row.Reviewer).
-					Update("reviewer_id", newID).Error; err != nil {
-					return err
+			if workload.AtCapacity() || excluded(r) {
+				continue
+			}
+
+			var current []string
+			if err := tx.Table("pull_request_reviewers").
+				Where("organization = ? AND pull_request_id = ?", org, r.PRID).
+				Pluck("reviewer_id", &current).Error; err != nil {
+				return nil, err
+			}
+
+			assigned := false
+			takenOver := false
+			for _, id := range current {
+				if id == userID {
+					assigned = true
 				}
-				delete(cur, row.Reviewer)
-				cur[newID] = struct{}{}
-				result.ReassignedReviewersCount++
+				if r.NewReviewerID != nil && id == *r.NewReviewerID {
+					takenOver = true
+				}
+			}
 
-			} else {
+			need, err := target(r)
+			if err != nil {
+				return nil, err
+			}
+
+			switch {
+			case assigned:
+				done[r.PRID] = struct{}{}
+				continue
+			case takenOver:
 				if err := tx.Table("pull_request_reviewers").
-					Where("pull_request_id = ? AND reviewer_id = ?", row.PRID, row.Reviewer).
-					Delete(nil).Error; err != nil {
-					return err
+					Where("organization = ? AND pull_request_id = ? AND reviewer_id = ?", org, r.PRID, *r.NewReviewerID).
+					Updates(map[string]any{"reviewer_id": userID, "assigned_at": time.Now()}).Error; err != nil {
+					return nil, err
+				}
+			case len(current) < need:
+				if err := tx.Create(&domain.PullRequestReviewer{
+					Organization:  org,
+					PullRequestID: r.PRID,
+					ReviewerID:    userID,
+				}).Error; err != nil {
+					return nil, err
 				}
-				delete(cur, row.Reviewer)
+			default:
+				continue
 			}
+			workload.OpenReviews++
+			restored = append(restored, r.PRID)
+			done[r.PRID] = struct{}{}
 		}
+	}
 
-		return nil
-	})
+	if err := bumpPRVersions(tx, org, restored); err != nil {
+		return nil, err
+	}
+	var ids []int64
+	for _, r := range rows {
+		if _, ok := done[r.PRID]; ok {
+			ids = append(ids, r.ID)
+		}
+	}
+	if len(ids) == 0 {
+		return restored, nil
+	}
+	err := tx.Table("reviewer_reassignments").
+		Where("organization = ? AND id IN ?", org, ids).
+		Update("restored_at", gorm.Expr("NOW()")).Error
+	return restored, err
 }
@@ -0,0 +1,189 @@
+package userService
+
+import (
+	"PullRequestService/domain"
+	"PullRequestService/internal/testdb"
+	"reflect"
+	"sort"
+	"testing"
+	"time"
+)
+
+func TestRepositoryStaysInOrganization(t *testing.T) {
+	db := testdb.Open(t)
+	testdb.Seed(t, db)
+	repo := NewUserRepository(db)
+
+	reviewers := func(org, prID string) []string {
+		t.Helper()
+		var ids []string
+		if err := db.Table("pull_request_reviewers").
+			Where("organization = ? AND pull_request_id = ?", org, prID).
+			Pluck("reviewer_id", &ids).Error; err != nil {
+			t.Fatal(err)
+		}
+		sort.Strings(ids)
+		return ids
+	}
+
+	activated := func(org, id string) domain.User {
+		t.Helper()
+		user, err := repo.GetUserByID(org, id)
+		if err != nil {
+			t.Fatal(err)
+		}
+		user.IsActive = true
+		return user
+	}
+
+	t.Run("GetPRsForReviewer", func(t *testing.T) {
+		tests := []struct {
+			org  string
+			want []string
+		}{
+			{testdb.Acme, []string{"acme-pr-1"}},
+			{testdb.Globex, []string{"globex-pr-1"}},
+		}
+		for _, tt := range tests {
+			prs, err := repo.GetPRsForReviewer(tt.org, "u2")
+			if err != nil {
+				t.Fatal(err)
+			}
+			var names []string
+			for _, pr := range prs {
+				names = append(names, pr.Name)
+			}
+			if !reflect.DeepEqual(names, tt.want) {
+				t.Errorf("GetPRsForReviewer(%s) = %v, want %v", tt.org, names, tt.want)
+			}
+		}
+	})
+
+	t.Run("DeactivateAndReassign", func(t *testing.T) {
+		// Globex pr-1 is merged, so Acme's open pr-1 with the same reviewer must not
+		// be touched.
+		result, err := repo.DeactivateAndReassign(testdb.Globex, "backend", []string{"u2"}, false, domain.ActorSystem)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if result.DeactivatedCount != 1 || result.AffectedPRCount != 0 {
+			t.Errorf("Globex deactivation = %+v, want 1 user and no PRs", result)
+		}
+		if got := reviewers(testdb.Acme, "pr-1"); !reflect.DeepEqual(got, []string{"u2"}) {
+			t.Errorf("Acme pr-1 reviewers = %v after Globex deactivation, want [u2]", got)
+		}
+		if user, err := repo.GetUserByID(testdb.Acme, "u2"); err != nil || !user.IsActive {
+			t.Errorf("Acme u2 = %+v, %v; want active", user, err)
+		}
+
+		// In Acme u1 excludes u3, so the review goes to u4 from the fallback team.
+		result, err = repo.DeactivateAndReassign(testdb.Acme, "backend", []string{"u2"}, false, domain.ActorSystem)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if result.ReassignedReviewersCount != 1 {
+			t.Errorf("Acme deactivation = %+v, want 1 reassigned review", result)
+		}
+		if got := reviewers(testdb.Acme, "pr-1"); !reflect.DeepEqual(got, []string{"u4"}) {
+			t.Errorf("Acme pr-1 reviewers = %v, want [u4]", got)
+		}
+		if got := reviewers(testdb.Globex, "pr-1"); !reflect.DeepEqual(got, []string{"u2", "u3"}) {
+			t.Errorf("Globex pr-1 reviewers = %v, want [u2 u3]", got)
+		}
+	})
+
+	t.Run("ActivateAndRestore", func(t *testing.T) {
+		restored, err := repo.ActivateAndRestore(testdb.Globex, activated(testdb.Globex, "u2"))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(restored) != 0 {
+			t.Errorf("Globex restored %v, want nothing", restored)
+		}
+
+		// Neither an absence nor a full review limit may use up the pending reviews.
+		absence := domain.Absence{Organization: testdb.Acme, UserID: "u2", Source: "api",
+			StartsAt: time.Now().Add(-time.Hour), EndsAt: time.Now().Add(time.Hour)}
+		if err := db.Create(&absence).Error; err != nil {
+			t.Fatal(err)
+		}
+		restored, err = repo.ActivateAndRestore(testdb.Acme, activated(testdb.Acme, "u2"))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(restored) != 0 {
+			t.Errorf("Acme restored %v during an absence, want nothing", restored)
+		}
+		if err := db.Delete(&absence).Error; err != nil {
+			t.Fatal(err)
+		}
+
+		if err := repo.SetMaxOpenReviews(testdb.Acme, "u2", new(int)); err != nil {
+			t.Fatal(err)
+		}
+		restored, err = repo.ActivateAndRestore(testdb.Acme, activated(testdb.Acme, "u2"))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(restored) != 0 {
+			t.Errorf("Acme restored %v at capacity, want nothing", restored)
+		}
+		if got := reviewers(testdb.Acme, "pr-1"); !reflect.DeepEqual(got, []string{"u4"}) {
+			t.Errorf("Acme pr-1 reviewers = %v, want [u4]", got)
+		}
+
+		if err := repo.SetMaxOpenReviews(testdb.Acme, "u2", nil); err != nil {
+			t.Fatal(err)
+		}
+		restored, err = repo.ActivateAndRestore(testdb.Acme, activated(testdb.Acme, "u2"))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !reflect.DeepEqual(restored, []string{"pr-1"}) {
+			t.Errorf("Acme restored %v, want [pr-1]", restored)
+		}
+		if got := reviewers(testdb.Acme, "pr-1"); !reflect.DeepEqual(got, []string{"u2"}) {
+			t.Errorf("Acme pr-1 reviewers = %v, want [u2]", got)
+		}
+	})
+}
+
+func TestDeactivateSpreadsReviewsByLoad(t *testing.T) {
+	db := testdb.Open(t)
+	repo := NewUserRepository(db)
+
+	values := []interface{}{
+		&domain.Team{Organization: testdb.Acme, Name: "core"},
+		&domain.User{Organization: testdb.Acme, ID: "author", Username: "author", TeamName: "core", IsActive: true},
+		&domain.User{Organization: testdb.Acme, ID: "leaving", Username: "leaving", TeamName: "core", IsActive: true},
+		&domain.User{Organization: testdb.Acme, ID: "c1", Username: "c1", TeamName: "core", IsActive: true},
+		&domain.User{Organization: testdb.Acme, ID: "c2", Username: "c2", TeamName: "core", IsActive: true},
+	}
+	for _, id := range []string{"pr-1", "pr-2", "pr-3", "pr-4"} {
+		values = append(values,
+			&domain.PullRequest{Organization: testdb.Acme, ID: id, Name: id, AuthorID: "author", Status: "OPEN", CreatedAt: time.Now()},
+			&domain.PullRequestReviewer{Organization: testdb.Acme, PullRequestID: id, ReviewerID: "leaving"},
+		)
+	}
+	for _, v := range values {
+		if err := db.Create(v).Error; err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	result, err := repo.DeactivateAndReassign(testdb.Acme, "core", []string{"leaving"}, false, domain.ActorSystem)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if result.ReassignedReviewersCount != 4 {
+		t.Fatalf("reassigned %d reviews, want 4", result.ReassignedReviewersCount)
+	}
+
+	got := map[string]int{}
+	for _, r := range result.Reassignments {
+		got[r.NewReviewerID]++
+	}
+	if got["c1"] != 2 || got["c2"] != 2 {
+		t.Errorf("reviews went to %v, want 2 each for c1 and c2", got)
+	}
+}
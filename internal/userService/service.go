@@ -2,17 +2,33 @@ package userService
 
 import (
 	"PullRequestService/domain"
+	"PullRequestService/internal/candidates"
+	"context"
 	"errors"
 	"gorm.io/gorm"
+	"time"
 )
 
 type UserService interface {
-	SetIsActive(isActive bool, id string) (*domain.User, error)
-	GetUserByID(id string) (domain.User, error)
-	GetPRsForReviewer(userID string) ([]domain.PullRequest, error)
-	DeactivateAndReassign(teamName string, userIDs []string) (*domain.DeactivateResult, error)
+	SetIsActive(ctx context.Context, isActive bool, id string, reassignReviews, restoreReviews bool) (*domain.SetIsActiveResult, error)
+	SetMaxOpenReviews(ctx context.Context, id string, maxOpenReviews *int) (*domain.User, error)
+	SetSeniority(ctx context.Context, id, seniority string) (*domain.User, error)
+	GetSkills(ctx context.Context, id string) ([]string, error)
+	AddSkills(ctx context.Context, id string, skills []string) ([]string, error)
+	RemoveSkills(ctx context.Context, id string, skills []string) ([]string, error)
+	AddExclusion(ctx context.Context, exclusion domain.ReviewerExclusion) (domain.ReviewerExclusion, error)
+	RemoveExclusion(ctx context.Context, authorID, reviewerID string) error
+	GetExclusions(ctx context.Context, userID string) ([]domain.ReviewerExclusion, error)
+	GetUserByID(ctx context.Context, id string) (domain.User, error)
+	GetPRsForReviewer(ctx context.Context, userID string) ([]domain.PullRequest, error)
+	DeactivateAndReassign(ctx context.Context, teamName string, userIDs []string, dryRun bool) (*domain.DeactivateResult, error)
 }
 
+var (
+	errUserNotFound = domain.ErrNotFound.Msg("user not found")
+	errInvalidSkill = domain.ErrBadRequest.Msg("skills must contain at least one non-empty tag")
+)
+
 type userService struct {
 	repo UserRepository
 }
@@ -21,34 +37,191 @@ func NewUserService(repo UserRepository) UserService {
 	return &userService{repo: repo}
 }
 
-func (us *userService) SetIsActive(isActive bool, id string) (*domain.User, error) {
-	existing, err := us.repo.GetUserByID(id)
+// SetIsActive flips the activity flag. On deactivation it can reassign the user's OPEN
+// reviews like DeactivateAndReassign does, on activation it can hand them back.
+func (us *userService) SetIsActive(ctx context.Context, isActive bool, id string, reassignReviews, restoreReviews bool) (*domain.SetIsActiveResult, error) {
+	org := domain.OrganizationFrom(ctx)
+	existing, err := us.getExisting(org, id)
 	if err != nil {
 		return nil, err
 	}
 
+	result := &domain.SetIsActiveResult{}
+
+	if !isActive && reassignReviews && existing.TeamName != "" {
+		deactivated, err := us.repo.DeactivateAndReassign(org, existing.TeamName, []string{existing.ID}, false, domain.ActorFrom(ctx))
+		if err != nil {
+			return nil, err
+		}
+		existing.IsActive = false
+		result.User = existing
+		result.Reassignments = deactivated.Reassignments
+		return result, nil
+	}
+
 	existing.IsActive = isActive
 
-	if err = us.repo.SetIsActive(existing); err != nil {
+	if isActive && restoreReviews {
+		result.RestoredPRIDs, err = us.repo.ActivateAndRestore(org, existing)
+	} else {
+		err = us.repo.SetIsActive(org, existing)
+	}
+	if err != nil {
+		return nil, err
+	}
+	result.User = existing
+
+	return result, nil
+}
+
+func (us *userService) SetMaxOpenReviews(ctx context.Context, id string, maxOpenReviews *int) (*domain.User, error) {
+	org := domain.OrganizationFrom(ctx)
+	if maxOpenReviews != nil && *maxOpenReviews < 0 {
+		return nil, domain.ErrBadRequest.Msg("max_open_reviews must not be negative")
+	}
+
+	existing, err := us.repo.GetUserByID(org, id)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errUserNotFound
+		}
+		return nil, err
+	}
+
+	if err := us.repo.SetMaxOpenReviews(org, id, maxOpenReviews); err != nil {
 		return nil, err
 	}
+
+	existing.MaxOpenReviews = maxOpenReviews
 	return &existing, nil
 }
 
-func (us *userService) GetUserByID(id string) (domain.User, error) {
-	return us.repo.GetUserByID(id)
+func (us *userService) SetSeniority(ctx context.Context, id, seniority string) (*domain.User, error) {
+	org := domain.OrganizationFrom(ctx)
+	if seniority != domain.SeniorityJunior && seniority != domain.SenioritySenior {
+		return nil, domain.ErrBadRequest.Msg("seniority must be junior or senior")
+	}
+
+	existing, err := us.getExisting(org, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := us.repo.SetSeniority(org, id, seniority); err != nil {
+		return nil, err
+	}
+
+	existing.Seniority = seniority
+	return &existing, nil
+}
+
+func (us *userService) GetSkills(ctx context.Context, id string) ([]string, error) {
+	org := domain.OrganizationFrom(ctx)
+	if _, err := us.getExisting(org, id); err != nil {
+		return nil, err
+	}
+	return us.repo.GetSkills(org, id)
+}
+
+// AddSkills tags the user with skills. Tags are stored lower-cased; adding a tag the
+// user already has is a no-op. It returns the user's skills after the change.
+func (us *userService) AddSkills(ctx context.Context, id string, skills []string) ([]string, error) {
+	org := domain.OrganizationFrom(ctx)
+	skills = candidates.NormalizeTags(skills)
+	if len(skills) == 0 {
+		return nil, errInvalidSkill
+	}
+	if _, err := us.getExisting(org, id); err != nil {
+		return nil, err
+	}
+
+	if err := us.repo.AddSkills(org, id, skills); err != nil {
+		return nil, err
+	}
+	return us.repo.GetSkills(org, id)
+}
+
+func (us *userService) RemoveSkills(ctx context.Context, id string, skills []string) ([]string, error) {
+	org := domain.OrganizationFrom(ctx)
+	skills = candidates.NormalizeTags(skills)
+	if len(skills) == 0 {
+		return nil, errInvalidSkill
+	}
+	if _, err := us.getExisting(org, id); err != nil {
+		return nil, err
+	}
+
+	if err := us.repo.RemoveSkills(org, id, skills); err != nil {
+		return nil, err
+	}
+	return us.repo.GetSkills(org, id)
+}
+
+func (us *userService) AddExclusion(ctx context.Context, exclusion domain.ReviewerExclusion) (domain.ReviewerExclusion, error) {
+	org := domain.OrganizationFrom(ctx)
+	if exclusion.AuthorID == exclusion.ReviewerID {
+		return domain.ReviewerExclusion{}, domain.ErrBadRequest.Msg("author_id and reviewer_id must differ")
+	}
+	for _, id := range []string{exclusion.AuthorID, exclusion.ReviewerID} {
+		if _, err := us.getExisting(org, id); err != nil {
+			return domain.ReviewerExclusion{}, err
+		}
+	}
+
+	exclusion.CreatedAt = time.Now()
+	if err := us.repo.AddExclusion(org, exclusion); err != nil {
+		return domain.ReviewerExclusion{}, err
+	}
+	return exclusion, nil
+}
+
+func (us *userService) RemoveExclusion(ctx context.Context, authorID, reviewerID string) error {
+	org := domain.OrganizationFrom(ctx)
+	found, err := us.repo.RemoveExclusion(org, authorID, reviewerID)
+	if err != nil {
+		return err
+	}
+	if !found {
+		return domain.ErrNotFound.Msg("exclusion not found")
+	}
+	return nil
+}
+
+func (us *userService) GetExclusions(ctx context.Context, userID string) ([]domain.ReviewerExclusion, error) {
+	org := domain.OrganizationFrom(ctx)
+	if _, err := us.getExisting(org, userID); err != nil {
+		return nil, err
+	}
+	return us.repo.GetExclusions(org, userID)
+}
+
+func (us *userService) getExisting(org, id string) (domain.User, error) {
+	user, err := us.repo.GetUserByID(org, id)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return domain.User{}, errUserNotFound
+		}
+		return domain.User{}, err
+	}
+	return user, nil
+}
+
+func (us *userService) GetUserByID(ctx context.Context, id string) (domain.User, error) {
+	org := domain.OrganizationFrom(ctx)
+	return us.repo.GetUserByID(org, id)
 }
 
-func (us *userService) GetPRsForReviewer(userID string) ([]domain.PullRequest, error) {
-	_, err := us.repo.GetUserByID(userID)
+func (us *userService) GetPRsForReviewer(ctx context.Context, userID string) ([]domain.PullRequest, error) {
+	org := domain.OrganizationFrom(ctx)
+	_, err := us.repo.GetUserByID(org, userID)
 	if err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
-			return nil, errors.New("NOT_FOUND")
+			return nil, errUserNotFound
 		}
 		return nil, err
 	}
 
-	prs, err := us.repo.GetPRsForReviewer(userID)
+	prs, err := us.repo.GetPRsForReviewer(org, userID)
 	if err != nil {
 		return nil, err
 	}
@@ -56,6 +229,7 @@ func (us *userService) GetPRsForReviewer(userID string) ([]domain.PullRequest, e
 	return prs, nil
 }
 
-func (us *userService) DeactivateAndReassign(teamName string, userIDs []string) (*domain.DeactivateResult, error) {
-	return us.repo.DeactivateAndReassign(teamName, userIDs)
+func (us *userService) DeactivateAndReassign(ctx context.Context, teamName string, userIDs []string, dryRun bool) (*domain.DeactivateResult, error) {
+	org := domain.OrganizationFrom(ctx)
+	return us.repo.DeactivateAndReassign(org, teamName, userIDs, dryRun, domain.ActorFrom(ctx))
 }
@@ -0,0 +1,95 @@
+package validation
+
+import (
+	"PullRequestService/domain"
+	"fmt"
+	"strings"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/getkin/kin-openapi/openapi3filter"
+	"github.com/getkin/kin-openapi/routers/gorillamux"
+	echo "github.com/labstack/echo/v4"
+)
+
+// Middleware checks every request against the OpenAPI spec: parameters, required
+// fields, types, lengths and patterns of the JSON body. A request that breaks it gets
+// 400 BAD_REQUEST listing each invalid field. Routes the spec doesn't know are left to
+// echo, and security is checked by the auth middleware, not here.
+func Middleware(spec []byte) (echo.MiddlewareFunc, error) {
+	loader := openapi3.NewLoader()
+	doc, err := loader.LoadFromData(spec)
+	if err != nil {
+		return nil, fmt.Errorf("load OpenAPI spec: %w", err)
+	}
+	if err := doc.Validate(loader.Context); err != nil {
+		return nil, fmt.Errorf("invalid OpenAPI spec: %w", err)
+	}
+	router, err := gorillamux.NewRouter(doc)
+	if err != nil {
+		return nil, fmt.Errorf("route OpenAPI spec: %w", err)
+	}
+
+	options := &openapi3filter.Options{
+		MultiError:         true,
+		AuthenticationFunc: openapi3filter.NoopAuthenticationFunc,
+	}
+
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			req := c.Request()
+			route, pathParams, err := router.FindRoute(req)
+			if err != nil {
+				return next(c)
+			}
+
+			err = openapi3filter.ValidateRequest(req.Context(), &openapi3filter.RequestValidationInput{
+				Request:    req,
+				PathParams: pathParams,
+				Route:      route,
+				Options:    options,
+			})
+			if err != nil {
+				return domain.ErrValidation.WithFields(fieldErrors(err, "", nil)...)
+			}
+			return next(c)
+		}
+	}, nil
+}
+
+// fieldErrors flattens a validation error into one FieldError per invalid field.
+// field is the parameter or body path the error was found under.
+func fieldErrors(err error, field string, fields []domain.FieldError) []domain.FieldError {
+	switch e := err.(type) {
+	case openapi3.MultiError:
+		for _, item := range e {
+			fields = fieldErrors(item, field, fields)
+		}
+		return fields
+	case *openapi3filter.RequestError:
+		if e.Parameter != nil {
+			field = e.Parameter.Name
+		}
+		if e.Err == nil {
+			return append(fields, domain.FieldError{Field: bodyField(field), Message: e.Reason})
+		}
+		return fieldErrors(e.Err, field, fields)
+	case *openapi3.SchemaError:
+		if path := e.JSONPointer(); len(path) > 0 {
+			if field != "" {
+				field += "."
+			}
+			field += strings.Join(path, ".")
+		}
+		return append(fields, domain.FieldError{Field: bodyField(field), Message: e.Reason})
+	default:
+		return append(fields, domain.FieldError{Field: bodyField(field), Message: err.Error()})
+	}
+}
+
+// bodyField names an error of the body as a whole, e.g. malformed JSON.
+func bodyField(field string) string {
+	if field == "" {
+		return "body"
+	}
+	return field
+}
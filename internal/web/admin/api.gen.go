@@ -0,0 +1,911 @@
+// Package admin provides primitives to interact with the openapi HTTP API.
+//
+// Code generated by github.com/deepmap/oapi-codegen version v1.16.3 DO NOT EDIT.
+package admin
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/oapi-codegen/runtime"
+	strictecho "github.com/oapi-codegen/runtime/strictmiddleware/echo"
+)
+
+const (
+	BearerAuthScopes = "bearerAuth.Scopes"
+)
+
+// Defines values for AuditEntryOutcome.
+const (
+	AuditEntryOutcomeError    AuditEntryOutcome = "error"
+	AuditEntryOutcomeRejected AuditEntryOutcome = "rejected"
+	AuditEntryOutcomeSuccess  AuditEntryOutcome = "success"
+)
+
+// Defines values for ErrorResponseErrorCode.
+const (
+	BADREQUEST               ErrorResponseErrorCode = "BAD_REQUEST"
+	FORBIDDEN                ErrorResponseErrorCode = "FORBIDDEN"
+	IDEMPOTENCYKEYINPROGRESS ErrorResponseErrorCode = "IDEMPOTENCY_KEY_IN_PROGRESS"
+	IDEMPOTENCYKEYREUSED     ErrorResponseErrorCode = "IDEMPOTENCY_KEY_REUSED"
+	INTERNALERROR            ErrorResponseErrorCode = "INTERNAL_ERROR"
+	NOCANDIDATE              ErrorResponseErrorCode = "NO_CANDIDATE"
+	NOTASSIGNED              ErrorResponseErrorCode = "NOT_ASSIGNED"
+	NOTFOUND                 ErrorResponseErrorCode = "NOT_FOUND"
+	PREXISTS                 ErrorResponseErrorCode = "PR_EXISTS"
+	PRMERGED                 ErrorResponseErrorCode = "PR_MERGED"
+	RATELIMITED              ErrorResponseErrorCode = "RATE_LIMITED"
+	TEAMEXISTS               ErrorResponseErrorCode = "TEAM_EXISTS"
+	UNAUTHORIZED             ErrorResponseErrorCode = "UNAUTHORIZED"
+	VERSIONMISMATCH          ErrorResponseErrorCode = "VERSION_MISMATCH"
+)
+
+// Defines values for Role.
+const (
+	Admin    Role = "admin"
+	Member   Role = "member"
+	TeamLead Role = "team-lead"
+)
+
+// Defines values for Seniority.
+const (
+	Junior Seniority = "junior"
+	Senior Seniority = "senior"
+)
+
+// Defines values for GetAdminAuditParamsOutcome.
+const (
+	GetAdminAuditParamsOutcomeError    GetAdminAuditParamsOutcome = "error"
+	GetAdminAuditParamsOutcomeRejected GetAdminAuditParamsOutcome = "rejected"
+	GetAdminAuditParamsOutcomeSuccess  GetAdminAuditParamsOutcome = "success"
+)
+
+// Defines values for PostAdminImportParamsFormat.
+const (
+	Csv  PostAdminImportParamsFormat = "csv"
+	Yaml PostAdminImportParamsFormat = "yaml"
+)
+
+// APIToken defines model for APIToken.
+type APIToken struct {
+	CreatedAt  time.Time  `json:"created_at"`
+	Id         int64      `json:"id"`
+	LastUsedAt *time.Time `json:"last_used_at"`
+	Name       string     `json:"name"`
+
+	// Organization Организация токена; выданный токен работает только в ней
+	Organization string     `json:"organization"`
+	RevokedAt    *time.Time `json:"revoked_at"`
+
+	// Role admin — любые операции; team-lead — управление своей командой и её PR;
+	// member — действия от своего имени
+	Role Role `json:"role"`
+
+	// TeamName Команда руководителя (обязательна для team-lead)
+	TeamName *string `json:"team_name"`
+
+	// UserId Пользователь, от имени которого действует токен (обязателен для member)
+	UserId *string `json:"user_id"`
+}
+
+// AuditEntry defines model for AuditEntry.
+type AuditEntry struct {
+	// Actor id пользователя, token:<id> или system
+	Actor string `json:"actor"`
+
+	// After Состояние после успешного вызова
+	After *map[string]interface{} `json:"after"`
+
+	// Before Состояние команды, пользователя или PR до вызова
+	Before    *map[string]interface{} `json:"before"`
+	CreatedAt time.Time               `json:"created_at"`
+	ErrorCode *string                 `json:"error_code"`
+	Id        int64                   `json:"id"`
+
+	// Operation operationId вызванной операции
+	Operation string `json:"operation"`
+
+	// Outcome success — 2xx, rejected — 4xx, error — 5xx
+	Outcome AuditEntryOutcome `json:"outcome"`
+
+	// Payload Параметры и поля тела запроса; вложенные объекты и длинные списки сокращены
+	Payload map[string]interface{} `json:"payload"`
+
+	// RequestId Значение заголовка X-Request-Id ответа
+	RequestId string `json:"request_id"`
+
+	// Status HTTP-статус ответа
+	Status int `json:"status"`
+}
+
+// AuditEntryOutcome success — 2xx, rejected — 4xx, error — 5xx
+type AuditEntryOutcome string
+
+// ErrorResponse defines model for ErrorResponse.
+type ErrorResponse struct {
+	Error struct {
+		Code ErrorResponseErrorCode `json:"code"`
+
+		// Fields Некорректные поля запроса, если он не прошёл проверку по схеме
+		Fields *[]struct {
+			// Field Путь к полю тела через точку (members.1.user_id) или имя параметра
+			Field   string `json:"field"`
+			Message string `json:"message"`
+		} `json:"fields,omitempty"`
+		Message string `json:"message"`
+
+		// RequestId X-Request-ID запроса, по нему ошибку можно найти в логах
+		RequestId *string `json:"request_id,omitempty"`
+	} `json:"error"`
+}
+
+// ErrorResponseErrorCode defines model for ErrorResponse.Error.Code.
+type ErrorResponseErrorCode string
+
+// ImportResult defines model for ImportResult.
+type ImportResult struct {
+	AffectedPrCount          int        `json:"affected_pr_count"`
+	Diff                     RosterDiff `json:"diff"`
+	DryRun                   bool       `json:"dry_run"`
+	ReassignedReviewersCount int        `json:"reassigned_reviewers_count"`
+}
+
+// Role admin — любые операции; team-lead — управление своей командой и её PR;
+// member — действия от своего имени
+type Role string
+
+// RosterDiff defines model for RosterDiff.
+type RosterDiff struct {
+	TeamsToCreate     []string     `json:"teams_to_create"`
+	UsersToActivate   []string     `json:"users_to_activate"`
+	UsersToCreate     []User       `json:"users_to_create"`
+	UsersToDeactivate []string     `json:"users_to_deactivate"`
+	UsersToMove       []RosterMove `json:"users_to_move"`
+}
+
+// RosterMove defines model for RosterMove.
+type RosterMove struct {
+	FromTeam string `json:"from_team"`
+	ToTeam   string `json:"to_team"`
+
+	// UserId Идентификатор пользователя, без пробелов
+	UserId UserId `json:"user_id"`
+}
+
+// Seniority Уровень пользователя для режима наставничества
+type Seniority string
+
+// TeamName Имя команды, без пробелов по краям
+type TeamName = string
+
+// User defines model for User.
+type User struct {
+	IsActive       bool `json:"is_active"`
+	MaxOpenReviews *int `json:"max_open_reviews"`
+
+	// Seniority Уровень пользователя для режима наставничества
+	Seniority *Seniority `json:"seniority,omitempty"`
+
+	// TeamName Имя команды, без пробелов по краям
+	TeamName TeamName `json:"team_name"`
+
+	// UserId Идентификатор пользователя, без пробелов
+	UserId   UserId   `json:"user_id"`
+	Username Username `json:"username"`
+}
+
+// UserId Идентификатор пользователя, без пробелов
+type UserId = string
+
+// Username defines model for Username.
+type Username = string
+
+// IdempotencyKey defines model for IdempotencyKey.
+type IdempotencyKey = string
+
+// InternalError defines model for InternalError.
+type InternalError = ErrorResponse
+
+// ValidationError defines model for ValidationError.
+type ValidationError = ErrorResponse
+
+// GetAdminAuditParams defines parameters for GetAdminAudit.
+type GetAdminAuditParams struct {
+	Actor     *string                     `form:"actor,omitempty" json:"actor,omitempty"`
+	Operation *string                     `form:"operation,omitempty" json:"operation,omitempty"`
+	Outcome   *GetAdminAuditParamsOutcome `form:"outcome,omitempty" json:"outcome,omitempty"`
+	RequestId *string                     `form:"request_id,omitempty" json:"request_id,omitempty"`
+	From      *time.Time                  `form:"from,omitempty" json:"from,omitempty"`
+	To        *time.Time                  `form:"to,omitempty" json:"to,omitempty"`
+	Limit     *int                        `form:"limit,omitempty" json:"limit,omitempty"`
+	Offset    *int                        `form:"offset,omitempty" json:"offset,omitempty"`
+}
+
+// GetAdminAuditParamsOutcome defines parameters for GetAdminAudit.
+type GetAdminAuditParamsOutcome string
+
+// PostAdminImportTextBody defines parameters for PostAdminImport.
+type PostAdminImportTextBody = string
+
+// PostAdminImportParams defines parameters for PostAdminImport.
+type PostAdminImportParams struct {
+	// DryRun Только посчитать изменения, ничего не применяя
+	DryRun *bool `form:"dry_run,omitempty" json:"dry_run,omitempty"`
+
+	// Format Формат файла с составом команд
+	Format PostAdminImportParamsFormat `form:"format" json:"format"`
+
+	// IdempotencyKey Ключ повторной отправки. Первый ответ (кроме 5xx) сохраняется на IDEMPOTENCY_TTL
+	// и возвращается повторным запросам того же клиента с тем же ключом, путём и телом
+	// с заголовком `Idempotent-Replayed: true`. Тот же ключ с другим запросом даёт
+	// 422 IDEMPOTENCY_KEY_REUSED, повтор во время выполнения первого запроса — 409
+	// IDEMPOTENCY_KEY_IN_PROGRESS.
+	IdempotencyKey *IdempotencyKey `json:"Idempotency-Key,omitempty"`
+}
+
+// PostAdminImportParamsFormat defines parameters for PostAdminImport.
+type PostAdminImportParamsFormat string
+
+// PostAdminTokensCreateJSONBody defines parameters for PostAdminTokensCreate.
+type PostAdminTokensCreateJSONBody struct {
+	Name string `json:"name"`
+
+	// Role admin — любые операции; team-lead — управление своей командой и её PR;
+	// member — действия от своего имени
+	Role Role `json:"role"`
+
+	// TeamName Имя команды, без пробелов по краям
+	TeamName *TeamName `json:"team_name,omitempty"`
+
+	// UserId Идентификатор пользователя, без пробелов
+	UserId *UserId `json:"user_id,omitempty"`
+}
+
+// PostAdminTokensCreateParams defines parameters for PostAdminTokensCreate.
+type PostAdminTokensCreateParams struct {
+	// IdempotencyKey Ключ повторной отправки. Первый ответ (кроме 5xx) сохраняется на IDEMPOTENCY_TTL
+	// и возвращается повторным запросам того же клиента с тем же ключом, путём и телом
+	// с заголовком `Idempotent-Replayed: true`. Тот же ключ с другим запросом даёт
+	// 422 IDEMPOTENCY_KEY_REUSED, повтор во время выполнения первого запроса — 409
+	// IDEMPOTENCY_KEY_IN_PROGRESS.
+	IdempotencyKey *IdempotencyKey `json:"Idempotency-Key,omitempty"`
+}
+
+// PostAdminTokensRevokeJSONBody defines parameters for PostAdminTokensRevoke.
+type PostAdminTokensRevokeJSONBody struct {
+	TokenId int64 `json:"token_id"`
+}
+
+// PostAdminTokensRevokeParams defines parameters for PostAdminTokensRevoke.
+type PostAdminTokensRevokeParams struct {
+	// IdempotencyKey Ключ повторной отправки. Первый ответ (кроме 5xx) сохраняется на IDEMPOTENCY_TTL
+	// и возвращается повторным запросам того же клиента с тем же ключом, путём и телом
+	// с заголовком `Idempotent-Replayed: true`. Тот же ключ с другим запросом даёт
+	// 422 IDEMPOTENCY_KEY_REUSED, повтор во время выполнения первого запроса — 409
+	// IDEMPOTENCY_KEY_IN_PROGRESS.
+	IdempotencyKey *IdempotencyKey `json:"Idempotency-Key,omitempty"`
+}
+
+// PostAdminImportTextRequestBody defines body for PostAdminImport for text/plain ContentType.
+type PostAdminImportTextRequestBody = PostAdminImportTextBody
+
+// PostAdminTokensCreateJSONRequestBody defines body for PostAdminTokensCreate for application/json ContentType.
+type PostAdminTokensCreateJSONRequestBody PostAdminTokensCreateJSONBody
+
+// PostAdminTokensRevokeJSONRequestBody defines body for PostAdminTokensRevoke for application/json ContentType.
+type PostAdminTokensRevokeJSONRequestBody PostAdminTokensRevokeJSONBody
+
+// ServerInterface represents all server handlers.
+type ServerInterface interface {
+	// Журнал изменяющих вызовов API
+	// (GET /admin/audit)
+	GetAdminAudit(ctx echo.Context, params GetAdminAuditParams) error
+	// Импортировать состав команд из CSV или YAML
+	// (POST /admin/import)
+	PostAdminImport(ctx echo.Context, params PostAdminImportParams) error
+	// Список API-токенов (без секретов)
+	// (GET /admin/tokens)
+	GetAdminTokens(ctx echo.Context) error
+	// Выпустить API-токен
+	// (POST /admin/tokens/create)
+	PostAdminTokensCreate(ctx echo.Context, params PostAdminTokensCreateParams) error
+	// Отозвать API-токен
+	// (POST /admin/tokens/revoke)
+	PostAdminTokensRevoke(ctx echo.Context, params PostAdminTokensRevokeParams) error
+}
+
+// ServerInterfaceWrapper converts echo contexts to parameters.
+type ServerInterfaceWrapper struct {
+	Handler ServerInterface
+}
+
+// GetAdminAudit converts echo context to params.
+func (w *ServerInterfaceWrapper) GetAdminAudit(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetAdminAuditParams
+	// ------------- Optional query parameter "actor" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "actor", ctx.QueryParams(), &params.Actor)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter actor: %s", err))
+	}
+
+	// ------------- Optional query parameter "operation" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "operation", ctx.QueryParams(), &params.Operation)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter operation: %s", err))
+	}
+
+	// ------------- Optional query parameter "outcome" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "outcome", ctx.QueryParams(), &params.Outcome)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter outcome: %s", err))
+	}
+
+	// ------------- Optional query parameter "request_id" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "request_id", ctx.QueryParams(), &params.RequestId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter request_id: %s", err))
+	}
+
+	// ------------- Optional query parameter "from" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "from", ctx.QueryParams(), &params.From)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter from: %s", err))
+	}
+
+	// ------------- Optional query parameter "to" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "to", ctx.QueryParams(), &params.To)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter to: %s", err))
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", ctx.QueryParams(), &params.Limit)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
+	}
+
+	// ------------- Optional query parameter "offset" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "offset", ctx.QueryParams(), &params.Offset)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter offset: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetAdminAudit(ctx, params)
+	return err
+}
+
+// PostAdminImport converts echo context to params.
+func (w *ServerInterfaceWrapper) PostAdminImport(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PostAdminImportParams
+	// ------------- Optional query parameter "dry_run" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "dry_run", ctx.QueryParams(), &params.DryRun)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter dry_run: %s", err))
+	}
+
+	// ------------- Required query parameter "format" -------------
+
+	err = runtime.BindQueryParameter("form", true, true, "format", ctx.QueryParams(), &params.Format)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter format: %s", err))
+	}
+
+	headers := ctx.Request().Header
+	// ------------- Optional header parameter "Idempotency-Key" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("Idempotency-Key")]; found {
+		var IdempotencyKey IdempotencyKey
+		n := len(valueList)
+		if n != 1 {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Expected one value for Idempotency-Key, got %d", n))
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "Idempotency-Key", valueList[0], &IdempotencyKey, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter Idempotency-Key: %s", err))
+		}
+
+		params.IdempotencyKey = &IdempotencyKey
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostAdminImport(ctx, params)
+	return err
+}
+
+// GetAdminTokens converts echo context to params.
+func (w *ServerInterfaceWrapper) GetAdminTokens(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetAdminTokens(ctx)
+	return err
+}
+
+// PostAdminTokensCreate converts echo context to params.
+func (w *ServerInterfaceWrapper) PostAdminTokensCreate(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PostAdminTokensCreateParams
+
+	headers := ctx.Request().Header
+	// ------------- Optional header parameter "Idempotency-Key" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("Idempotency-Key")]; found {
+		var IdempotencyKey IdempotencyKey
+		n := len(valueList)
+		if n != 1 {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Expected one value for Idempotency-Key, got %d", n))
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "Idempotency-Key", valueList[0], &IdempotencyKey, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter Idempotency-Key: %s", err))
+		}
+
+		params.IdempotencyKey = &IdempotencyKey
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostAdminTokensCreate(ctx, params)
+	return err
+}
+
+// PostAdminTokensRevoke converts echo context to params.
+func (w *ServerInterfaceWrapper) PostAdminTokensRevoke(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PostAdminTokensRevokeParams
+
+	headers := ctx.Request().Header
+	// ------------- Optional header parameter "Idempotency-Key" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("Idempotency-Key")]; found {
+		var IdempotencyKey IdempotencyKey
+		n := len(valueList)
+		if n != 1 {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Expected one value for Idempotency-Key, got %d", n))
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "Idempotency-Key", valueList[0], &IdempotencyKey, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter Idempotency-Key: %s", err))
+		}
+
+		params.IdempotencyKey = &IdempotencyKey
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostAdminTokensRevoke(ctx, params)
+	return err
+}
+
+// This is a simple interface which specifies echo.Route addition functions which
+// are present on both echo.Echo and echo.Group, since we want to allow using
+// either of them for path registration
+type EchoRouter interface {
+	CONNECT(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+	DELETE(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+	GET(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+	HEAD(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+	OPTIONS(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+	PATCH(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+	POST(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+	PUT(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+	TRACE(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+}
+
+// RegisterHandlers adds each server route to the EchoRouter.
+func RegisterHandlers(router EchoRouter, si ServerInterface) {
+	RegisterHandlersWithBaseURL(router, si, "")
+}
+
+// Registers handlers, and prepends BaseURL to the paths, so that the paths
+// can be served under a prefix.
+func RegisterHandlersWithBaseURL(router EchoRouter, si ServerInterface, baseURL string) {
+
+	wrapper := ServerInterfaceWrapper{
+		Handler: si,
+	}
+
+	router.GET(baseURL+"/admin/audit", wrapper.GetAdminAudit)
+	router.POST(baseURL+"/admin/import", wrapper.PostAdminImport)
+	router.GET(baseURL+"/admin/tokens", wrapper.GetAdminTokens)
+	router.POST(baseURL+"/admin/tokens/create", wrapper.PostAdminTokensCreate)
+	router.POST(baseURL+"/admin/tokens/revoke", wrapper.PostAdminTokensRevoke)
+
+}
+
+type InternalErrorJSONResponse ErrorResponse
+
+type ValidationErrorJSONResponse ErrorResponse
+
+type GetAdminAuditRequestObject struct {
+	Params GetAdminAuditParams
+}
+
+type GetAdminAuditResponseObject interface {
+	VisitGetAdminAuditResponse(w http.ResponseWriter) error
+}
+
+type GetAdminAudit200JSONResponse struct {
+	Entries []AuditEntry `json:"entries"`
+
+	// NextOffset Смещение следующей страницы, null на последней
+	NextOffset *int `json:"next_offset"`
+}
+
+func (response GetAdminAudit200JSONResponse) VisitGetAdminAuditResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAdminAudit400JSONResponse ErrorResponse
+
+func (response GetAdminAudit400JSONResponse) VisitGetAdminAuditResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAdminAudit500JSONResponse struct{ InternalErrorJSONResponse }
+
+func (response GetAdminAudit500JSONResponse) VisitGetAdminAuditResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostAdminImportRequestObject struct {
+	Params PostAdminImportParams
+	Body   *PostAdminImportTextRequestBody
+}
+
+type PostAdminImportResponseObject interface {
+	VisitPostAdminImportResponse(w http.ResponseWriter) error
+}
+
+type PostAdminImport200JSONResponse ImportResult
+
+func (response PostAdminImport200JSONResponse) VisitPostAdminImportResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostAdminImport400JSONResponse ErrorResponse
+
+func (response PostAdminImport400JSONResponse) VisitPostAdminImportResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostAdminImport500JSONResponse struct{ InternalErrorJSONResponse }
+
+func (response PostAdminImport500JSONResponse) VisitPostAdminImportResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAdminTokensRequestObject struct {
+}
+
+type GetAdminTokensResponseObject interface {
+	VisitGetAdminTokensResponse(w http.ResponseWriter) error
+}
+
+type GetAdminTokens200JSONResponse struct {
+	Tokens []APIToken `json:"tokens"`
+}
+
+func (response GetAdminTokens200JSONResponse) VisitGetAdminTokensResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAdminTokens500JSONResponse struct{ InternalErrorJSONResponse }
+
+func (response GetAdminTokens500JSONResponse) VisitGetAdminTokensResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostAdminTokensCreateRequestObject struct {
+	Params PostAdminTokensCreateParams
+	Body   *PostAdminTokensCreateJSONRequestBody
+}
+
+type PostAdminTokensCreateResponseObject interface {
+	VisitPostAdminTokensCreateResponse(w http.ResponseWriter) error
+}
+
+type PostAdminTokensCreate201JSONResponse struct {
+	// Secret Значение для заголовка Authorization: Bearer
+	Secret string   `json:"secret"`
+	Token  APIToken `json:"token"`
+}
+
+func (response PostAdminTokensCreate201JSONResponse) VisitPostAdminTokensCreateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostAdminTokensCreate400JSONResponse ErrorResponse
+
+func (response PostAdminTokensCreate400JSONResponse) VisitPostAdminTokensCreateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostAdminTokensCreate500JSONResponse struct{ InternalErrorJSONResponse }
+
+func (response PostAdminTokensCreate500JSONResponse) VisitPostAdminTokensCreateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostAdminTokensRevokeRequestObject struct {
+	Params PostAdminTokensRevokeParams
+	Body   *PostAdminTokensRevokeJSONRequestBody
+}
+
+type PostAdminTokensRevokeResponseObject interface {
+	VisitPostAdminTokensRevokeResponse(w http.ResponseWriter) error
+}
+
+type PostAdminTokensRevoke204Response struct {
+}
+
+func (response PostAdminTokensRevoke204Response) VisitPostAdminTokensRevokeResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type PostAdminTokensRevoke400JSONResponse struct{ ValidationErrorJSONResponse }
+
+func (response PostAdminTokensRevoke400JSONResponse) VisitPostAdminTokensRevokeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostAdminTokensRevoke404JSONResponse ErrorResponse
+
+func (response PostAdminTokensRevoke404JSONResponse) VisitPostAdminTokensRevokeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostAdminTokensRevoke500JSONResponse struct{ InternalErrorJSONResponse }
+
+func (response PostAdminTokensRevoke500JSONResponse) VisitPostAdminTokensRevokeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+// StrictServerInterface represents all server handlers.
+type StrictServerInterface interface {
+	// Журнал изменяющих вызовов API
+	// (GET /admin/audit)
+	GetAdminAudit(ctx context.Context, request GetAdminAuditRequestObject) (GetAdminAuditResponseObject, error)
+	// Импортировать состав команд из CSV или YAML
+	// (POST /admin/import)
+	PostAdminImport(ctx context.Context, request PostAdminImportRequestObject) (PostAdminImportResponseObject, error)
+	// Список API-токенов (без секретов)
+	// (GET /admin/tokens)
+	GetAdminTokens(ctx context.Context, request GetAdminTokensRequestObject) (GetAdminTokensResponseObject, error)
+	// Выпустить API-токен
+	// (POST /admin/tokens/create)
+	PostAdminTokensCreate(ctx context.Context, request PostAdminTokensCreateRequestObject) (PostAdminTokensCreateResponseObject, error)
+	// Отозвать API-токен
+	// (POST /admin/tokens/revoke)
+	PostAdminTokensRevoke(ctx context.Context, request PostAdminTokensRevokeRequestObject) (PostAdminTokensRevokeResponseObject, error)
+}
+
+type StrictHandlerFunc = strictecho.StrictEchoHandlerFunc
+type StrictMiddlewareFunc = strictecho.StrictEchoMiddlewareFunc
+
+func NewStrictHandler(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc) ServerInterface {
+	return &strictHandler{ssi: ssi, middlewares: middlewares}
+}
+
+type strictHandler struct {
+	ssi         StrictServerInterface
+	middlewares []StrictMiddlewareFunc
+}
+
+// GetAdminAudit operation middleware
+func (sh *strictHandler) GetAdminAudit(ctx echo.Context, params GetAdminAuditParams) error {
+	var request GetAdminAuditRequestObject
+
+	request.Params = params
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetAdminAudit(ctx.Request().Context(), request.(GetAdminAuditRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetAdminAudit")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(GetAdminAuditResponseObject); ok {
+		return validResponse.VisitGetAdminAuditResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// PostAdminImport operation middleware
+func (sh *strictHandler) PostAdminImport(ctx echo.Context, params PostAdminImportParams) error {
+	var request PostAdminImportRequestObject
+
+	request.Params = params
+
+	data, err := io.ReadAll(ctx.Request().Body)
+	if err != nil {
+		return err
+	}
+	body := PostAdminImportTextRequestBody(data)
+	request.Body = &body
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostAdminImport(ctx.Request().Context(), request.(PostAdminImportRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostAdminImport")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(PostAdminImportResponseObject); ok {
+		return validResponse.VisitPostAdminImportResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// GetAdminTokens operation middleware
+func (sh *strictHandler) GetAdminTokens(ctx echo.Context) error {
+	var request GetAdminTokensRequestObject
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetAdminTokens(ctx.Request().Context(), request.(GetAdminTokensRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetAdminTokens")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(GetAdminTokensResponseObject); ok {
+		return validResponse.VisitGetAdminTokensResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// PostAdminTokensCreate operation middleware
+func (sh *strictHandler) PostAdminTokensCreate(ctx echo.Context, params PostAdminTokensCreateParams) error {
+	var request PostAdminTokensCreateRequestObject
+
+	request.Params = params
+
+	var body PostAdminTokensCreateJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostAdminTokensCreate(ctx.Request().Context(), request.(PostAdminTokensCreateRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostAdminTokensCreate")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(PostAdminTokensCreateResponseObject); ok {
+		return validResponse.VisitPostAdminTokensCreateResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// PostAdminTokensRevoke operation middleware
+func (sh *strictHandler) PostAdminTokensRevoke(ctx echo.Context, params PostAdminTokensRevokeParams) error {
+	var request PostAdminTokensRevokeRequestObject
+
+	request.Params = params
+
+	var body PostAdminTokensRevokeJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostAdminTokensRevoke(ctx.Request().Context(), request.(PostAdminTokensRevokeRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostAdminTokensRevoke")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(PostAdminTokensRevokeResponseObject); ok {
+		return validResponse.VisitPostAdminTokensRevokeResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
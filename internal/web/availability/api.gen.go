@@ -0,0 +1,691 @@
+// Package availability provides primitives to interact with the openapi HTTP API.
+//
+// Code generated by github.com/deepmap/oapi-codegen version v1.16.3 DO NOT EDIT.
+package availability
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/oapi-codegen/runtime"
+	strictecho "github.com/oapi-codegen/runtime/strictmiddleware/echo"
+)
+
+const (
+	BearerAuthScopes = "bearerAuth.Scopes"
+)
+
+// Defines values for AbsenceSource.
+const (
+	Api AbsenceSource = "api"
+	Ics AbsenceSource = "ics"
+)
+
+// Defines values for ErrorResponseErrorCode.
+const (
+	BADREQUEST               ErrorResponseErrorCode = "BAD_REQUEST"
+	FORBIDDEN                ErrorResponseErrorCode = "FORBIDDEN"
+	IDEMPOTENCYKEYINPROGRESS ErrorResponseErrorCode = "IDEMPOTENCY_KEY_IN_PROGRESS"
+	IDEMPOTENCYKEYREUSED     ErrorResponseErrorCode = "IDEMPOTENCY_KEY_REUSED"
+	INTERNALERROR            ErrorResponseErrorCode = "INTERNAL_ERROR"
+	NOCANDIDATE              ErrorResponseErrorCode = "NO_CANDIDATE"
+	NOTASSIGNED              ErrorResponseErrorCode = "NOT_ASSIGNED"
+	NOTFOUND                 ErrorResponseErrorCode = "NOT_FOUND"
+	PREXISTS                 ErrorResponseErrorCode = "PR_EXISTS"
+	PRMERGED                 ErrorResponseErrorCode = "PR_MERGED"
+	RATELIMITED              ErrorResponseErrorCode = "RATE_LIMITED"
+	TEAMEXISTS               ErrorResponseErrorCode = "TEAM_EXISTS"
+	UNAUTHORIZED             ErrorResponseErrorCode = "UNAUTHORIZED"
+	VERSIONMISMATCH          ErrorResponseErrorCode = "VERSION_MISMATCH"
+)
+
+// Absence defines model for Absence.
+type Absence struct {
+	AbsenceId int64     `json:"absence_id"`
+	EndsAt    time.Time `json:"ends_at"`
+
+	// HandOffReviews Передать открытые ревью другим участникам команды, когда отсутствие начнётся
+	HandOffReviews bool          `json:"hand_off_reviews"`
+	HandedOffAt    *time.Time    `json:"handed_off_at"`
+	Reason         string        `json:"reason"`
+	Source         AbsenceSource `json:"source"`
+	StartsAt       time.Time     `json:"starts_at"`
+
+	// UserId Идентификатор пользователя, без пробелов
+	UserId UserId `json:"user_id"`
+}
+
+// AbsenceSource defines model for Absence.Source.
+type AbsenceSource string
+
+// ErrorResponse defines model for ErrorResponse.
+type ErrorResponse struct {
+	Error struct {
+		Code ErrorResponseErrorCode `json:"code"`
+
+		// Fields Некорректные поля запроса, если он не прошёл проверку по схеме
+		Fields *[]struct {
+			// Field Путь к полю тела через точку (members.1.user_id) или имя параметра
+			Field   string `json:"field"`
+			Message string `json:"message"`
+		} `json:"fields,omitempty"`
+		Message string `json:"message"`
+
+		// RequestId X-Request-ID запроса, по нему ошибку можно найти в логах
+		RequestId *string `json:"request_id,omitempty"`
+	} `json:"error"`
+}
+
+// ErrorResponseErrorCode defines model for ErrorResponse.Error.Code.
+type ErrorResponseErrorCode string
+
+// UserId Идентификатор пользователя, без пробелов
+type UserId = string
+
+// IdempotencyKey defines model for IdempotencyKey.
+type IdempotencyKey = string
+
+// UserIdQuery Идентификатор пользователя, без пробелов
+type UserIdQuery = UserId
+
+// InternalError defines model for InternalError.
+type InternalError = ErrorResponse
+
+// ValidationError defines model for ValidationError.
+type ValidationError = ErrorResponse
+
+// GetUsersAbsencesParams defines parameters for GetUsersAbsences.
+type GetUsersAbsencesParams struct {
+	// UserId Идентификатор пользователя
+	UserId UserIdQuery `form:"user_id" json:"user_id"`
+}
+
+// PostUsersAbsencesAddJSONBody defines parameters for PostUsersAbsencesAdd.
+type PostUsersAbsencesAddJSONBody struct {
+	EndsAt         time.Time `json:"ends_at"`
+	HandOffReviews *bool     `json:"hand_off_reviews,omitempty"`
+	Reason         *string   `json:"reason,omitempty"`
+	StartsAt       time.Time `json:"starts_at"`
+
+	// UserId Идентификатор пользователя, без пробелов
+	UserId UserId `json:"user_id"`
+}
+
+// PostUsersAbsencesAddParams defines parameters for PostUsersAbsencesAdd.
+type PostUsersAbsencesAddParams struct {
+	// IdempotencyKey Ключ повторной отправки. Первый ответ (кроме 5xx) сохраняется на IDEMPOTENCY_TTL
+	// и возвращается повторным запросам того же клиента с тем же ключом, путём и телом
+	// с заголовком `Idempotent-Replayed: true`. Тот же ключ с другим запросом даёт
+	// 422 IDEMPOTENCY_KEY_REUSED, повтор во время выполнения первого запроса — 409
+	// IDEMPOTENCY_KEY_IN_PROGRESS.
+	IdempotencyKey *IdempotencyKey `json:"Idempotency-Key,omitempty"`
+}
+
+// PostUsersAbsencesDeleteJSONBody defines parameters for PostUsersAbsencesDelete.
+type PostUsersAbsencesDeleteJSONBody struct {
+	AbsenceId int64 `json:"absence_id"`
+}
+
+// PostUsersAbsencesDeleteParams defines parameters for PostUsersAbsencesDelete.
+type PostUsersAbsencesDeleteParams struct {
+	// IdempotencyKey Ключ повторной отправки. Первый ответ (кроме 5xx) сохраняется на IDEMPOTENCY_TTL
+	// и возвращается повторным запросам того же клиента с тем же ключом, путём и телом
+	// с заголовком `Idempotent-Replayed: true`. Тот же ключ с другим запросом даёт
+	// 422 IDEMPOTENCY_KEY_REUSED, повтор во время выполнения первого запроса — 409
+	// IDEMPOTENCY_KEY_IN_PROGRESS.
+	IdempotencyKey *IdempotencyKey `json:"Idempotency-Key,omitempty"`
+}
+
+// PostUsersAbsencesImportTextBody defines parameters for PostUsersAbsencesImport.
+type PostUsersAbsencesImportTextBody = string
+
+// PostUsersAbsencesImportParams defines parameters for PostUsersAbsencesImport.
+type PostUsersAbsencesImportParams struct {
+	// UserId Идентификатор пользователя
+	UserId         UserIdQuery `form:"user_id" json:"user_id"`
+	HandOffReviews *bool       `form:"hand_off_reviews,omitempty" json:"hand_off_reviews,omitempty"`
+
+	// IdempotencyKey Ключ повторной отправки. Первый ответ (кроме 5xx) сохраняется на IDEMPOTENCY_TTL
+	// и возвращается повторным запросам того же клиента с тем же ключом, путём и телом
+	// с заголовком `Idempotent-Replayed: true`. Тот же ключ с другим запросом даёт
+	// 422 IDEMPOTENCY_KEY_REUSED, повтор во время выполнения первого запроса — 409
+	// IDEMPOTENCY_KEY_IN_PROGRESS.
+	IdempotencyKey *IdempotencyKey `json:"Idempotency-Key,omitempty"`
+}
+
+// PostUsersAbsencesAddJSONRequestBody defines body for PostUsersAbsencesAdd for application/json ContentType.
+type PostUsersAbsencesAddJSONRequestBody PostUsersAbsencesAddJSONBody
+
+// PostUsersAbsencesDeleteJSONRequestBody defines body for PostUsersAbsencesDelete for application/json ContentType.
+type PostUsersAbsencesDeleteJSONRequestBody PostUsersAbsencesDeleteJSONBody
+
+// PostUsersAbsencesImportTextRequestBody defines body for PostUsersAbsencesImport for text/plain ContentType.
+type PostUsersAbsencesImportTextRequestBody = PostUsersAbsencesImportTextBody
+
+// ServerInterface represents all server handlers.
+type ServerInterface interface {
+	// Получить периоды отсутствия пользователя
+	// (GET /users/absences)
+	GetUsersAbsences(ctx echo.Context, params GetUsersAbsencesParams) error
+	// Добавить период отсутствия пользователя
+	// (POST /users/absences/add)
+	PostUsersAbsencesAdd(ctx echo.Context, params PostUsersAbsencesAddParams) error
+	// Удалить период отсутствия
+	// (POST /users/absences/delete)
+	PostUsersAbsencesDelete(ctx echo.Context, params PostUsersAbsencesDeleteParams) error
+	// Импортировать отсутствия пользователя из iCalendar (.ics)
+	// (POST /users/absences/import)
+	PostUsersAbsencesImport(ctx echo.Context, params PostUsersAbsencesImportParams) error
+}
+
+// ServerInterfaceWrapper converts echo contexts to parameters.
+type ServerInterfaceWrapper struct {
+	Handler ServerInterface
+}
+
+// GetUsersAbsences converts echo context to params.
+func (w *ServerInterfaceWrapper) GetUsersAbsences(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetUsersAbsencesParams
+	// ------------- Required query parameter "user_id" -------------
+
+	err = runtime.BindQueryParameter("form", true, true, "user_id", ctx.QueryParams(), &params.UserId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter user_id: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetUsersAbsences(ctx, params)
+	return err
+}
+
+// PostUsersAbsencesAdd converts echo context to params.
+func (w *ServerInterfaceWrapper) PostUsersAbsencesAdd(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PostUsersAbsencesAddParams
+
+	headers := ctx.Request().Header
+	// ------------- Optional header parameter "Idempotency-Key" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("Idempotency-Key")]; found {
+		var IdempotencyKey IdempotencyKey
+		n := len(valueList)
+		if n != 1 {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Expected one value for Idempotency-Key, got %d", n))
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "Idempotency-Key", valueList[0], &IdempotencyKey, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter Idempotency-Key: %s", err))
+		}
+
+		params.IdempotencyKey = &IdempotencyKey
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostUsersAbsencesAdd(ctx, params)
+	return err
+}
+
+// PostUsersAbsencesDelete converts echo context to params.
+func (w *ServerInterfaceWrapper) PostUsersAbsencesDelete(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PostUsersAbsencesDeleteParams
+
+	headers := ctx.Request().Header
+	// ------------- Optional header parameter "Idempotency-Key" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("Idempotency-Key")]; found {
+		var IdempotencyKey IdempotencyKey
+		n := len(valueList)
+		if n != 1 {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Expected one value for Idempotency-Key, got %d", n))
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "Idempotency-Key", valueList[0], &IdempotencyKey, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter Idempotency-Key: %s", err))
+		}
+
+		params.IdempotencyKey = &IdempotencyKey
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostUsersAbsencesDelete(ctx, params)
+	return err
+}
+
+// PostUsersAbsencesImport converts echo context to params.
+func (w *ServerInterfaceWrapper) PostUsersAbsencesImport(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PostUsersAbsencesImportParams
+	// ------------- Required query parameter "user_id" -------------
+
+	err = runtime.BindQueryParameter("form", true, true, "user_id", ctx.QueryParams(), &params.UserId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter user_id: %s", err))
+	}
+
+	// ------------- Optional query parameter "hand_off_reviews" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "hand_off_reviews", ctx.QueryParams(), &params.HandOffReviews)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter hand_off_reviews: %s", err))
+	}
+
+	headers := ctx.Request().Header
+	// ------------- Optional header parameter "Idempotency-Key" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("Idempotency-Key")]; found {
+		var IdempotencyKey IdempotencyKey
+		n := len(valueList)
+		if n != 1 {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Expected one value for Idempotency-Key, got %d", n))
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "Idempotency-Key", valueList[0], &IdempotencyKey, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter Idempotency-Key: %s", err))
+		}
+
+		params.IdempotencyKey = &IdempotencyKey
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostUsersAbsencesImport(ctx, params)
+	return err
+}
+
+// This is a simple interface which specifies echo.Route addition functions which
+// are present on both echo.Echo and echo.Group, since we want to allow using
+// either of them for path registration
+type EchoRouter interface {
+	CONNECT(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+	DELETE(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+	GET(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+	HEAD(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+	OPTIONS(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+	PATCH(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+	POST(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+	PUT(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+	TRACE(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+}
+
+// RegisterHandlers adds each server route to the EchoRouter.
+func RegisterHandlers(router EchoRouter, si ServerInterface) {
+	RegisterHandlersWithBaseURL(router, si, "")
+}
+
+// Registers handlers, and prepends BaseURL to the paths, so that the paths
+// can be served under a prefix.
+func RegisterHandlersWithBaseURL(router EchoRouter, si ServerInterface, baseURL string) {
+
+	wrapper := ServerInterfaceWrapper{
+		Handler: si,
+	}
+
+	router.GET(baseURL+"/users/absences", wrapper.GetUsersAbsences)
+	router.POST(baseURL+"/users/absences/add", wrapper.PostUsersAbsencesAdd)
+	router.POST(baseURL+"/users/absences/delete", wrapper.PostUsersAbsencesDelete)
+	router.POST(baseURL+"/users/absences/import", wrapper.PostUsersAbsencesImport)
+
+}
+
+type InternalErrorJSONResponse ErrorResponse
+
+type ValidationErrorJSONResponse ErrorResponse
+
+type GetUsersAbsencesRequestObject struct {
+	Params GetUsersAbsencesParams
+}
+
+type GetUsersAbsencesResponseObject interface {
+	VisitGetUsersAbsencesResponse(w http.ResponseWriter) error
+}
+
+type GetUsersAbsences200JSONResponse struct {
+	Absences []Absence `json:"absences"`
+
+	// UserId Идентификатор пользователя, без пробелов
+	UserId UserId `json:"user_id"`
+}
+
+func (response GetUsersAbsences200JSONResponse) VisitGetUsersAbsencesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetUsersAbsences400JSONResponse struct{ ValidationErrorJSONResponse }
+
+func (response GetUsersAbsences400JSONResponse) VisitGetUsersAbsencesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetUsersAbsences404JSONResponse ErrorResponse
+
+func (response GetUsersAbsences404JSONResponse) VisitGetUsersAbsencesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetUsersAbsences500JSONResponse struct{ InternalErrorJSONResponse }
+
+func (response GetUsersAbsences500JSONResponse) VisitGetUsersAbsencesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostUsersAbsencesAddRequestObject struct {
+	Params PostUsersAbsencesAddParams
+	Body   *PostUsersAbsencesAddJSONRequestBody
+}
+
+type PostUsersAbsencesAddResponseObject interface {
+	VisitPostUsersAbsencesAddResponse(w http.ResponseWriter) error
+}
+
+type PostUsersAbsencesAdd201JSONResponse struct {
+	Absence Absence `json:"absence"`
+}
+
+func (response PostUsersAbsencesAdd201JSONResponse) VisitPostUsersAbsencesAddResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostUsersAbsencesAdd400JSONResponse ErrorResponse
+
+func (response PostUsersAbsencesAdd400JSONResponse) VisitPostUsersAbsencesAddResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostUsersAbsencesAdd404JSONResponse ErrorResponse
+
+func (response PostUsersAbsencesAdd404JSONResponse) VisitPostUsersAbsencesAddResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostUsersAbsencesAdd500JSONResponse struct{ InternalErrorJSONResponse }
+
+func (response PostUsersAbsencesAdd500JSONResponse) VisitPostUsersAbsencesAddResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostUsersAbsencesDeleteRequestObject struct {
+	Params PostUsersAbsencesDeleteParams
+	Body   *PostUsersAbsencesDeleteJSONRequestBody
+}
+
+type PostUsersAbsencesDeleteResponseObject interface {
+	VisitPostUsersAbsencesDeleteResponse(w http.ResponseWriter) error
+}
+
+type PostUsersAbsencesDelete204Response struct {
+}
+
+func (response PostUsersAbsencesDelete204Response) VisitPostUsersAbsencesDeleteResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type PostUsersAbsencesDelete400JSONResponse struct{ ValidationErrorJSONResponse }
+
+func (response PostUsersAbsencesDelete400JSONResponse) VisitPostUsersAbsencesDeleteResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostUsersAbsencesDelete404JSONResponse ErrorResponse
+
+func (response PostUsersAbsencesDelete404JSONResponse) VisitPostUsersAbsencesDeleteResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostUsersAbsencesDelete500JSONResponse struct{ InternalErrorJSONResponse }
+
+func (response PostUsersAbsencesDelete500JSONResponse) VisitPostUsersAbsencesDeleteResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostUsersAbsencesImportRequestObject struct {
+	Params PostUsersAbsencesImportParams
+	Body   *PostUsersAbsencesImportTextRequestBody
+}
+
+type PostUsersAbsencesImportResponseObject interface {
+	VisitPostUsersAbsencesImportResponse(w http.ResponseWriter) error
+}
+
+type PostUsersAbsencesImport200JSONResponse struct {
+	Absences []Absence `json:"absences"`
+
+	// UserId Идентификатор пользователя, без пробелов
+	UserId UserId `json:"user_id"`
+}
+
+func (response PostUsersAbsencesImport200JSONResponse) VisitPostUsersAbsencesImportResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostUsersAbsencesImport400JSONResponse ErrorResponse
+
+func (response PostUsersAbsencesImport400JSONResponse) VisitPostUsersAbsencesImportResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostUsersAbsencesImport404JSONResponse ErrorResponse
+
+func (response PostUsersAbsencesImport404JSONResponse) VisitPostUsersAbsencesImportResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostUsersAbsencesImport500JSONResponse struct{ InternalErrorJSONResponse }
+
+func (response PostUsersAbsencesImport500JSONResponse) VisitPostUsersAbsencesImportResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+// StrictServerInterface represents all server handlers.
+type StrictServerInterface interface {
+	// Получить периоды отсутствия пользователя
+	// (GET /users/absences)
+	GetUsersAbsences(ctx context.Context, request GetUsersAbsencesRequestObject) (GetUsersAbsencesResponseObject, error)
+	// Добавить период отсутствия пользователя
+	// (POST /users/absences/add)
+	PostUsersAbsencesAdd(ctx context.Context, request PostUsersAbsencesAddRequestObject) (PostUsersAbsencesAddResponseObject, error)
+	// Удалить период отсутствия
+	// (POST /users/absences/delete)
+	PostUsersAbsencesDelete(ctx context.Context, request PostUsersAbsencesDeleteRequestObject) (PostUsersAbsencesDeleteResponseObject, error)
+	// Импортировать отсутствия пользователя из iCalendar (.ics)
+	// (POST /users/absences/import)
+	PostUsersAbsencesImport(ctx context.Context, request PostUsersAbsencesImportRequestObject) (PostUsersAbsencesImportResponseObject, error)
+}
+
+type StrictHandlerFunc = strictecho.StrictEchoHandlerFunc
+type StrictMiddlewareFunc = strictecho.StrictEchoMiddlewareFunc
+
+func NewStrictHandler(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc) ServerInterface {
+	return &strictHandler{ssi: ssi, middlewares: middlewares}
+}
+
+type strictHandler struct {
+	ssi         StrictServerInterface
+	middlewares []StrictMiddlewareFunc
+}
+
+// GetUsersAbsences operation middleware
+func (sh *strictHandler) GetUsersAbsences(ctx echo.Context, params GetUsersAbsencesParams) error {
+	var request GetUsersAbsencesRequestObject
+
+	request.Params = params
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetUsersAbsences(ctx.Request().Context(), request.(GetUsersAbsencesRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetUsersAbsences")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(GetUsersAbsencesResponseObject); ok {
+		return validResponse.VisitGetUsersAbsencesResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// PostUsersAbsencesAdd operation middleware
+func (sh *strictHandler) PostUsersAbsencesAdd(ctx echo.Context, params PostUsersAbsencesAddParams) error {
+	var request PostUsersAbsencesAddRequestObject
+
+	request.Params = params
+
+	var body PostUsersAbsencesAddJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostUsersAbsencesAdd(ctx.Request().Context(), request.(PostUsersAbsencesAddRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostUsersAbsencesAdd")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(PostUsersAbsencesAddResponseObject); ok {
+		return validResponse.VisitPostUsersAbsencesAddResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// PostUsersAbsencesDelete operation middleware
+func (sh *strictHandler) PostUsersAbsencesDelete(ctx echo.Context, params PostUsersAbsencesDeleteParams) error {
+	var request PostUsersAbsencesDeleteRequestObject
+
+	request.Params = params
+
+	var body PostUsersAbsencesDeleteJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostUsersAbsencesDelete(ctx.Request().Context(), request.(PostUsersAbsencesDeleteRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostUsersAbsencesDelete")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(PostUsersAbsencesDeleteResponseObject); ok {
+		return validResponse.VisitPostUsersAbsencesDeleteResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// PostUsersAbsencesImport operation middleware
+func (sh *strictHandler) PostUsersAbsencesImport(ctx echo.Context, params PostUsersAbsencesImportParams) error {
+	var request PostUsersAbsencesImportRequestObject
+
+	request.Params = params
+
+	data, err := io.ReadAll(ctx.Request().Body)
+	if err != nil {
+		return err
+	}
+	body := PostUsersAbsencesImportTextRequestBody(data)
+	request.Body = &body
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostUsersAbsencesImport(ctx.Request().Context(), request.(PostUsersAbsencesImportRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostUsersAbsencesImport")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(PostUsersAbsencesImportResponseObject); ok {
+		return validResponse.VisitPostUsersAbsencesImportResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
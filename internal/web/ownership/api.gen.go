@@ -0,0 +1,323 @@
+// Package ownership provides primitives to interact with the openapi HTTP API.
+//
+// Code generated by github.com/deepmap/oapi-codegen version v1.16.3 DO NOT EDIT.
+package ownership
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	"github.com/oapi-codegen/runtime"
+	strictecho "github.com/oapi-codegen/runtime/strictmiddleware/echo"
+)
+
+const (
+	BearerAuthScopes = "bearerAuth.Scopes"
+)
+
+// Defines values for ErrorResponseErrorCode.
+const (
+	BADREQUEST               ErrorResponseErrorCode = "BAD_REQUEST"
+	FORBIDDEN                ErrorResponseErrorCode = "FORBIDDEN"
+	IDEMPOTENCYKEYINPROGRESS ErrorResponseErrorCode = "IDEMPOTENCY_KEY_IN_PROGRESS"
+	IDEMPOTENCYKEYREUSED     ErrorResponseErrorCode = "IDEMPOTENCY_KEY_REUSED"
+	INTERNALERROR            ErrorResponseErrorCode = "INTERNAL_ERROR"
+	NOCANDIDATE              ErrorResponseErrorCode = "NO_CANDIDATE"
+	NOTASSIGNED              ErrorResponseErrorCode = "NOT_ASSIGNED"
+	NOTFOUND                 ErrorResponseErrorCode = "NOT_FOUND"
+	PREXISTS                 ErrorResponseErrorCode = "PR_EXISTS"
+	PRMERGED                 ErrorResponseErrorCode = "PR_MERGED"
+	RATELIMITED              ErrorResponseErrorCode = "RATE_LIMITED"
+	TEAMEXISTS               ErrorResponseErrorCode = "TEAM_EXISTS"
+	UNAUTHORIZED             ErrorResponseErrorCode = "UNAUTHORIZED"
+	VERSIONMISMATCH          ErrorResponseErrorCode = "VERSION_MISMATCH"
+)
+
+// ErrorResponse defines model for ErrorResponse.
+type ErrorResponse struct {
+	Error struct {
+		Code ErrorResponseErrorCode `json:"code"`
+
+		// Fields Некорректные поля запроса, если он не прошёл проверку по схеме
+		Fields *[]struct {
+			// Field Путь к полю тела через точку (members.1.user_id) или имя параметра
+			Field   string `json:"field"`
+			Message string `json:"message"`
+		} `json:"fields,omitempty"`
+		Message string `json:"message"`
+
+		// RequestId X-Request-ID запроса, по нему ошибку можно найти в логах
+		RequestId *string `json:"request_id,omitempty"`
+	} `json:"error"`
+}
+
+// ErrorResponseErrorCode defines model for ErrorResponse.Error.Code.
+type ErrorResponseErrorCode string
+
+// OwnershipRule defines model for OwnershipRule.
+type OwnershipRule struct {
+	OwnerTeams []string `json:"owner_teams"`
+	OwnerUsers []string `json:"owner_users"`
+	Pattern    string   `json:"pattern"`
+	Position   int      `json:"position"`
+}
+
+// IdempotencyKey defines model for IdempotencyKey.
+type IdempotencyKey = string
+
+// InternalError defines model for InternalError.
+type InternalError = ErrorResponse
+
+// PostOwnershipImportTextBody defines parameters for PostOwnershipImport.
+type PostOwnershipImportTextBody = string
+
+// PostOwnershipImportParams defines parameters for PostOwnershipImport.
+type PostOwnershipImportParams struct {
+	// IdempotencyKey Ключ повторной отправки. Первый ответ (кроме 5xx) сохраняется на IDEMPOTENCY_TTL
+	// и возвращается повторным запросам того же клиента с тем же ключом, путём и телом
+	// с заголовком `Idempotent-Replayed: true`. Тот же ключ с другим запросом даёт
+	// 422 IDEMPOTENCY_KEY_REUSED, повтор во время выполнения первого запроса — 409
+	// IDEMPOTENCY_KEY_IN_PROGRESS.
+	IdempotencyKey *IdempotencyKey `json:"Idempotency-Key,omitempty"`
+}
+
+// PostOwnershipImportTextRequestBody defines body for PostOwnershipImport for text/plain ContentType.
+type PostOwnershipImportTextRequestBody = PostOwnershipImportTextBody
+
+// ServerInterface represents all server handlers.
+type ServerInterface interface {
+	// Заменить правила владения содержимым файла CODEOWNERS
+	// (POST /ownership/import)
+	PostOwnershipImport(ctx echo.Context, params PostOwnershipImportParams) error
+	// Получить правила владения в порядке применения
+	// (GET /ownership/rules)
+	GetOwnershipRules(ctx echo.Context) error
+}
+
+// ServerInterfaceWrapper converts echo contexts to parameters.
+type ServerInterfaceWrapper struct {
+	Handler ServerInterface
+}
+
+// PostOwnershipImport converts echo context to params.
+func (w *ServerInterfaceWrapper) PostOwnershipImport(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PostOwnershipImportParams
+
+	headers := ctx.Request().Header
+	// ------------- Optional header parameter "Idempotency-Key" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("Idempotency-Key")]; found {
+		var IdempotencyKey IdempotencyKey
+		n := len(valueList)
+		if n != 1 {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Expected one value for Idempotency-Key, got %d", n))
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "Idempotency-Key", valueList[0], &IdempotencyKey, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter Idempotency-Key: %s", err))
+		}
+
+		params.IdempotencyKey = &IdempotencyKey
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostOwnershipImport(ctx, params)
+	return err
+}
+
+// GetOwnershipRules converts echo context to params.
+func (w *ServerInterfaceWrapper) GetOwnershipRules(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetOwnershipRules(ctx)
+	return err
+}
+
+// This is a simple interface which specifies echo.Route addition functions which
+// are present on both echo.Echo and echo.Group, since we want to allow using
+// either of them for path registration
+type EchoRouter interface {
+	CONNECT(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+	DELETE(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+	GET(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+	HEAD(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+	OPTIONS(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+	PATCH(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+	POST(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+	PUT(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+	TRACE(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+}
+
+// RegisterHandlers adds each server route to the EchoRouter.
+func RegisterHandlers(router EchoRouter, si ServerInterface) {
+	RegisterHandlersWithBaseURL(router, si, "")
+}
+
+// Registers handlers, and prepends BaseURL to the paths, so that the paths
+// can be served under a prefix.
+func RegisterHandlersWithBaseURL(router EchoRouter, si ServerInterface, baseURL string) {
+
+	wrapper := ServerInterfaceWrapper{
+		Handler: si,
+	}
+
+	router.POST(baseURL+"/ownership/import", wrapper.PostOwnershipImport)
+	router.GET(baseURL+"/ownership/rules", wrapper.GetOwnershipRules)
+
+}
+
+type InternalErrorJSONResponse ErrorResponse
+
+type PostOwnershipImportRequestObject struct {
+	Params PostOwnershipImportParams
+	Body   *PostOwnershipImportTextRequestBody
+}
+
+type PostOwnershipImportResponseObject interface {
+	VisitPostOwnershipImportResponse(w http.ResponseWriter) error
+}
+
+type PostOwnershipImport200JSONResponse struct {
+	Rules []OwnershipRule `json:"rules"`
+}
+
+func (response PostOwnershipImport200JSONResponse) VisitPostOwnershipImportResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostOwnershipImport400JSONResponse ErrorResponse
+
+func (response PostOwnershipImport400JSONResponse) VisitPostOwnershipImportResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostOwnershipImport500JSONResponse struct{ InternalErrorJSONResponse }
+
+func (response PostOwnershipImport500JSONResponse) VisitPostOwnershipImportResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetOwnershipRulesRequestObject struct {
+}
+
+type GetOwnershipRulesResponseObject interface {
+	VisitGetOwnershipRulesResponse(w http.ResponseWriter) error
+}
+
+type GetOwnershipRules200JSONResponse struct {
+	Rules []OwnershipRule `json:"rules"`
+}
+
+func (response GetOwnershipRules200JSONResponse) VisitGetOwnershipRulesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetOwnershipRules500JSONResponse struct{ InternalErrorJSONResponse }
+
+func (response GetOwnershipRules500JSONResponse) VisitGetOwnershipRulesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+// StrictServerInterface represents all server handlers.
+type StrictServerInterface interface {
+	// Заменить правила владения содержимым файла CODEOWNERS
+	// (POST /ownership/import)
+	PostOwnershipImport(ctx context.Context, request PostOwnershipImportRequestObject) (PostOwnershipImportResponseObject, error)
+	// Получить правила владения в порядке применения
+	// (GET /ownership/rules)
+	GetOwnershipRules(ctx context.Context, request GetOwnershipRulesRequestObject) (GetOwnershipRulesResponseObject, error)
+}
+
+type StrictHandlerFunc = strictecho.StrictEchoHandlerFunc
+type StrictMiddlewareFunc = strictecho.StrictEchoMiddlewareFunc
+
+func NewStrictHandler(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc) ServerInterface {
+	return &strictHandler{ssi: ssi, middlewares: middlewares}
+}
+
+type strictHandler struct {
+	ssi         StrictServerInterface
+	middlewares []StrictMiddlewareFunc
+}
+
+// PostOwnershipImport operation middleware
+func (sh *strictHandler) PostOwnershipImport(ctx echo.Context, params PostOwnershipImportParams) error {
+	var request PostOwnershipImportRequestObject
+
+	request.Params = params
+
+	data, err := io.ReadAll(ctx.Request().Body)
+	if err != nil {
+		return err
+	}
+	body := PostOwnershipImportTextRequestBody(data)
+	request.Body = &body
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostOwnershipImport(ctx.Request().Context(), request.(PostOwnershipImportRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostOwnershipImport")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(PostOwnershipImportResponseObject); ok {
+		return validResponse.VisitPostOwnershipImportResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// GetOwnershipRules operation middleware
+func (sh *strictHandler) GetOwnershipRules(ctx echo.Context) error {
+	var request GetOwnershipRulesRequestObject
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetOwnershipRules(ctx.Request().Context(), request.(GetOwnershipRulesRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetOwnershipRules")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(GetOwnershipRulesResponseObject); ok {
+		return validResponse.VisitGetOwnershipRulesResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
@@ -11,273 +11,1394 @@ import (
 	"time"
 
 	"github.com/labstack/echo/v4"
+	"github.com/oapi-codegen/runtime"
 	strictecho "github.com/oapi-codegen/runtime/strictmiddleware/echo"
 )
 
+const (
+	BearerAuthScopes = "bearerAuth.Scopes"
+)
+
 // Defines values for ErrorResponseErrorCode.
 const (
-	NOCANDIDATE ErrorResponseErrorCode = "NO_CANDIDATE"
-	NOTASSIGNED ErrorResponseErrorCode = "NOT_ASSIGNED"
-	NOTFOUND    ErrorResponseErrorCode = "NOT_FOUND"
-	PREXISTS    ErrorResponseErrorCode = "PR_EXISTS"
-	PRMERGED    ErrorResponseErrorCode = "PR_MERGED"
-	TEAMEXISTS  ErrorResponseErrorCode = "TEAM_EXISTS"
+	BADREQUEST               ErrorResponseErrorCode = "BAD_REQUEST"
+	FORBIDDEN                ErrorResponseErrorCode = "FORBIDDEN"
+	IDEMPOTENCYKEYINPROGRESS ErrorResponseErrorCode = "IDEMPOTENCY_KEY_IN_PROGRESS"
+	IDEMPOTENCYKEYREUSED     ErrorResponseErrorCode = "IDEMPOTENCY_KEY_REUSED"
+	INTERNALERROR            ErrorResponseErrorCode = "INTERNAL_ERROR"
+	NOCANDIDATE              ErrorResponseErrorCode = "NO_CANDIDATE"
+	NOTASSIGNED              ErrorResponseErrorCode = "NOT_ASSIGNED"
+	NOTFOUND                 ErrorResponseErrorCode = "NOT_FOUND"
+	PREXISTS                 ErrorResponseErrorCode = "PR_EXISTS"
+	PRMERGED                 ErrorResponseErrorCode = "PR_MERGED"
+	RATELIMITED              ErrorResponseErrorCode = "RATE_LIMITED"
+	TEAMEXISTS               ErrorResponseErrorCode = "TEAM_EXISTS"
+	UNAUTHORIZED             ErrorResponseErrorCode = "UNAUTHORIZED"
+	VERSIONMISMATCH          ErrorResponseErrorCode = "VERSION_MISMATCH"
+)
+
+// Defines values for Priority.
+const (
+	Low    Priority = "low"
+	Normal Priority = "normal"
+	Urgent Priority = "urgent"
 )
 
 // Defines values for PullRequestStatus.
 const (
-	MERGED PullRequestStatus = "MERGED"
-	OPEN   PullRequestStatus = "OPEN"
+	PullRequestStatusMERGED PullRequestStatus = "MERGED"
+	PullRequestStatusOPEN   PullRequestStatus = "OPEN"
+)
+
+// Defines values for Seniority.
+const (
+	Junior Seniority = "junior"
+	Senior Seniority = "senior"
+)
+
+// Defines values for GetPullRequestListParamsStatus.
+const (
+	GetPullRequestListParamsStatusMERGED GetPullRequestListParamsStatus = "MERGED"
+	GetPullRequestListParamsStatusOPEN   GetPullRequestListParamsStatus = "OPEN"
 )
 
+// AssignmentReport defines model for AssignmentReport.
+type AssignmentReport struct {
+	AssignedReviewers int `json:"assigned_reviewers"`
+
+	// CapacityLimited Назначено меньше ревьюверов, чем нужно, потому что часть кандидатов достигла лимита открытых ревью
+	CapacityLimited bool `json:"capacity_limited"`
+
+	// ExternalReviewers Ревьюверы не из команды автора (из резервных команд или общих пулов)
+	ExternalReviewers []string `json:"external_reviewers"`
+
+	// Owners Зоны владения, затронутые изменёнными файлами, и назначенный на каждую владелец
+	Owners *[]OwnedArea `json:"owners,omitempty"`
+
+	// PairingMissing Уровни, которые режим наставничества требовал, но закрыть их не удалось
+	PairingMissing     *[]Seniority `json:"pairing_missing,omitempty"`
+	RequestedReviewers int          `json:"requested_reviewers"`
+}
+
 // ErrorResponse defines model for ErrorResponse.
 type ErrorResponse struct {
 	Error struct {
-		Code    ErrorResponseErrorCode `json:"code"`
-		Message string                 `json:"message"`
+		Code ErrorResponseErrorCode `json:"code"`
+
+		// Fields Некорректные поля запроса, если он не прошёл проверку по схеме
+		Fields *[]struct {
+			// Field Путь к полю тела через точку (members.1.user_id) или имя параметра
+			Field   string `json:"field"`
+			Message string `json:"message"`
+		} `json:"fields,omitempty"`
+		Message string `json:"message"`
+
+		// RequestId X-Request-ID запроса, по нему ошибку можно найти в логах
+		RequestId *string `json:"request_id,omitempty"`
 	} `json:"error"`
 }
 
-// ErrorResponseErrorCode defines model for ErrorResponse.Error.Code.
-type ErrorResponseErrorCode string
+// ErrorResponseErrorCode defines model for ErrorResponse.Error.Code.
+type ErrorResponseErrorCode string
+
+// OwnedArea defines model for OwnedArea.
+type OwnedArea struct {
+	Pattern string `json:"pattern"`
+
+	// ReviewerId Владелец, назначенный ревьювером; null, если ни один владелец не доступен
+	ReviewerId *string `json:"reviewer_id"`
+}
+
+// Priority Приоритет PR; срочные PR получают наименее загруженные и самые быстрые ревьюверы
+type Priority string
+
+// PullRequest defines model for PullRequest.
+type PullRequest struct {
+	// Additions Добавленные строки
+	Additions *int `json:"additions,omitempty"`
+
+	// AssignedReviewers user_id назначенных ревьюверов (0..2)
+	AssignedReviewers []UserId `json:"assigned_reviewers"`
+
+	// AuthorId Идентификатор пользователя, без пробелов
+	AuthorId  UserId     `json:"author_id"`
+	CreatedAt *time.Time `json:"createdAt"`
+
+	// Deletions Удалённые строки
+	Deletions *int `json:"deletions,omitempty"`
+
+	// DueAt Срок, к которому нужно закончить ревью
+	DueAt *time.Time `json:"due_at"`
+
+	// ExcludedReviewers Пользователи, которых нельзя назначать на этот PR
+	ExcludedReviewers *[]UserId  `json:"excluded_reviewers,omitempty"`
+	Labels            *[]Tag     `json:"labels,omitempty"`
+	MergedAt          *time.Time `json:"mergedAt"`
+
+	// Priority Приоритет PR; срочные PR получают наименее загруженные и самые быстрые ревьюверы
+	Priority        *Priority       `json:"priority,omitempty"`
+	PullRequestId   PullRequestId   `json:"pull_request_id"`
+	PullRequestName PullRequestName `json:"pull_request_name"`
+
+	// Repository Репозиторий, например org/service
+	Repository   *string           `json:"repository,omitempty"`
+	SourceBranch *string           `json:"source_branch,omitempty"`
+	Status       PullRequestStatus `json:"status"`
+
+	// Tags Теги навыков, нужных для ревью
+	Tags         *[]Tag  `json:"tags,omitempty"`
+	TargetBranch *string `json:"target_branch,omitempty"`
+
+	// Url Ссылка на PR в системе контроля версий
+	Url *string `json:"url,omitempty"`
+
+	// Version Версия PR, растёт с каждым изменением; ETag — она же в кавычках
+	Version *int64 `json:"version,omitempty"`
+}
+
+// PullRequestStatus defines model for PullRequest.Status.
+type PullRequestStatus string
+
+// PullRequestId defines model for PullRequestId.
+type PullRequestId = string
+
+// PullRequestName defines model for PullRequestName.
+type PullRequestName = string
+
+// Seniority Уровень пользователя для режима наставничества
+type Seniority string
+
+// Tag Тег навыка или метка PR
+type Tag = string
+
+// UserId Идентификатор пользователя, без пробелов
+type UserId = string
+
+// IdempotencyKey defines model for IdempotencyKey.
+type IdempotencyKey = string
+
+// IfMatch defines model for IfMatch.
+type IfMatch = string
+
+// LabelQuery defines model for LabelQuery.
+type LabelQuery = string
+
+// RepositoryQuery defines model for RepositoryQuery.
+type RepositoryQuery = string
+
+// InternalError defines model for InternalError.
+type InternalError = ErrorResponse
+
+// ValidationError defines model for ValidationError.
+type ValidationError = ErrorResponse
+
+// PostPullRequestCreateJSONBody defines parameters for PostPullRequestCreate.
+type PostPullRequestCreateJSONBody struct {
+	// Additions Добавленные строки
+	Additions *int `json:"additions,omitempty"`
+
+	// AuthorId Идентификатор пользователя, без пробелов
+	AuthorId UserId `json:"author_id"`
+
+	// ChangedFiles Пути изменённых файлов относительно корня репозитория
+	ChangedFiles *[]string `json:"changed_files,omitempty"`
+
+	// Deletions Удалённые строки
+	Deletions *int `json:"deletions,omitempty"`
+
+	// DueAt Срок, к которому нужно закончить ревью
+	DueAt *time.Time `json:"due_at,omitempty"`
+
+	// ExcludeReviewers Пользователи, которых нельзя назначать на этот PR (в том числе при переназначении)
+	ExcludeReviewers *[]UserId `json:"exclude_reviewers,omitempty"`
+	Labels           *[]Tag    `json:"labels,omitempty"`
+
+	// Priority Приоритет PR; срочные PR получают наименее загруженные и самые быстрые ревьюверы
+	Priority        *Priority       `json:"priority,omitempty"`
+	PullRequestId   PullRequestId   `json:"pull_request_id"`
+	PullRequestName PullRequestName `json:"pull_request_name"`
+
+	// Repository Репозиторий, например org/service
+	Repository *string `json:"repository,omitempty"`
+
+	// RequestedReviewers Ревьюверы, выбранные автором; назначаются первыми, остальные места заполняются автоматически
+	RequestedReviewers *[]UserId `json:"requested_reviewers,omitempty"`
+	SourceBranch       *string   `json:"source_branch,omitempty"`
+
+	// Tags Теги навыков; предпочтение отдаётся ревьюверам с совпадающими навыками
+	Tags         *[]Tag  `json:"tags,omitempty"`
+	TargetBranch *string `json:"target_branch,omitempty"`
+
+	// Url Ссылка на PR в системе контроля версий
+	Url *string `json:"url,omitempty"`
+}
+
+// PostPullRequestCreateParams defines parameters for PostPullRequestCreate.
+type PostPullRequestCreateParams struct {
+	// IdempotencyKey Ключ повторной отправки. Первый ответ (кроме 5xx) сохраняется на IDEMPOTENCY_TTL
+	// и возвращается повторным запросам того же клиента с тем же ключом, путём и телом
+	// с заголовком `Idempotent-Replayed: true`. Тот же ключ с другим запросом даёт
+	// 422 IDEMPOTENCY_KEY_REUSED, повтор во время выполнения первого запроса — 409
+	// IDEMPOTENCY_KEY_IN_PROGRESS.
+	IdempotencyKey *IdempotencyKey `json:"Idempotency-Key,omitempty"`
+}
+
+// PostPullRequestDeclineJSONBody defines parameters for PostPullRequestDecline.
+type PostPullRequestDeclineJSONBody struct {
+	PullRequestId PullRequestId `json:"pull_request_id"`
+	Reason        string        `json:"reason"`
+
+	// UserId Идентификатор пользователя, без пробелов
+	UserId UserId `json:"user_id"`
+}
+
+// PostPullRequestDeclineParams defines parameters for PostPullRequestDecline.
+type PostPullRequestDeclineParams struct {
+	// IdempotencyKey Ключ повторной отправки. Первый ответ (кроме 5xx) сохраняется на IDEMPOTENCY_TTL
+	// и возвращается повторным запросам того же клиента с тем же ключом, путём и телом
+	// с заголовком `Idempotent-Replayed: true`. Тот же ключ с другим запросом даёт
+	// 422 IDEMPOTENCY_KEY_REUSED, повтор во время выполнения первого запроса — 409
+	// IDEMPOTENCY_KEY_IN_PROGRESS.
+	IdempotencyKey *IdempotencyKey `json:"Idempotency-Key,omitempty"`
+
+	// IfMatch ETag команды или PR из предыдущего ответа (или `*`). Изменение применяется, только
+	// если объект с тех пор не менялся, иначе возвращается 412 VERSION_MISMATCH.
+	IfMatch *IfMatch `json:"If-Match,omitempty"`
+}
+
+// GetPullRequestListParams defines parameters for GetPullRequestList.
+type GetPullRequestListParams struct {
+	// Repository Только PR этого репозитория
+	Repository *RepositoryQuery `form:"repository,omitempty" json:"repository,omitempty"`
+
+	// Label Только PR с этой меткой
+	Label  *LabelQuery                     `form:"label,omitempty" json:"label,omitempty"`
+	Status *GetPullRequestListParamsStatus `form:"status,omitempty" json:"status,omitempty"`
+}
+
+// GetPullRequestListParamsStatus defines parameters for GetPullRequestList.
+type GetPullRequestListParamsStatus string
+
+// PostPullRequestMergeJSONBody defines parameters for PostPullRequestMerge.
+type PostPullRequestMergeJSONBody struct {
+	PullRequestId PullRequestId `json:"pull_request_id"`
+}
+
+// PostPullRequestMergeParams defines parameters for PostPullRequestMerge.
+type PostPullRequestMergeParams struct {
+	// IdempotencyKey Ключ повторной отправки. Первый ответ (кроме 5xx) сохраняется на IDEMPOTENCY_TTL
+	// и возвращается повторным запросам того же клиента с тем же ключом, путём и телом
+	// с заголовком `Idempotent-Replayed: true`. Тот же ключ с другим запросом даёт
+	// 422 IDEMPOTENCY_KEY_REUSED, повтор во время выполнения первого запроса — 409
+	// IDEMPOTENCY_KEY_IN_PROGRESS.
+	IdempotencyKey *IdempotencyKey `json:"Idempotency-Key,omitempty"`
+
+	// IfMatch ETag команды или PR из предыдущего ответа (или `*`). Изменение применяется, только
+	// если объект с тех пор не менялся, иначе возвращается 412 VERSION_MISMATCH.
+	IfMatch *IfMatch `json:"If-Match,omitempty"`
+}
+
+// PostPullRequestReassignJSONBody defines parameters for PostPullRequestReassign.
+type PostPullRequestReassignJSONBody struct {
+	// NewUserId Идентификатор пользователя, без пробелов
+	NewUserId *UserId `json:"new_user_id,omitempty"`
+
+	// OldUserId Идентификатор пользователя, без пробелов
+	OldUserId     UserId        `json:"old_user_id"`
+	PullRequestId PullRequestId `json:"pull_request_id"`
+}
+
+// PostPullRequestReassignParams defines parameters for PostPullRequestReassign.
+type PostPullRequestReassignParams struct {
+	// IdempotencyKey Ключ повторной отправки. Первый ответ (кроме 5xx) сохраняется на IDEMPOTENCY_TTL
+	// и возвращается повторным запросам того же клиента с тем же ключом, путём и телом
+	// с заголовком `Idempotent-Replayed: true`. Тот же ключ с другим запросом даёт
+	// 422 IDEMPOTENCY_KEY_REUSED, повтор во время выполнения первого запроса — 409
+	// IDEMPOTENCY_KEY_IN_PROGRESS.
+	IdempotencyKey *IdempotencyKey `json:"Idempotency-Key,omitempty"`
+
+	// IfMatch ETag команды или PR из предыдущего ответа (или `*`). Изменение применяется, только
+	// если объект с тех пор не менялся, иначе возвращается 412 VERSION_MISMATCH.
+	IfMatch *IfMatch `json:"If-Match,omitempty"`
+}
+
+// PostPullRequestReviewersAddJSONBody defines parameters for PostPullRequestReviewersAdd.
+type PostPullRequestReviewersAddJSONBody struct {
+	PullRequestId PullRequestId `json:"pull_request_id"`
+
+	// UserId Идентификатор пользователя, без пробелов
+	UserId UserId `json:"user_id"`
+}
+
+// PostPullRequestReviewersAddParams defines parameters for PostPullRequestReviewersAdd.
+type PostPullRequestReviewersAddParams struct {
+	// IdempotencyKey Ключ повторной отправки. Первый ответ (кроме 5xx) сохраняется на IDEMPOTENCY_TTL
+	// и возвращается повторным запросам того же клиента с тем же ключом, путём и телом
+	// с заголовком `Idempotent-Replayed: true`. Тот же ключ с другим запросом даёт
+	// 422 IDEMPOTENCY_KEY_REUSED, повтор во время выполнения первого запроса — 409
+	// IDEMPOTENCY_KEY_IN_PROGRESS.
+	IdempotencyKey *IdempotencyKey `json:"Idempotency-Key,omitempty"`
+
+	// IfMatch ETag команды или PR из предыдущего ответа (или `*`). Изменение применяется, только
+	// если объект с тех пор не менялся, иначе возвращается 412 VERSION_MISMATCH.
+	IfMatch *IfMatch `json:"If-Match,omitempty"`
+}
+
+// PostPullRequestReviewersRemoveJSONBody defines parameters for PostPullRequestReviewersRemove.
+type PostPullRequestReviewersRemoveJSONBody struct {
+	PullRequestId PullRequestId `json:"pull_request_id"`
+
+	// UserId Идентификатор пользователя, без пробелов
+	UserId UserId `json:"user_id"`
+}
+
+// PostPullRequestReviewersRemoveParams defines parameters for PostPullRequestReviewersRemove.
+type PostPullRequestReviewersRemoveParams struct {
+	// IdempotencyKey Ключ повторной отправки. Первый ответ (кроме 5xx) сохраняется на IDEMPOTENCY_TTL
+	// и возвращается повторным запросам того же клиента с тем же ключом, путём и телом
+	// с заголовком `Idempotent-Replayed: true`. Тот же ключ с другим запросом даёт
+	// 422 IDEMPOTENCY_KEY_REUSED, повтор во время выполнения первого запроса — 409
+	// IDEMPOTENCY_KEY_IN_PROGRESS.
+	IdempotencyKey *IdempotencyKey `json:"Idempotency-Key,omitempty"`
+
+	// IfMatch ETag команды или PR из предыдущего ответа (или `*`). Изменение применяется, только
+	// если объект с тех пор не менялся, иначе возвращается 412 VERSION_MISMATCH.
+	IfMatch *IfMatch `json:"If-Match,omitempty"`
+}
+
+// PostPullRequestUpdateJSONBody defines parameters for PostPullRequestUpdate.
+type PostPullRequestUpdateJSONBody struct {
+	// Additions Добавленные строки
+	Additions *int `json:"additions,omitempty"`
+
+	// Deletions Удалённые строки
+	Deletions     *int          `json:"deletions,omitempty"`
+	Labels        *[]Tag        `json:"labels,omitempty"`
+	PullRequestId PullRequestId `json:"pull_request_id"`
+
+	// Repository Репозиторий, например org/service
+	Repository   *string `json:"repository,omitempty"`
+	SourceBranch *string `json:"source_branch,omitempty"`
+	TargetBranch *string `json:"target_branch,omitempty"`
+
+	// Url Ссылка на PR в системе контроля версий
+	Url *string `json:"url,omitempty"`
+}
+
+// PostPullRequestUpdateParams defines parameters for PostPullRequestUpdate.
+type PostPullRequestUpdateParams struct {
+	// IdempotencyKey Ключ повторной отправки. Первый ответ (кроме 5xx) сохраняется на IDEMPOTENCY_TTL
+	// и возвращается повторным запросам того же клиента с тем же ключом, путём и телом
+	// с заголовком `Idempotent-Replayed: true`. Тот же ключ с другим запросом даёт
+	// 422 IDEMPOTENCY_KEY_REUSED, повтор во время выполнения первого запроса — 409
+	// IDEMPOTENCY_KEY_IN_PROGRESS.
+	IdempotencyKey *IdempotencyKey `json:"Idempotency-Key,omitempty"`
+
+	// IfMatch ETag команды или PR из предыдущего ответа (или `*`). Изменение применяется, только
+	// если объект с тех пор не менялся, иначе возвращается 412 VERSION_MISMATCH.
+	IfMatch *IfMatch `json:"If-Match,omitempty"`
+}
+
+// PostPullRequestCreateJSONRequestBody defines body for PostPullRequestCreate for application/json ContentType.
+type PostPullRequestCreateJSONRequestBody PostPullRequestCreateJSONBody
+
+// PostPullRequestDeclineJSONRequestBody defines body for PostPullRequestDecline for application/json ContentType.
+type PostPullRequestDeclineJSONRequestBody PostPullRequestDeclineJSONBody
+
+// PostPullRequestMergeJSONRequestBody defines body for PostPullRequestMerge for application/json ContentType.
+type PostPullRequestMergeJSONRequestBody PostPullRequestMergeJSONBody
+
+// PostPullRequestReassignJSONRequestBody defines body for PostPullRequestReassign for application/json ContentType.
+type PostPullRequestReassignJSONRequestBody PostPullRequestReassignJSONBody
+
+// PostPullRequestReviewersAddJSONRequestBody defines body for PostPullRequestReviewersAdd for application/json ContentType.
+type PostPullRequestReviewersAddJSONRequestBody PostPullRequestReviewersAddJSONBody
+
+// PostPullRequestReviewersRemoveJSONRequestBody defines body for PostPullRequestReviewersRemove for application/json ContentType.
+type PostPullRequestReviewersRemoveJSONRequestBody PostPullRequestReviewersRemoveJSONBody
+
+// PostPullRequestUpdateJSONRequestBody defines body for PostPullRequestUpdate for application/json ContentType.
+type PostPullRequestUpdateJSONRequestBody PostPullRequestUpdateJSONBody
+
+// ServerInterface represents all server handlers.
+type ServerInterface interface {
+	// Создать PR и автоматически назначить ревьюверов из команды автора
+	// (POST /pullRequest/create)
+	PostPullRequestCreate(ctx echo.Context, params PostPullRequestCreateParams) error
+	// Отказаться от ревью с автоматической заменой
+	// (POST /pullRequest/decline)
+	PostPullRequestDecline(ctx echo.Context, params PostPullRequestDeclineParams) error
+	// Список PR с фильтрами по репозиторию, метке и статусу
+	// (GET /pullRequest/list)
+	GetPullRequestList(ctx echo.Context, params GetPullRequestListParams) error
+	// Пометить PR как MERGED (идемпотентная операция)
+	// (POST /pullRequest/merge)
+	PostPullRequestMerge(ctx echo.Context, params PostPullRequestMergeParams) error
+	// Переназначить конкретного ревьювера на другого из его команды
+	// (POST /pullRequest/reassign)
+	PostPullRequestReassign(ctx echo.Context, params PostPullRequestReassignParams) error
+	// Добавить ревьювера на открытый PR вручную
+	// (POST /pullRequest/reviewers/add)
+	PostPullRequestReviewersAdd(ctx echo.Context, params PostPullRequestReviewersAddParams) error
+	// Снять ревьювера с открытого PR без замены
+	// (POST /pullRequest/reviewers/remove)
+	PostPullRequestReviewersRemove(ctx echo.Context, params PostPullRequestReviewersRemoveParams) error
+	// Изменить метаданные PR
+	// (POST /pullRequest/update)
+	PostPullRequestUpdate(ctx echo.Context, params PostPullRequestUpdateParams) error
+}
+
+// ServerInterfaceWrapper converts echo contexts to parameters.
+type ServerInterfaceWrapper struct {
+	Handler ServerInterface
+}
+
+// PostPullRequestCreate converts echo context to params.
+func (w *ServerInterfaceWrapper) PostPullRequestCreate(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PostPullRequestCreateParams
+
+	headers := ctx.Request().Header
+	// ------------- Optional header parameter "Idempotency-Key" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("Idempotency-Key")]; found {
+		var IdempotencyKey IdempotencyKey
+		n := len(valueList)
+		if n != 1 {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Expected one value for Idempotency-Key, got %d", n))
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "Idempotency-Key", valueList[0], &IdempotencyKey, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter Idempotency-Key: %s", err))
+		}
+
+		params.IdempotencyKey = &IdempotencyKey
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostPullRequestCreate(ctx, params)
+	return err
+}
+
+// PostPullRequestDecline converts echo context to params.
+func (w *ServerInterfaceWrapper) PostPullRequestDecline(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PostPullRequestDeclineParams
+
+	headers := ctx.Request().Header
+	// ------------- Optional header parameter "Idempotency-Key" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("Idempotency-Key")]; found {
+		var IdempotencyKey IdempotencyKey
+		n := len(valueList)
+		if n != 1 {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Expected one value for Idempotency-Key, got %d", n))
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "Idempotency-Key", valueList[0], &IdempotencyKey, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter Idempotency-Key: %s", err))
+		}
+
+		params.IdempotencyKey = &IdempotencyKey
+	}
+	// ------------- Optional header parameter "If-Match" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-Match")]; found {
+		var IfMatch IfMatch
+		n := len(valueList)
+		if n != 1 {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Expected one value for If-Match, got %d", n))
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "If-Match", valueList[0], &IfMatch, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter If-Match: %s", err))
+		}
+
+		params.IfMatch = &IfMatch
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostPullRequestDecline(ctx, params)
+	return err
+}
+
+// GetPullRequestList converts echo context to params.
+func (w *ServerInterfaceWrapper) GetPullRequestList(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetPullRequestListParams
+	// ------------- Optional query parameter "repository" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "repository", ctx.QueryParams(), &params.Repository)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter repository: %s", err))
+	}
+
+	// ------------- Optional query parameter "label" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "label", ctx.QueryParams(), &params.Label)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter label: %s", err))
+	}
+
+	// ------------- Optional query parameter "status" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "status", ctx.QueryParams(), &params.Status)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter status: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetPullRequestList(ctx, params)
+	return err
+}
+
+// PostPullRequestMerge converts echo context to params.
+func (w *ServerInterfaceWrapper) PostPullRequestMerge(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PostPullRequestMergeParams
+
+	headers := ctx.Request().Header
+	// ------------- Optional header parameter "Idempotency-Key" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("Idempotency-Key")]; found {
+		var IdempotencyKey IdempotencyKey
+		n := len(valueList)
+		if n != 1 {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Expected one value for Idempotency-Key, got %d", n))
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "Idempotency-Key", valueList[0], &IdempotencyKey, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter Idempotency-Key: %s", err))
+		}
+
+		params.IdempotencyKey = &IdempotencyKey
+	}
+	// ------------- Optional header parameter "If-Match" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-Match")]; found {
+		var IfMatch IfMatch
+		n := len(valueList)
+		if n != 1 {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Expected one value for If-Match, got %d", n))
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "If-Match", valueList[0], &IfMatch, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter If-Match: %s", err))
+		}
+
+		params.IfMatch = &IfMatch
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostPullRequestMerge(ctx, params)
+	return err
+}
+
+// PostPullRequestReassign converts echo context to params.
+func (w *ServerInterfaceWrapper) PostPullRequestReassign(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PostPullRequestReassignParams
+
+	headers := ctx.Request().Header
+	// ------------- Optional header parameter "Idempotency-Key" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("Idempotency-Key")]; found {
+		var IdempotencyKey IdempotencyKey
+		n := len(valueList)
+		if n != 1 {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Expected one value for Idempotency-Key, got %d", n))
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "Idempotency-Key", valueList[0], &IdempotencyKey, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter Idempotency-Key: %s", err))
+		}
+
+		params.IdempotencyKey = &IdempotencyKey
+	}
+	// ------------- Optional header parameter "If-Match" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-Match")]; found {
+		var IfMatch IfMatch
+		n := len(valueList)
+		if n != 1 {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Expected one value for If-Match, got %d", n))
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "If-Match", valueList[0], &IfMatch, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter If-Match: %s", err))
+		}
+
+		params.IfMatch = &IfMatch
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostPullRequestReassign(ctx, params)
+	return err
+}
+
+// PostPullRequestReviewersAdd converts echo context to params.
+func (w *ServerInterfaceWrapper) PostPullRequestReviewersAdd(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PostPullRequestReviewersAddParams
+
+	headers := ctx.Request().Header
+	// ------------- Optional header parameter "Idempotency-Key" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("Idempotency-Key")]; found {
+		var IdempotencyKey IdempotencyKey
+		n := len(valueList)
+		if n != 1 {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Expected one value for Idempotency-Key, got %d", n))
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "Idempotency-Key", valueList[0], &IdempotencyKey, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter Idempotency-Key: %s", err))
+		}
+
+		params.IdempotencyKey = &IdempotencyKey
+	}
+	// ------------- Optional header parameter "If-Match" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-Match")]; found {
+		var IfMatch IfMatch
+		n := len(valueList)
+		if n != 1 {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Expected one value for If-Match, got %d", n))
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "If-Match", valueList[0], &IfMatch, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter If-Match: %s", err))
+		}
+
+		params.IfMatch = &IfMatch
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostPullRequestReviewersAdd(ctx, params)
+	return err
+}
+
+// PostPullRequestReviewersRemove converts echo context to params.
+func (w *ServerInterfaceWrapper) PostPullRequestReviewersRemove(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PostPullRequestReviewersRemoveParams
+
+	headers := ctx.Request().Header
+	// ------------- Optional header parameter "Idempotency-Key" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("Idempotency-Key")]; found {
+		var IdempotencyKey IdempotencyKey
+		n := len(valueList)
+		if n != 1 {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Expected one value for Idempotency-Key, got %d", n))
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "Idempotency-Key", valueList[0], &IdempotencyKey, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter Idempotency-Key: %s", err))
+		}
+
+		params.IdempotencyKey = &IdempotencyKey
+	}
+	// ------------- Optional header parameter "If-Match" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-Match")]; found {
+		var IfMatch IfMatch
+		n := len(valueList)
+		if n != 1 {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Expected one value for If-Match, got %d", n))
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "If-Match", valueList[0], &IfMatch, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter If-Match: %s", err))
+		}
+
+		params.IfMatch = &IfMatch
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostPullRequestReviewersRemove(ctx, params)
+	return err
+}
+
+// PostPullRequestUpdate converts echo context to params.
+func (w *ServerInterfaceWrapper) PostPullRequestUpdate(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PostPullRequestUpdateParams
+
+	headers := ctx.Request().Header
+	// ------------- Optional header parameter "Idempotency-Key" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("Idempotency-Key")]; found {
+		var IdempotencyKey IdempotencyKey
+		n := len(valueList)
+		if n != 1 {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Expected one value for Idempotency-Key, got %d", n))
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "Idempotency-Key", valueList[0], &IdempotencyKey, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter Idempotency-Key: %s", err))
+		}
+
+		params.IdempotencyKey = &IdempotencyKey
+	}
+	// ------------- Optional header parameter "If-Match" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-Match")]; found {
+		var IfMatch IfMatch
+		n := len(valueList)
+		if n != 1 {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Expected one value for If-Match, got %d", n))
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "If-Match", valueList[0], &IfMatch, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter If-Match: %s", err))
+		}
+
+		params.IfMatch = &IfMatch
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostPullRequestUpdate(ctx, params)
+	return err
+}
+
+// This is a simple interface which specifies echo.Route addition functions which
+// are present on both echo.Echo and echo.Group, since we want to allow using
+// either of them for path registration
+type EchoRouter interface {
+	CONNECT(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+	DELETE(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+	GET(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+	HEAD(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+	OPTIONS(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+	PATCH(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+	POST(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+	PUT(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+	TRACE(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+}
+
+// RegisterHandlers adds each server route to the EchoRouter.
+func RegisterHandlers(router EchoRouter, si ServerInterface) {
+	RegisterHandlersWithBaseURL(router, si, "")
+}
+
+// Registers handlers, and prepends BaseURL to the paths, so that the paths
+// can be served under a prefix.
+func RegisterHandlersWithBaseURL(router EchoRouter, si ServerInterface, baseURL string) {
+
+	wrapper := ServerInterfaceWrapper{
+		Handler: si,
+	}
+
+	router.POST(baseURL+"/pullRequest/create", wrapper.PostPullRequestCreate)
+	router.POST(baseURL+"/pullRequest/decline", wrapper.PostPullRequestDecline)
+	router.GET(baseURL+"/pullRequest/list", wrapper.GetPullRequestList)
+	router.POST(baseURL+"/pullRequest/merge", wrapper.PostPullRequestMerge)
+	router.POST(baseURL+"/pullRequest/reassign", wrapper.PostPullRequestReassign)
+	router.POST(baseURL+"/pullRequest/reviewers/add", wrapper.PostPullRequestReviewersAdd)
+	router.POST(baseURL+"/pullRequest/reviewers/remove", wrapper.PostPullRequestReviewersRemove)
+	router.POST(baseURL+"/pullRequest/update", wrapper.PostPullRequestUpdate)
+
+}
+
+type InternalErrorJSONResponse ErrorResponse
+
+type ValidationErrorJSONResponse ErrorResponse
+
+type PostPullRequestCreateRequestObject struct {
+	Params PostPullRequestCreateParams
+	Body   *PostPullRequestCreateJSONRequestBody
+}
+
+type PostPullRequestCreateResponseObject interface {
+	VisitPostPullRequestCreateResponse(w http.ResponseWriter) error
+}
+
+type PostPullRequestCreate201ResponseHeaders struct {
+	ETag string
+}
+
+type PostPullRequestCreate201JSONResponse struct {
+	Body struct {
+		Assignment *AssignmentReport `json:"assignment,omitempty"`
+		Pr         *PullRequest      `json:"pr,omitempty"`
+	}
+	Headers PostPullRequestCreate201ResponseHeaders
+}
+
+func (response PostPullRequestCreate201JSONResponse) VisitPostPullRequestCreateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("ETag", fmt.Sprint(response.Headers.ETag))
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type PostPullRequestCreate400JSONResponse ErrorResponse
+
+func (response PostPullRequestCreate400JSONResponse) VisitPostPullRequestCreateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPullRequestCreate404JSONResponse ErrorResponse
+
+func (response PostPullRequestCreate404JSONResponse) VisitPostPullRequestCreateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPullRequestCreate409JSONResponse ErrorResponse
+
+func (response PostPullRequestCreate409JSONResponse) VisitPostPullRequestCreateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPullRequestCreate500JSONResponse struct{ InternalErrorJSONResponse }
+
+func (response PostPullRequestCreate500JSONResponse) VisitPostPullRequestCreateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPullRequestDeclineRequestObject struct {
+	Params PostPullRequestDeclineParams
+	Body   *PostPullRequestDeclineJSONRequestBody
+}
+
+type PostPullRequestDeclineResponseObject interface {
+	VisitPostPullRequestDeclineResponse(w http.ResponseWriter) error
+}
+
+type PostPullRequestDecline200ResponseHeaders struct {
+	ETag string
+}
+
+type PostPullRequestDecline200JSONResponse struct {
+	Body struct {
+		Pr PullRequest `json:"pr"`
+
+		// ReplacedBy user_id нового ревьювера или null, если замены не нашлось
+		ReplacedBy *string `json:"replaced_by"`
+
+		// ReplacedByExternal Новый ревьювер не из команды автора
+		ReplacedByExternal *bool `json:"replaced_by_external,omitempty"`
+	}
+	Headers PostPullRequestDecline200ResponseHeaders
+}
+
+func (response PostPullRequestDecline200JSONResponse) VisitPostPullRequestDeclineResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("ETag", fmt.Sprint(response.Headers.ETag))
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type PostPullRequestDecline400JSONResponse ErrorResponse
+
+func (response PostPullRequestDecline400JSONResponse) VisitPostPullRequestDeclineResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPullRequestDecline404JSONResponse ErrorResponse
+
+func (response PostPullRequestDecline404JSONResponse) VisitPostPullRequestDeclineResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPullRequestDecline409JSONResponse ErrorResponse
+
+func (response PostPullRequestDecline409JSONResponse) VisitPostPullRequestDeclineResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPullRequestDecline412JSONResponse ErrorResponse
+
+func (response PostPullRequestDecline412JSONResponse) VisitPostPullRequestDeclineResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(412)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPullRequestDecline500JSONResponse struct{ InternalErrorJSONResponse }
+
+func (response PostPullRequestDecline500JSONResponse) VisitPostPullRequestDeclineResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetPullRequestListRequestObject struct {
+	Params GetPullRequestListParams
+}
+
+type GetPullRequestListResponseObject interface {
+	VisitGetPullRequestListResponse(w http.ResponseWriter) error
+}
+
+type GetPullRequestList200JSONResponse struct {
+	PullRequests []PullRequest `json:"pull_requests"`
+}
+
+func (response GetPullRequestList200JSONResponse) VisitGetPullRequestListResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetPullRequestList400JSONResponse struct{ ValidationErrorJSONResponse }
+
+func (response GetPullRequestList400JSONResponse) VisitGetPullRequestListResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetPullRequestList500JSONResponse struct{ InternalErrorJSONResponse }
+
+func (response GetPullRequestList500JSONResponse) VisitGetPullRequestListResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPullRequestMergeRequestObject struct {
+	Params PostPullRequestMergeParams
+	Body   *PostPullRequestMergeJSONRequestBody
+}
+
+type PostPullRequestMergeResponseObject interface {
+	VisitPostPullRequestMergeResponse(w http.ResponseWriter) error
+}
+
+type PostPullRequestMerge200ResponseHeaders struct {
+	ETag string
+}
+
+type PostPullRequestMerge200JSONResponse struct {
+	Body struct {
+		Pr *PullRequest `json:"pr,omitempty"`
+	}
+	Headers PostPullRequestMerge200ResponseHeaders
+}
+
+func (response PostPullRequestMerge200JSONResponse) VisitPostPullRequestMergeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("ETag", fmt.Sprint(response.Headers.ETag))
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type PostPullRequestMerge400JSONResponse struct{ ValidationErrorJSONResponse }
+
+func (response PostPullRequestMerge400JSONResponse) VisitPostPullRequestMergeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPullRequestMerge404JSONResponse ErrorResponse
+
+func (response PostPullRequestMerge404JSONResponse) VisitPostPullRequestMergeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPullRequestMerge412JSONResponse ErrorResponse
+
+func (response PostPullRequestMerge412JSONResponse) VisitPostPullRequestMergeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(412)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPullRequestMerge500JSONResponse struct{ InternalErrorJSONResponse }
+
+func (response PostPullRequestMerge500JSONResponse) VisitPostPullRequestMergeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPullRequestReassignRequestObject struct {
+	Params PostPullRequestReassignParams
+	Body   *PostPullRequestReassignJSONRequestBody
+}
+
+type PostPullRequestReassignResponseObject interface {
+	VisitPostPullRequestReassignResponse(w http.ResponseWriter) error
+}
+
+type PostPullRequestReassign200ResponseHeaders struct {
+	ETag string
+}
+
+type PostPullRequestReassign200JSONResponse struct {
+	Body struct {
+		// PairingBroken В команде режим наставничества, но новый ревьювер другого уровня
+		PairingBroken *bool       `json:"pairing_broken,omitempty"`
+		Pr            PullRequest `json:"pr"`
+
+		// ReplacedBy user_id нового ревьювера
+		ReplacedBy string `json:"replaced_by"`
+
+		// ReplacedByExternal Новый ревьювер не из команды автора
+		ReplacedByExternal *bool `json:"replaced_by_external,omitempty"`
+	}
+	Headers PostPullRequestReassign200ResponseHeaders
+}
+
+func (response PostPullRequestReassign200JSONResponse) VisitPostPullRequestReassignResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("ETag", fmt.Sprint(response.Headers.ETag))
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type PostPullRequestReassign400JSONResponse ErrorResponse
+
+func (response PostPullRequestReassign400JSONResponse) VisitPostPullRequestReassignResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPullRequestReassign404JSONResponse ErrorResponse
+
+func (response PostPullRequestReassign404JSONResponse) VisitPostPullRequestReassignResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPullRequestReassign409JSONResponse ErrorResponse
+
+func (response PostPullRequestReassign409JSONResponse) VisitPostPullRequestReassignResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPullRequestReassign412JSONResponse ErrorResponse
+
+func (response PostPullRequestReassign412JSONResponse) VisitPostPullRequestReassignResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(412)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPullRequestReassign500JSONResponse struct{ InternalErrorJSONResponse }
 
-// PullRequest defines model for PullRequest.
-type PullRequest struct {
-	// AssignedReviewers user_id назначенных ревьюверов (0..2)
-	AssignedReviewers []string          `json:"assigned_reviewers"`
-	AuthorId          string            `json:"author_id"`
-	CreatedAt         *time.Time        `json:"createdAt"`
-	MergedAt          *time.Time        `json:"mergedAt"`
-	PullRequestId     string            `json:"pull_request_id"`
-	PullRequestName   string            `json:"pull_request_name"`
-	Status            PullRequestStatus `json:"status"`
+func (response PostPullRequestReassign500JSONResponse) VisitPostPullRequestReassignResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-// PullRequestStatus defines model for PullRequest.Status.
-type PullRequestStatus string
+type PostPullRequestReviewersAddRequestObject struct {
+	Params PostPullRequestReviewersAddParams
+	Body   *PostPullRequestReviewersAddJSONRequestBody
+}
 
-// PostPullRequestCreateJSONBody defines parameters for PostPullRequestCreate.
-type PostPullRequestCreateJSONBody struct {
-	AuthorId        string `json:"author_id"`
-	PullRequestId   string `json:"pull_request_id"`
-	PullRequestName string `json:"pull_request_name"`
+type PostPullRequestReviewersAddResponseObject interface {
+	VisitPostPullRequestReviewersAddResponse(w http.ResponseWriter) error
 }
 
-// PostPullRequestMergeJSONBody defines parameters for PostPullRequestMerge.
-type PostPullRequestMergeJSONBody struct {
-	PullRequestId string `json:"pull_request_id"`
+type PostPullRequestReviewersAdd200ResponseHeaders struct {
+	ETag string
 }
 
-// PostPullRequestReassignJSONBody defines parameters for PostPullRequestReassign.
-type PostPullRequestReassignJSONBody struct {
-	OldUserId     string `json:"old_user_id"`
-	PullRequestId string `json:"pull_request_id"`
+type PostPullRequestReviewersAdd200JSONResponse struct {
+	Body struct {
+		Pr PullRequest `json:"pr"`
+	}
+	Headers PostPullRequestReviewersAdd200ResponseHeaders
 }
 
-// PostPullRequestCreateJSONRequestBody defines body for PostPullRequestCreate for application/json ContentType.
-type PostPullRequestCreateJSONRequestBody PostPullRequestCreateJSONBody
+func (response PostPullRequestReviewersAdd200JSONResponse) VisitPostPullRequestReviewersAddResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("ETag", fmt.Sprint(response.Headers.ETag))
+	w.WriteHeader(200)
 
-// PostPullRequestMergeJSONRequestBody defines body for PostPullRequestMerge for application/json ContentType.
-type PostPullRequestMergeJSONRequestBody PostPullRequestMergeJSONBody
+	return json.NewEncoder(w).Encode(response.Body)
+}
 
-// PostPullRequestReassignJSONRequestBody defines body for PostPullRequestReassign for application/json ContentType.
-type PostPullRequestReassignJSONRequestBody PostPullRequestReassignJSONBody
+type PostPullRequestReviewersAdd400JSONResponse ErrorResponse
 
-// ServerInterface represents all server handlers.
-type ServerInterface interface {
-	// Создать PR и автоматически назначить до 2 ревьюверов из команды автора
-	// (POST /pullRequest/create)
-	PostPullRequestCreate(ctx echo.Context) error
-	// Пометить PR как MERGED (идемпотентная операция)
-	// (POST /pullRequest/merge)
-	PostPullRequestMerge(ctx echo.Context) error
-	// Переназначить конкретного ревьювера на другого из его команды
-	// (POST /pullRequest/reassign)
-	PostPullRequestReassign(ctx echo.Context) error
-}
+func (response PostPullRequestReviewersAdd400JSONResponse) VisitPostPullRequestReviewersAddResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
 
-// ServerInterfaceWrapper converts echo contexts to parameters.
-type ServerInterfaceWrapper struct {
-	Handler ServerInterface
+	return json.NewEncoder(w).Encode(response)
 }
 
-// PostPullRequestCreate converts echo context to params.
-func (w *ServerInterfaceWrapper) PostPullRequestCreate(ctx echo.Context) error {
-	var err error
+type PostPullRequestReviewersAdd404JSONResponse ErrorResponse
 
-	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.PostPullRequestCreate(ctx)
-	return err
+func (response PostPullRequestReviewersAdd404JSONResponse) VisitPostPullRequestReviewersAddResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-// PostPullRequestMerge converts echo context to params.
-func (w *ServerInterfaceWrapper) PostPullRequestMerge(ctx echo.Context) error {
-	var err error
+type PostPullRequestReviewersAdd409JSONResponse ErrorResponse
 
-	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.PostPullRequestMerge(ctx)
-	return err
+func (response PostPullRequestReviewersAdd409JSONResponse) VisitPostPullRequestReviewersAddResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-// PostPullRequestReassign converts echo context to params.
-func (w *ServerInterfaceWrapper) PostPullRequestReassign(ctx echo.Context) error {
-	var err error
+type PostPullRequestReviewersAdd412JSONResponse ErrorResponse
 
-	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.PostPullRequestReassign(ctx)
-	return err
-}
+func (response PostPullRequestReviewersAdd412JSONResponse) VisitPostPullRequestReviewersAddResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(412)
 
-// This is a simple interface which specifies echo.Route addition functions which
-// are present on both echo.Echo and echo.Group, since we want to allow using
-// either of them for path registration
-type EchoRouter interface {
-	CONNECT(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
-	DELETE(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
-	GET(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
-	HEAD(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
-	OPTIONS(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
-	PATCH(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
-	POST(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
-	PUT(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
-	TRACE(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+	return json.NewEncoder(w).Encode(response)
 }
 
-// RegisterHandlers adds each server route to the EchoRouter.
-func RegisterHandlers(router EchoRouter, si ServerInterface) {
-	RegisterHandlersWithBaseURL(router, si, "")
-}
+type PostPullRequestReviewersAdd500JSONResponse struct{ InternalErrorJSONResponse }
 
-// Registers handlers, and prepends BaseURL to the paths, so that the paths
-// can be served under a prefix.
-func RegisterHandlersWithBaseURL(router EchoRouter, si ServerInterface, baseURL string) {
+func (response PostPullRequestReviewersAdd500JSONResponse) VisitPostPullRequestReviewersAddResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
 
-	wrapper := ServerInterfaceWrapper{
-		Handler: si,
-	}
+	return json.NewEncoder(w).Encode(response)
+}
 
-	router.POST(baseURL+"/pullRequest/create", wrapper.PostPullRequestCreate)
-	router.POST(baseURL+"/pullRequest/merge", wrapper.PostPullRequestMerge)
-	router.POST(baseURL+"/pullRequest/reassign", wrapper.PostPullRequestReassign)
+type PostPullRequestReviewersRemoveRequestObject struct {
+	Params PostPullRequestReviewersRemoveParams
+	Body   *PostPullRequestReviewersRemoveJSONRequestBody
+}
 
+type PostPullRequestReviewersRemoveResponseObject interface {
+	VisitPostPullRequestReviewersRemoveResponse(w http.ResponseWriter) error
 }
 
-type PostPullRequestCreateRequestObject struct {
-	Body *PostPullRequestCreateJSONRequestBody
+type PostPullRequestReviewersRemove200ResponseHeaders struct {
+	ETag string
 }
 
-type PostPullRequestCreateResponseObject interface {
-	VisitPostPullRequestCreateResponse(w http.ResponseWriter) error
+type PostPullRequestReviewersRemove200JSONResponse struct {
+	Body struct {
+		Pr PullRequest `json:"pr"`
+	}
+	Headers PostPullRequestReviewersRemove200ResponseHeaders
 }
 
-type PostPullRequestCreate201JSONResponse struct {
-	Pr *PullRequest `json:"pr,omitempty"`
+func (response PostPullRequestReviewersRemove200JSONResponse) VisitPostPullRequestReviewersRemoveResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("ETag", fmt.Sprint(response.Headers.ETag))
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response.Body)
 }
 
-func (response PostPullRequestCreate201JSONResponse) VisitPostPullRequestCreateResponse(w http.ResponseWriter) error {
+type PostPullRequestReviewersRemove400JSONResponse struct{ ValidationErrorJSONResponse }
+
+func (response PostPullRequestReviewersRemove400JSONResponse) VisitPostPullRequestReviewersRemoveResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(201)
+	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type PostPullRequestCreate404JSONResponse ErrorResponse
+type PostPullRequestReviewersRemove404JSONResponse ErrorResponse
 
-func (response PostPullRequestCreate404JSONResponse) VisitPostPullRequestCreateResponse(w http.ResponseWriter) error {
+func (response PostPullRequestReviewersRemove404JSONResponse) VisitPostPullRequestReviewersRemoveResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(404)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type PostPullRequestCreate409JSONResponse ErrorResponse
+type PostPullRequestReviewersRemove409JSONResponse ErrorResponse
 
-func (response PostPullRequestCreate409JSONResponse) VisitPostPullRequestCreateResponse(w http.ResponseWriter) error {
+func (response PostPullRequestReviewersRemove409JSONResponse) VisitPostPullRequestReviewersRemoveResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(409)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type PostPullRequestMergeRequestObject struct {
-	Body *PostPullRequestMergeJSONRequestBody
-}
-
-type PostPullRequestMergeResponseObject interface {
-	VisitPostPullRequestMergeResponse(w http.ResponseWriter) error
-}
-
-type PostPullRequestMerge200JSONResponse struct {
-	Pr *PullRequest `json:"pr,omitempty"`
-}
+type PostPullRequestReviewersRemove412JSONResponse ErrorResponse
 
-func (response PostPullRequestMerge200JSONResponse) VisitPostPullRequestMergeResponse(w http.ResponseWriter) error {
+func (response PostPullRequestReviewersRemove412JSONResponse) VisitPostPullRequestReviewersRemoveResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	w.WriteHeader(412)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type PostPullRequestMerge404JSONResponse ErrorResponse
+type PostPullRequestReviewersRemove500JSONResponse struct{ InternalErrorJSONResponse }
 
-func (response PostPullRequestMerge404JSONResponse) VisitPostPullRequestMergeResponse(w http.ResponseWriter) error {
+func (response PostPullRequestReviewersRemove500JSONResponse) VisitPostPullRequestReviewersRemoveResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	w.WriteHeader(500)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type PostPullRequestReassignRequestObject struct {
-	Body *PostPullRequestReassignJSONRequestBody
+type PostPullRequestUpdateRequestObject struct {
+	Params PostPullRequestUpdateParams
+	Body   *PostPullRequestUpdateJSONRequestBody
 }
 
-type PostPullRequestReassignResponseObject interface {
-	VisitPostPullRequestReassignResponse(w http.ResponseWriter) error
+type PostPullRequestUpdateResponseObject interface {
+	VisitPostPullRequestUpdateResponse(w http.ResponseWriter) error
 }
 
-type PostPullRequestReassign200JSONResponse struct {
-	Pr PullRequest `json:"pr"`
+type PostPullRequestUpdate200ResponseHeaders struct {
+	ETag string
+}
 
-	// ReplacedBy user_id нового ревьювера
-	ReplacedBy string `json:"replaced_by"`
+type PostPullRequestUpdate200JSONResponse struct {
+	Body struct {
+		Pr PullRequest `json:"pr"`
+	}
+	Headers PostPullRequestUpdate200ResponseHeaders
 }
 
-func (response PostPullRequestReassign200JSONResponse) VisitPostPullRequestReassignResponse(w http.ResponseWriter) error {
+func (response PostPullRequestUpdate200JSONResponse) VisitPostPullRequestUpdateResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("ETag", fmt.Sprint(response.Headers.ETag))
 	w.WriteHeader(200)
 
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type PostPullRequestUpdate400JSONResponse ErrorResponse
+
+func (response PostPullRequestUpdate400JSONResponse) VisitPostPullRequestUpdateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
 	return json.NewEncoder(w).Encode(response)
 }
 
-type PostPullRequestReassign404JSONResponse ErrorResponse
+type PostPullRequestUpdate404JSONResponse ErrorResponse
 
-func (response PostPullRequestReassign404JSONResponse) VisitPostPullRequestReassignResponse(w http.ResponseWriter) error {
+func (response PostPullRequestUpdate404JSONResponse) VisitPostPullRequestUpdateResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(404)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type PostPullRequestReassign409JSONResponse ErrorResponse
+type PostPullRequestUpdate412JSONResponse ErrorResponse
 
-func (response PostPullRequestReassign409JSONResponse) VisitPostPullRequestReassignResponse(w http.ResponseWriter) error {
+func (response PostPullRequestUpdate412JSONResponse) VisitPostPullRequestUpdateResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(409)
+	w.WriteHeader(412)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPullRequestUpdate500JSONResponse struct{ InternalErrorJSONResponse }
+
+func (response PostPullRequestUpdate500JSONResponse) VisitPostPullRequestUpdateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
 // StrictServerInterface represents all server handlers.
 type StrictServerInterface interface {
-	// Создать PR и автоматически назначить до 2 ревьюверов из команды автора
+	// Создать PR и автоматически назначить ревьюверов из команды автора
 	// (POST /pullRequest/create)
 	PostPullRequestCreate(ctx context.Context, request PostPullRequestCreateRequestObject) (PostPullRequestCreateResponseObject, error)
+	// Отказаться от ревью с автоматической заменой
+	// (POST /pullRequest/decline)
+	PostPullRequestDecline(ctx context.Context, request PostPullRequestDeclineRequestObject) (PostPullRequestDeclineResponseObject, error)
+	// Список PR с фильтрами по репозиторию, метке и статусу
+	// (GET /pullRequest/list)
+	GetPullRequestList(ctx context.Context, request GetPullRequestListRequestObject) (GetPullRequestListResponseObject, error)
 	// Пометить PR как MERGED (идемпотентная операция)
 	// (POST /pullRequest/merge)
 	PostPullRequestMerge(ctx context.Context, request PostPullRequestMergeRequestObject) (PostPullRequestMergeResponseObject, error)
 	// Переназначить конкретного ревьювера на другого из его команды
 	// (POST /pullRequest/reassign)
 	PostPullRequestReassign(ctx context.Context, request PostPullRequestReassignRequestObject) (PostPullRequestReassignResponseObject, error)
+	// Добавить ревьювера на открытый PR вручную
+	// (POST /pullRequest/reviewers/add)
+	PostPullRequestReviewersAdd(ctx context.Context, request PostPullRequestReviewersAddRequestObject) (PostPullRequestReviewersAddResponseObject, error)
+	// Снять ревьювера с открытого PR без замены
+	// (POST /pullRequest/reviewers/remove)
+	PostPullRequestReviewersRemove(ctx context.Context, request PostPullRequestReviewersRemoveRequestObject) (PostPullRequestReviewersRemoveResponseObject, error)
+	// Изменить метаданные PR
+	// (POST /pullRequest/update)
+	PostPullRequestUpdate(ctx context.Context, request PostPullRequestUpdateRequestObject) (PostPullRequestUpdateResponseObject, error)
 }
 
 type StrictHandlerFunc = strictecho.StrictEchoHandlerFunc
@@ -293,9 +1414,11 @@ type strictHandler struct {
 }
 
 // PostPullRequestCreate operation middleware
-func (sh *strictHandler) PostPullRequestCreate(ctx echo.Context) error {
+func (sh *strictHandler) PostPullRequestCreate(ctx echo.Context, params PostPullRequestCreateParams) error {
 	var request PostPullRequestCreateRequestObject
 
+	request.Params = params
+
 	var body PostPullRequestCreateJSONRequestBody
 	if err := ctx.Bind(&body); err != nil {
 		return err
@@ -321,10 +1444,68 @@ func (sh *strictHandler) PostPullRequestCreate(ctx echo.Context) error {
 	return nil
 }
 
+// PostPullRequestDecline operation middleware
+func (sh *strictHandler) PostPullRequestDecline(ctx echo.Context, params PostPullRequestDeclineParams) error {
+	var request PostPullRequestDeclineRequestObject
+
+	request.Params = params
+
+	var body PostPullRequestDeclineJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostPullRequestDecline(ctx.Request().Context(), request.(PostPullRequestDeclineRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostPullRequestDecline")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(PostPullRequestDeclineResponseObject); ok {
+		return validResponse.VisitPostPullRequestDeclineResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// GetPullRequestList operation middleware
+func (sh *strictHandler) GetPullRequestList(ctx echo.Context, params GetPullRequestListParams) error {
+	var request GetPullRequestListRequestObject
+
+	request.Params = params
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetPullRequestList(ctx.Request().Context(), request.(GetPullRequestListRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetPullRequestList")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(GetPullRequestListResponseObject); ok {
+		return validResponse.VisitGetPullRequestListResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
 // PostPullRequestMerge operation middleware
-func (sh *strictHandler) PostPullRequestMerge(ctx echo.Context) error {
+func (sh *strictHandler) PostPullRequestMerge(ctx echo.Context, params PostPullRequestMergeParams) error {
 	var request PostPullRequestMergeRequestObject
 
+	request.Params = params
+
 	var body PostPullRequestMergeJSONRequestBody
 	if err := ctx.Bind(&body); err != nil {
 		return err
@@ -351,9 +1532,11 @@ func (sh *strictHandler) PostPullRequestMerge(ctx echo.Context) error {
 }
 
 // PostPullRequestReassign operation middleware
-func (sh *strictHandler) PostPullRequestReassign(ctx echo.Context) error {
+func (sh *strictHandler) PostPullRequestReassign(ctx echo.Context, params PostPullRequestReassignParams) error {
 	var request PostPullRequestReassignRequestObject
 
+	request.Params = params
+
 	var body PostPullRequestReassignJSONRequestBody
 	if err := ctx.Bind(&body); err != nil {
 		return err
@@ -378,3 +1561,96 @@ func (sh *strictHandler) PostPullRequestReassign(ctx echo.Context) error {
 	}
 	return nil
 }
+
+// PostPullRequestReviewersAdd operation middleware
+func (sh *strictHandler) PostPullRequestReviewersAdd(ctx echo.Context, params PostPullRequestReviewersAddParams) error {
+	var request PostPullRequestReviewersAddRequestObject
+
+	request.Params = params
+
+	var body PostPullRequestReviewersAddJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostPullRequestReviewersAdd(ctx.Request().Context(), request.(PostPullRequestReviewersAddRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostPullRequestReviewersAdd")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(PostPullRequestReviewersAddResponseObject); ok {
+		return validResponse.VisitPostPullRequestReviewersAddResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// PostPullRequestReviewersRemove operation middleware
+func (sh *strictHandler) PostPullRequestReviewersRemove(ctx echo.Context, params PostPullRequestReviewersRemoveParams) error {
+	var request PostPullRequestReviewersRemoveRequestObject
+
+	request.Params = params
+
+	var body PostPullRequestReviewersRemoveJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostPullRequestReviewersRemove(ctx.Request().Context(), request.(PostPullRequestReviewersRemoveRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostPullRequestReviewersRemove")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(PostPullRequestReviewersRemoveResponseObject); ok {
+		return validResponse.VisitPostPullRequestReviewersRemoveResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// PostPullRequestUpdate operation middleware
+func (sh *strictHandler) PostPullRequestUpdate(ctx echo.Context, params PostPullRequestUpdateParams) error {
+	var request PostPullRequestUpdateRequestObject
+
+	request.Params = params
+
+	var body PostPullRequestUpdateJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostPullRequestUpdate(ctx.Request().Context(), request.(PostPullRequestUpdateRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostPullRequestUpdate")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(PostPullRequestUpdateResponseObject); ok {
+		return validResponse.VisitPostPullRequestUpdateResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
@@ -10,55 +10,118 @@ import (
 	"net/http"
 
 	"github.com/labstack/echo/v4"
+	"github.com/oapi-codegen/runtime"
 	strictecho "github.com/oapi-codegen/runtime/strictmiddleware/echo"
 )
 
+const (
+	BearerAuthScopes = "bearerAuth.Scopes"
+)
+
 // Defines values for ErrorResponseErrorCode.
 const (
-	NOCANDIDATE ErrorResponseErrorCode = "NO_CANDIDATE"
-	NOTASSIGNED ErrorResponseErrorCode = "NOT_ASSIGNED"
-	NOTFOUND    ErrorResponseErrorCode = "NOT_FOUND"
-	PREXISTS    ErrorResponseErrorCode = "PR_EXISTS"
-	PRMERGED    ErrorResponseErrorCode = "PR_MERGED"
-	TEAMEXISTS  ErrorResponseErrorCode = "TEAM_EXISTS"
+	BADREQUEST               ErrorResponseErrorCode = "BAD_REQUEST"
+	FORBIDDEN                ErrorResponseErrorCode = "FORBIDDEN"
+	IDEMPOTENCYKEYINPROGRESS ErrorResponseErrorCode = "IDEMPOTENCY_KEY_IN_PROGRESS"
+	IDEMPOTENCYKEYREUSED     ErrorResponseErrorCode = "IDEMPOTENCY_KEY_REUSED"
+	INTERNALERROR            ErrorResponseErrorCode = "INTERNAL_ERROR"
+	NOCANDIDATE              ErrorResponseErrorCode = "NO_CANDIDATE"
+	NOTASSIGNED              ErrorResponseErrorCode = "NOT_ASSIGNED"
+	NOTFOUND                 ErrorResponseErrorCode = "NOT_FOUND"
+	PREXISTS                 ErrorResponseErrorCode = "PR_EXISTS"
+	PRMERGED                 ErrorResponseErrorCode = "PR_MERGED"
+	RATELIMITED              ErrorResponseErrorCode = "RATE_LIMITED"
+	TEAMEXISTS               ErrorResponseErrorCode = "TEAM_EXISTS"
+	UNAUTHORIZED             ErrorResponseErrorCode = "UNAUTHORIZED"
+	VERSIONMISMATCH          ErrorResponseErrorCode = "VERSION_MISMATCH"
 )
 
 // AssignmentsByPRItem defines model for AssignmentsByPRItem.
 type AssignmentsByPRItem struct {
-	Count         int    `json:"count"`
-	PullRequestId string `json:"pull_request_id"`
+	Count         int           `json:"count"`
+	PullRequestId PullRequestId `json:"pull_request_id"`
 }
 
 // AssignmentsByUserItem defines model for AssignmentsByUserItem.
 type AssignmentsByUserItem struct {
-	Count  int    `json:"count"`
-	UserId string `json:"user_id"`
+	Count int `json:"count"`
+
+	// UserId Идентификатор пользователя, без пробелов
+	UserId UserId `json:"user_id"`
+}
+
+// DeclinesByUserItem defines model for DeclinesByUserItem.
+type DeclinesByUserItem struct {
+	Count int `json:"count"`
+
+	// UserId Идентификатор пользователя, без пробелов
+	UserId UserId `json:"user_id"`
 }
 
 // ErrorResponse defines model for ErrorResponse.
 type ErrorResponse struct {
 	Error struct {
-		Code    ErrorResponseErrorCode `json:"code"`
-		Message string                 `json:"message"`
+		Code ErrorResponseErrorCode `json:"code"`
+
+		// Fields Некорректные поля запроса, если он не прошёл проверку по схеме
+		Fields *[]struct {
+			// Field Путь к полю тела через точку (members.1.user_id) или имя параметра
+			Field   string `json:"field"`
+			Message string `json:"message"`
+		} `json:"fields,omitempty"`
+		Message string `json:"message"`
+
+		// RequestId X-Request-ID запроса, по нему ошибку можно найти в логах
+		RequestId *string `json:"request_id,omitempty"`
 	} `json:"error"`
 }
 
 // ErrorResponseErrorCode defines model for ErrorResponse.Error.Code.
 type ErrorResponseErrorCode string
 
+// PullRequestId defines model for PullRequestId.
+type PullRequestId = string
+
 // StatsResponse defines model for StatsResponse.
 type StatsResponse struct {
 	AssignmentsByPr   []AssignmentsByPRItem   `json:"assignments_by_pr"`
 	AssignmentsByUser []AssignmentsByUserItem `json:"assignments_by_user"`
-	MergedPrsCount    int                     `json:"merged_prs_count"`
-	OpenPrsCount      int                     `json:"open_prs_count"`
+
+	// DeclinesByUser Сколько раз каждый пользователь отказался от ревью
+	DeclinesByUser []DeclinesByUserItem `json:"declines_by_user"`
+	MergedPrsCount int                  `json:"merged_prs_count"`
+	OpenPrsCount   int                  `json:"open_prs_count"`
+}
+
+// UserId Идентификатор пользователя, без пробелов
+type UserId = string
+
+// LabelQuery defines model for LabelQuery.
+type LabelQuery = string
+
+// RepositoryQuery defines model for RepositoryQuery.
+type RepositoryQuery = string
+
+// InternalError defines model for InternalError.
+type InternalError = ErrorResponse
+
+// ValidationError defines model for ValidationError.
+type ValidationError = ErrorResponse
+
+// GetStatsParams defines parameters for GetStats.
+type GetStatsParams struct {
+	// Repository Только PR этого репозитория
+	Repository *RepositoryQuery `form:"repository,omitempty" json:"repository,omitempty"`
+
+	// Label Только PR с этой меткой
+	Label *LabelQuery `form:"label,omitempty" json:"label,omitempty"`
 }
 
 // ServerInterface represents all server handlers.
 type ServerInterface interface {
 	// Get statistics about pull requests and assignments
 	// (GET /stats)
-	GetStats(ctx echo.Context) error
+	GetStats(ctx echo.Context, params GetStatsParams) error
 }
 
 // ServerInterfaceWrapper converts echo contexts to parameters.
@@ -70,8 +133,26 @@ type ServerInterfaceWrapper struct {
 func (w *ServerInterfaceWrapper) GetStats(ctx echo.Context) error {
 	var err error
 
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetStatsParams
+	// ------------- Optional query parameter "repository" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "repository", ctx.QueryParams(), &params.Repository)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter repository: %s", err))
+	}
+
+	// ------------- Optional query parameter "label" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "label", ctx.QueryParams(), &params.Label)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter label: %s", err))
+	}
+
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.GetStats(ctx)
+	err = w.Handler.GetStats(ctx, params)
 	return err
 }
 
@@ -107,7 +188,12 @@ func RegisterHandlersWithBaseURL(router EchoRouter, si ServerInterface, baseURL
 
 }
 
+type InternalErrorJSONResponse ErrorResponse
+
+type ValidationErrorJSONResponse ErrorResponse
+
 type GetStatsRequestObject struct {
+	Params GetStatsParams
 }
 
 type GetStatsResponseObject interface {
@@ -123,7 +209,16 @@ func (response GetStats200JSONResponse) VisitGetStatsResponse(w http.ResponseWri
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetStats500JSONResponse ErrorResponse
+type GetStats400JSONResponse struct{ ValidationErrorJSONResponse }
+
+func (response GetStats400JSONResponse) VisitGetStatsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetStats500JSONResponse struct{ InternalErrorJSONResponse }
 
 func (response GetStats500JSONResponse) VisitGetStatsResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
@@ -152,9 +247,11 @@ type strictHandler struct {
 }
 
 // GetStats operation middleware
-func (sh *strictHandler) GetStats(ctx echo.Context) error {
+func (sh *strictHandler) GetStats(ctx echo.Context, params GetStatsParams) error {
 	var request GetStatsRequestObject
 
+	request.Params = params
+
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
 		return sh.ssi.GetStats(ctx.Request().Context(), request.(GetStatsRequestObject))
 	}
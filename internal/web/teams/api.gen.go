@@ -14,42 +14,177 @@ import (
 	strictecho "github.com/oapi-codegen/runtime/strictmiddleware/echo"
 )
 
+const (
+	BearerAuthScopes = "bearerAuth.Scopes"
+)
+
 // Defines values for ErrorResponseErrorCode.
 const (
-	NOCANDIDATE ErrorResponseErrorCode = "NO_CANDIDATE"
-	NOTASSIGNED ErrorResponseErrorCode = "NOT_ASSIGNED"
-	NOTFOUND    ErrorResponseErrorCode = "NOT_FOUND"
-	PREXISTS    ErrorResponseErrorCode = "PR_EXISTS"
-	PRMERGED    ErrorResponseErrorCode = "PR_MERGED"
-	TEAMEXISTS  ErrorResponseErrorCode = "TEAM_EXISTS"
+	BADREQUEST               ErrorResponseErrorCode = "BAD_REQUEST"
+	FORBIDDEN                ErrorResponseErrorCode = "FORBIDDEN"
+	IDEMPOTENCYKEYINPROGRESS ErrorResponseErrorCode = "IDEMPOTENCY_KEY_IN_PROGRESS"
+	IDEMPOTENCYKEYREUSED     ErrorResponseErrorCode = "IDEMPOTENCY_KEY_REUSED"
+	INTERNALERROR            ErrorResponseErrorCode = "INTERNAL_ERROR"
+	NOCANDIDATE              ErrorResponseErrorCode = "NO_CANDIDATE"
+	NOTASSIGNED              ErrorResponseErrorCode = "NOT_ASSIGNED"
+	NOTFOUND                 ErrorResponseErrorCode = "NOT_FOUND"
+	PREXISTS                 ErrorResponseErrorCode = "PR_EXISTS"
+	PRMERGED                 ErrorResponseErrorCode = "PR_MERGED"
+	RATELIMITED              ErrorResponseErrorCode = "RATE_LIMITED"
+	TEAMEXISTS               ErrorResponseErrorCode = "TEAM_EXISTS"
+	UNAUTHORIZED             ErrorResponseErrorCode = "UNAUTHORIZED"
+	VERSIONMISMATCH          ErrorResponseErrorCode = "VERSION_MISMATCH"
+)
+
+// Defines values for ReviewPolicy.
+const (
+	Pairing  ReviewPolicy = "pairing"
+	Standard ReviewPolicy = "standard"
+)
+
+// Defines values for Seniority.
+const (
+	Junior Seniority = "junior"
+	Senior Seniority = "senior"
 )
 
 // ErrorResponse defines model for ErrorResponse.
 type ErrorResponse struct {
 	Error struct {
-		Code    ErrorResponseErrorCode `json:"code"`
-		Message string                 `json:"message"`
+		Code ErrorResponseErrorCode `json:"code"`
+
+		// Fields Некорректные поля запроса, если он не прошёл проверку по схеме
+		Fields *[]struct {
+			// Field Путь к полю тела через точку (members.1.user_id) или имя параметра
+			Field   string `json:"field"`
+			Message string `json:"message"`
+		} `json:"fields,omitempty"`
+		Message string `json:"message"`
+
+		// RequestId X-Request-ID запроса, по нему ошибку можно найти в логах
+		RequestId *string `json:"request_id,omitempty"`
 	} `json:"error"`
 }
 
 // ErrorResponseErrorCode defines model for ErrorResponse.Error.Code.
 type ErrorResponseErrorCode string
 
+// PoolName defines model for PoolName.
+type PoolName = string
+
+// ReviewPolicy pairing — на каждый PR назначаются один senior и один junior
+type ReviewPolicy string
+
+// ReviewerPool defines model for ReviewerPool.
+type ReviewerPool struct {
+	PoolName PoolName `json:"pool_name"`
+	UserIds  []UserId `json:"user_ids"`
+}
+
+// Seniority Уровень пользователя для режима наставничества
+type Seniority string
+
+// SizeRule defines model for SizeRule.
+type SizeRule struct {
+	// MinLines Правило действует для PR, изменяющих не меньше строк (additions + deletions)
+	MinLines  int `json:"min_lines"`
+	Reviewers int `json:"reviewers"`
+}
+
+// Tag Тег навыка или метка PR
+type Tag = string
+
 // Team defines model for Team.
 type Team struct {
-	Members  []TeamMember `json:"members"`
-	TeamName string       `json:"team_name"`
+	// DefaultMaxOpenReviews Максимум открытых ревью для участников без собственного лимита
+	DefaultMaxOpenReviews *int `json:"default_max_open_reviews"`
+
+	// FallbackTeams Команды, из которых берутся ревьюверы, если своих не хватает (в порядке приоритета)
+	FallbackTeams *[]TeamName  `json:"fallback_teams,omitempty"`
+	Members       []TeamMember `json:"members"`
+
+	// ReviewPolicy pairing — на каждый PR назначаются один senior и один junior
+	ReviewPolicy *ReviewPolicy `json:"review_policy,omitempty"`
+
+	// ReviewerPools Общие пулы ревьюверов, доступные команде
+	ReviewerPools *[]PoolName `json:"reviewer_pools,omitempty"`
+
+	// SizeRules Число ревьюверов в зависимости от размера PR
+	SizeRules *[]SizeRule `json:"size_rules,omitempty"`
+
+	// TeamName Имя команды, без пробелов по краям
+	TeamName TeamName `json:"team_name"`
+
+	// Version Версия команды, растёт с каждым изменением; ETag — она же в кавычках
+	Version *int64 `json:"version,omitempty"`
 }
 
 // TeamMember defines model for TeamMember.
 type TeamMember struct {
-	IsActive bool   `json:"is_active"`
-	UserId   string `json:"user_id"`
-	Username string `json:"username"`
+	IsActive bool `json:"is_active"`
+
+	// MaxOpenReviews Максимум открытых ревью; если не задан, действует значение команды
+	MaxOpenReviews *int `json:"max_open_reviews"`
+
+	// Seniority Уровень пользователя для режима наставничества
+	Seniority *Seniority `json:"seniority,omitempty"`
+
+	// Skills Теги навыков пользователя
+	Skills *[]Tag `json:"skills,omitempty"`
+
+	// UserId Идентификатор пользователя, без пробелов
+	UserId   UserId   `json:"user_id"`
+	Username Username `json:"username"`
+}
+
+// TeamName Имя команды, без пробелов по краям
+type TeamName = string
+
+// UserId Идентификатор пользователя, без пробелов
+type UserId = string
+
+// Username defines model for Username.
+type Username = string
+
+// IdempotencyKey defines model for IdempotencyKey.
+type IdempotencyKey = string
+
+// IfMatch defines model for IfMatch.
+type IfMatch = string
+
+// TeamNameQuery Имя команды, без пробелов по краям
+type TeamNameQuery = TeamName
+
+// InternalError defines model for InternalError.
+type InternalError = ErrorResponse
+
+// ValidationError defines model for ValidationError.
+type ValidationError = ErrorResponse
+
+// GetPoolsGetParams defines parameters for GetPoolsGet.
+type GetPoolsGetParams struct {
+	PoolName PoolName `form:"pool_name" json:"pool_name"`
+}
+
+// PostPoolsSetParams defines parameters for PostPoolsSet.
+type PostPoolsSetParams struct {
+	// IdempotencyKey Ключ повторной отправки. Первый ответ (кроме 5xx) сохраняется на IDEMPOTENCY_TTL
+	// и возвращается повторным запросам того же клиента с тем же ключом, путём и телом
+	// с заголовком `Idempotent-Replayed: true`. Тот же ключ с другим запросом даёт
+	// 422 IDEMPOTENCY_KEY_REUSED, повтор во время выполнения первого запроса — 409
+	// IDEMPOTENCY_KEY_IN_PROGRESS.
+	IdempotencyKey *IdempotencyKey `json:"Idempotency-Key,omitempty"`
 }
 
-// TeamNameQuery defines model for TeamNameQuery.
-type TeamNameQuery = string
+// PostTeamAddParams defines parameters for PostTeamAdd.
+type PostTeamAddParams struct {
+	// IdempotencyKey Ключ повторной отправки. Первый ответ (кроме 5xx) сохраняется на IDEMPOTENCY_TTL
+	// и возвращается повторным запросам того же клиента с тем же ключом, путём и телом
+	// с заголовком `Idempotent-Replayed: true`. Тот же ключ с другим запросом даёт
+	// 422 IDEMPOTENCY_KEY_REUSED, повтор во время выполнения первого запроса — 409
+	// IDEMPOTENCY_KEY_IN_PROGRESS.
+	IdempotencyKey *IdempotencyKey `json:"Idempotency-Key,omitempty"`
+}
 
 // GetTeamGetParams defines parameters for GetTeamGet.
 type GetTeamGetParams struct {
@@ -57,17 +192,141 @@ type GetTeamGetParams struct {
 	TeamName TeamNameQuery `form:"team_name" json:"team_name"`
 }
 
+// PostTeamSetDefaultMaxOpenReviewsJSONBody defines parameters for PostTeamSetDefaultMaxOpenReviews.
+type PostTeamSetDefaultMaxOpenReviewsJSONBody struct {
+	// DefaultMaxOpenReviews null снимает лимит
+	DefaultMaxOpenReviews *int `json:"default_max_open_reviews"`
+
+	// TeamName Имя команды, без пробелов по краям
+	TeamName TeamName `json:"team_name"`
+}
+
+// PostTeamSetDefaultMaxOpenReviewsParams defines parameters for PostTeamSetDefaultMaxOpenReviews.
+type PostTeamSetDefaultMaxOpenReviewsParams struct {
+	// IdempotencyKey Ключ повторной отправки. Первый ответ (кроме 5xx) сохраняется на IDEMPOTENCY_TTL
+	// и возвращается повторным запросам того же клиента с тем же ключом, путём и телом
+	// с заголовком `Idempotent-Replayed: true`. Тот же ключ с другим запросом даёт
+	// 422 IDEMPOTENCY_KEY_REUSED, повтор во время выполнения первого запроса — 409
+	// IDEMPOTENCY_KEY_IN_PROGRESS.
+	IdempotencyKey *IdempotencyKey `json:"Idempotency-Key,omitempty"`
+
+	// IfMatch ETag команды или PR из предыдущего ответа (или `*`). Изменение применяется, только
+	// если объект с тех пор не менялся, иначе возвращается 412 VERSION_MISMATCH.
+	IfMatch *IfMatch `json:"If-Match,omitempty"`
+}
+
+// PostTeamSetFallbacksJSONBody defines parameters for PostTeamSetFallbacks.
+type PostTeamSetFallbacksJSONBody struct {
+	FallbackTeams []TeamName `json:"fallback_teams"`
+	ReviewerPools []PoolName `json:"reviewer_pools"`
+
+	// TeamName Имя команды, без пробелов по краям
+	TeamName TeamName `json:"team_name"`
+}
+
+// PostTeamSetFallbacksParams defines parameters for PostTeamSetFallbacks.
+type PostTeamSetFallbacksParams struct {
+	// IdempotencyKey Ключ повторной отправки. Первый ответ (кроме 5xx) сохраняется на IDEMPOTENCY_TTL
+	// и возвращается повторным запросам того же клиента с тем же ключом, путём и телом
+	// с заголовком `Idempotent-Replayed: true`. Тот же ключ с другим запросом даёт
+	// 422 IDEMPOTENCY_KEY_REUSED, повтор во время выполнения первого запроса — 409
+	// IDEMPOTENCY_KEY_IN_PROGRESS.
+	IdempotencyKey *IdempotencyKey `json:"Idempotency-Key,omitempty"`
+
+	// IfMatch ETag команды или PR из предыдущего ответа (или `*`). Изменение применяется, только
+	// если объект с тех пор не менялся, иначе возвращается 412 VERSION_MISMATCH.
+	IfMatch *IfMatch `json:"If-Match,omitempty"`
+}
+
+// PostTeamSetReviewPolicyJSONBody defines parameters for PostTeamSetReviewPolicy.
+type PostTeamSetReviewPolicyJSONBody struct {
+	// ReviewPolicy pairing — на каждый PR назначаются один senior и один junior
+	ReviewPolicy ReviewPolicy `json:"review_policy"`
+
+	// TeamName Имя команды, без пробелов по краям
+	TeamName TeamName `json:"team_name"`
+}
+
+// PostTeamSetReviewPolicyParams defines parameters for PostTeamSetReviewPolicy.
+type PostTeamSetReviewPolicyParams struct {
+	// IdempotencyKey Ключ повторной отправки. Первый ответ (кроме 5xx) сохраняется на IDEMPOTENCY_TTL
+	// и возвращается повторным запросам того же клиента с тем же ключом, путём и телом
+	// с заголовком `Idempotent-Replayed: true`. Тот же ключ с другим запросом даёт
+	// 422 IDEMPOTENCY_KEY_REUSED, повтор во время выполнения первого запроса — 409
+	// IDEMPOTENCY_KEY_IN_PROGRESS.
+	IdempotencyKey *IdempotencyKey `json:"Idempotency-Key,omitempty"`
+
+	// IfMatch ETag команды или PR из предыдущего ответа (или `*`). Изменение применяется, только
+	// если объект с тех пор не менялся, иначе возвращается 412 VERSION_MISMATCH.
+	IfMatch *IfMatch `json:"If-Match,omitempty"`
+}
+
+// PostTeamSetSizeRulesJSONBody defines parameters for PostTeamSetSizeRules.
+type PostTeamSetSizeRulesJSONBody struct {
+	SizeRules []SizeRule `json:"size_rules"`
+
+	// TeamName Имя команды, без пробелов по краям
+	TeamName TeamName `json:"team_name"`
+}
+
+// PostTeamSetSizeRulesParams defines parameters for PostTeamSetSizeRules.
+type PostTeamSetSizeRulesParams struct {
+	// IdempotencyKey Ключ повторной отправки. Первый ответ (кроме 5xx) сохраняется на IDEMPOTENCY_TTL
+	// и возвращается повторным запросам того же клиента с тем же ключом, путём и телом
+	// с заголовком `Idempotent-Replayed: true`. Тот же ключ с другим запросом даёт
+	// 422 IDEMPOTENCY_KEY_REUSED, повтор во время выполнения первого запроса — 409
+	// IDEMPOTENCY_KEY_IN_PROGRESS.
+	IdempotencyKey *IdempotencyKey `json:"Idempotency-Key,omitempty"`
+
+	// IfMatch ETag команды или PR из предыдущего ответа (или `*`). Изменение применяется, только
+	// если объект с тех пор не менялся, иначе возвращается 412 VERSION_MISMATCH.
+	IfMatch *IfMatch `json:"If-Match,omitempty"`
+}
+
+// PostPoolsSetJSONRequestBody defines body for PostPoolsSet for application/json ContentType.
+type PostPoolsSetJSONRequestBody = ReviewerPool
+
 // PostTeamAddJSONRequestBody defines body for PostTeamAdd for application/json ContentType.
 type PostTeamAddJSONRequestBody = Team
 
+// PostTeamSetDefaultMaxOpenReviewsJSONRequestBody defines body for PostTeamSetDefaultMaxOpenReviews for application/json ContentType.
+type PostTeamSetDefaultMaxOpenReviewsJSONRequestBody PostTeamSetDefaultMaxOpenReviewsJSONBody
+
+// PostTeamSetFallbacksJSONRequestBody defines body for PostTeamSetFallbacks for application/json ContentType.
+type PostTeamSetFallbacksJSONRequestBody PostTeamSetFallbacksJSONBody
+
+// PostTeamSetReviewPolicyJSONRequestBody defines body for PostTeamSetReviewPolicy for application/json ContentType.
+type PostTeamSetReviewPolicyJSONRequestBody PostTeamSetReviewPolicyJSONBody
+
+// PostTeamSetSizeRulesJSONRequestBody defines body for PostTeamSetSizeRules for application/json ContentType.
+type PostTeamSetSizeRulesJSONRequestBody PostTeamSetSizeRulesJSONBody
+
 // ServerInterface represents all server handlers.
 type ServerInterface interface {
+	// Получить общий пул ревьюверов
+	// (GET /pools/get)
+	GetPoolsGet(ctx echo.Context, params GetPoolsGetParams) error
+	// Создать или заменить общий пул ревьюверов
+	// (POST /pools/set)
+	PostPoolsSet(ctx echo.Context, params PostPoolsSetParams) error
 	// Создать команду с участниками (создаёт/обновляет пользователей)
 	// (POST /team/add)
-	PostTeamAdd(ctx echo.Context) error
+	PostTeamAdd(ctx echo.Context, params PostTeamAddParams) error
 	// Получить команду с участниками
 	// (GET /team/get)
 	GetTeamGet(ctx echo.Context, params GetTeamGetParams) error
+	// Установить лимит открытых ревью по умолчанию для участников команды
+	// (POST /team/setDefaultMaxOpenReviews)
+	PostTeamSetDefaultMaxOpenReviews(ctx echo.Context, params PostTeamSetDefaultMaxOpenReviewsParams) error
+	// Задать резервные команды и общие пулы ревьюверов
+	// (POST /team/setFallbacks)
+	PostTeamSetFallbacks(ctx echo.Context, params PostTeamSetFallbacksParams) error
+	// Выбрать режим назначения ревьюверов команды
+	// (POST /team/setReviewPolicy)
+	PostTeamSetReviewPolicy(ctx echo.Context, params PostTeamSetReviewPolicyParams) error
+	// Задать число ревьюверов в зависимости от размера PR
+	// (POST /team/setSizeRules)
+	PostTeamSetSizeRules(ctx echo.Context, params PostTeamSetSizeRulesParams) error
 }
 
 // ServerInterfaceWrapper converts echo contexts to parameters.
@@ -75,12 +334,85 @@ type ServerInterfaceWrapper struct {
 	Handler ServerInterface
 }
 
+// GetPoolsGet converts echo context to params.
+func (w *ServerInterfaceWrapper) GetPoolsGet(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetPoolsGetParams
+	// ------------- Required query parameter "pool_name" -------------
+
+	err = runtime.BindQueryParameter("form", true, true, "pool_name", ctx.QueryParams(), &params.PoolName)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter pool_name: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetPoolsGet(ctx, params)
+	return err
+}
+
+// PostPoolsSet converts echo context to params.
+func (w *ServerInterfaceWrapper) PostPoolsSet(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PostPoolsSetParams
+
+	headers := ctx.Request().Header
+	// ------------- Optional header parameter "Idempotency-Key" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("Idempotency-Key")]; found {
+		var IdempotencyKey IdempotencyKey
+		n := len(valueList)
+		if n != 1 {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Expected one value for Idempotency-Key, got %d", n))
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "Idempotency-Key", valueList[0], &IdempotencyKey, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter Idempotency-Key: %s", err))
+		}
+
+		params.IdempotencyKey = &IdempotencyKey
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostPoolsSet(ctx, params)
+	return err
+}
+
 // PostTeamAdd converts echo context to params.
 func (w *ServerInterfaceWrapper) PostTeamAdd(ctx echo.Context) error {
 	var err error
 
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PostTeamAddParams
+
+	headers := ctx.Request().Header
+	// ------------- Optional header parameter "Idempotency-Key" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("Idempotency-Key")]; found {
+		var IdempotencyKey IdempotencyKey
+		n := len(valueList)
+		if n != 1 {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Expected one value for Idempotency-Key, got %d", n))
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "Idempotency-Key", valueList[0], &IdempotencyKey, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter Idempotency-Key: %s", err))
+		}
+
+		params.IdempotencyKey = &IdempotencyKey
+	}
+
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.PostTeamAdd(ctx)
+	err = w.Handler.PostTeamAdd(ctx, params)
 	return err
 }
 
@@ -88,6 +420,8 @@ func (w *ServerInterfaceWrapper) PostTeamAdd(ctx echo.Context) error {
 func (w *ServerInterfaceWrapper) GetTeamGet(ctx echo.Context) error {
 	var err error
 
+	ctx.Set(BearerAuthScopes, []string{})
+
 	// Parameter object where we will unmarshal all parameters from the context
 	var params GetTeamGetParams
 	// ------------- Required query parameter "team_name" -------------
@@ -102,6 +436,190 @@ func (w *ServerInterfaceWrapper) GetTeamGet(ctx echo.Context) error {
 	return err
 }
 
+// PostTeamSetDefaultMaxOpenReviews converts echo context to params.
+func (w *ServerInterfaceWrapper) PostTeamSetDefaultMaxOpenReviews(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PostTeamSetDefaultMaxOpenReviewsParams
+
+	headers := ctx.Request().Header
+	// ------------- Optional header parameter "Idempotency-Key" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("Idempotency-Key")]; found {
+		var IdempotencyKey IdempotencyKey
+		n := len(valueList)
+		if n != 1 {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Expected one value for Idempotency-Key, got %d", n))
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "Idempotency-Key", valueList[0], &IdempotencyKey, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter Idempotency-Key: %s", err))
+		}
+
+		params.IdempotencyKey = &IdempotencyKey
+	}
+	// ------------- Optional header parameter "If-Match" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-Match")]; found {
+		var IfMatch IfMatch
+		n := len(valueList)
+		if n != 1 {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Expected one value for If-Match, got %d", n))
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "If-Match", valueList[0], &IfMatch, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter If-Match: %s", err))
+		}
+
+		params.IfMatch = &IfMatch
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostTeamSetDefaultMaxOpenReviews(ctx, params)
+	return err
+}
+
+// PostTeamSetFallbacks converts echo context to params.
+func (w *ServerInterfaceWrapper) PostTeamSetFallbacks(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PostTeamSetFallbacksParams
+
+	headers := ctx.Request().Header
+	// ------------- Optional header parameter "Idempotency-Key" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("Idempotency-Key")]; found {
+		var IdempotencyKey IdempotencyKey
+		n := len(valueList)
+		if n != 1 {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Expected one value for Idempotency-Key, got %d", n))
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "Idempotency-Key", valueList[0], &IdempotencyKey, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter Idempotency-Key: %s", err))
+		}
+
+		params.IdempotencyKey = &IdempotencyKey
+	}
+	// ------------- Optional header parameter "If-Match" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-Match")]; found {
+		var IfMatch IfMatch
+		n := len(valueList)
+		if n != 1 {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Expected one value for If-Match, got %d", n))
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "If-Match", valueList[0], &IfMatch, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter If-Match: %s", err))
+		}
+
+		params.IfMatch = &IfMatch
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostTeamSetFallbacks(ctx, params)
+	return err
+}
+
+// PostTeamSetReviewPolicy converts echo context to params.
+func (w *ServerInterfaceWrapper) PostTeamSetReviewPolicy(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PostTeamSetReviewPolicyParams
+
+	headers := ctx.Request().Header
+	// ------------- Optional header parameter "Idempotency-Key" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("Idempotency-Key")]; found {
+		var IdempotencyKey IdempotencyKey
+		n := len(valueList)
+		if n != 1 {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Expected one value for Idempotency-Key, got %d", n))
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "Idempotency-Key", valueList[0], &IdempotencyKey, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter Idempotency-Key: %s", err))
+		}
+
+		params.IdempotencyKey = &IdempotencyKey
+	}
+	// ------------- Optional header parameter "If-Match" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-Match")]; found {
+		var IfMatch IfMatch
+		n := len(valueList)
+		if n != 1 {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Expected one value for If-Match, got %d", n))
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "If-Match", valueList[0], &IfMatch, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter If-Match: %s", err))
+		}
+
+		params.IfMatch = &IfMatch
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostTeamSetReviewPolicy(ctx, params)
+	return err
+}
+
+// PostTeamSetSizeRules converts echo context to params.
+func (w *ServerInterfaceWrapper) PostTeamSetSizeRules(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PostTeamSetSizeRulesParams
+
+	headers := ctx.Request().Header
+	// ------------- Optional header parameter "Idempotency-Key" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("Idempotency-Key")]; found {
+		var IdempotencyKey IdempotencyKey
+		n := len(valueList)
+		if n != 1 {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Expected one value for Idempotency-Key, got %d", n))
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "Idempotency-Key", valueList[0], &IdempotencyKey, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter Idempotency-Key: %s", err))
+		}
+
+		params.IdempotencyKey = &IdempotencyKey
+	}
+	// ------------- Optional header parameter "If-Match" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-Match")]; found {
+		var IfMatch IfMatch
+		n := len(valueList)
+		if n != 1 {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Expected one value for If-Match, got %d", n))
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "If-Match", valueList[0], &IfMatch, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter If-Match: %s", err))
+		}
+
+		params.IfMatch = &IfMatch
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostTeamSetSizeRules(ctx, params)
+	return err
+}
+
 // This is a simple interface which specifies echo.Route addition functions which
 // are present on both echo.Echo and echo.Group, since we want to allow using
 // either of them for path registration
@@ -130,28 +648,138 @@ func RegisterHandlersWithBaseURL(router EchoRouter, si ServerInterface, baseURL
 		Handler: si,
 	}
 
+	router.GET(baseURL+"/pools/get", wrapper.GetPoolsGet)
+	router.POST(baseURL+"/pools/set", wrapper.PostPoolsSet)
 	router.POST(baseURL+"/team/add", wrapper.PostTeamAdd)
 	router.GET(baseURL+"/team/get", wrapper.GetTeamGet)
+	router.POST(baseURL+"/team/setDefaultMaxOpenReviews", wrapper.PostTeamSetDefaultMaxOpenReviews)
+	router.POST(baseURL+"/team/setFallbacks", wrapper.PostTeamSetFallbacks)
+	router.POST(baseURL+"/team/setReviewPolicy", wrapper.PostTeamSetReviewPolicy)
+	router.POST(baseURL+"/team/setSizeRules", wrapper.PostTeamSetSizeRules)
+
+}
+
+type InternalErrorJSONResponse ErrorResponse
+
+type ValidationErrorJSONResponse ErrorResponse
+
+type GetPoolsGetRequestObject struct {
+	Params GetPoolsGetParams
+}
+
+type GetPoolsGetResponseObject interface {
+	VisitGetPoolsGetResponse(w http.ResponseWriter) error
+}
+
+type GetPoolsGet200JSONResponse ReviewerPool
+
+func (response GetPoolsGet200JSONResponse) VisitGetPoolsGetResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetPoolsGet400JSONResponse struct{ ValidationErrorJSONResponse }
+
+func (response GetPoolsGet400JSONResponse) VisitGetPoolsGetResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetPoolsGet404JSONResponse ErrorResponse
+
+func (response GetPoolsGet404JSONResponse) VisitGetPoolsGetResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetPoolsGet500JSONResponse struct{ InternalErrorJSONResponse }
+
+func (response GetPoolsGet500JSONResponse) VisitGetPoolsGetResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPoolsSetRequestObject struct {
+	Params PostPoolsSetParams
+	Body   *PostPoolsSetJSONRequestBody
+}
+
+type PostPoolsSetResponseObject interface {
+	VisitPostPoolsSetResponse(w http.ResponseWriter) error
+}
+
+type PostPoolsSet200JSONResponse struct {
+	Pool ReviewerPool `json:"pool"`
+}
+
+func (response PostPoolsSet200JSONResponse) VisitPostPoolsSetResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPoolsSet400JSONResponse struct{ ValidationErrorJSONResponse }
+
+func (response PostPoolsSet400JSONResponse) VisitPostPoolsSetResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPoolsSet404JSONResponse ErrorResponse
+
+func (response PostPoolsSet404JSONResponse) VisitPostPoolsSetResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPoolsSet500JSONResponse struct{ InternalErrorJSONResponse }
+
+func (response PostPoolsSet500JSONResponse) VisitPostPoolsSetResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
 
+	return json.NewEncoder(w).Encode(response)
 }
 
 type PostTeamAddRequestObject struct {
-	Body *PostTeamAddJSONRequestBody
+	Params PostTeamAddParams
+	Body   *PostTeamAddJSONRequestBody
 }
 
 type PostTeamAddResponseObject interface {
 	VisitPostTeamAddResponse(w http.ResponseWriter) error
 }
 
+type PostTeamAdd201ResponseHeaders struct {
+	ETag string
+}
+
 type PostTeamAdd201JSONResponse struct {
-	Team *Team `json:"team,omitempty"`
+	Body struct {
+		Team *Team `json:"team,omitempty"`
+	}
+	Headers PostTeamAdd201ResponseHeaders
 }
 
 func (response PostTeamAdd201JSONResponse) VisitPostTeamAddResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("ETag", fmt.Sprint(response.Headers.ETag))
 	w.WriteHeader(201)
 
-	return json.NewEncoder(w).Encode(response)
+	return json.NewEncoder(w).Encode(response.Body)
 }
 
 type PostTeamAdd400JSONResponse ErrorResponse
@@ -163,6 +791,15 @@ func (response PostTeamAdd400JSONResponse) VisitPostTeamAddResponse(w http.Respo
 	return json.NewEncoder(w).Encode(response)
 }
 
+type PostTeamAdd500JSONResponse struct{ InternalErrorJSONResponse }
+
+func (response PostTeamAdd500JSONResponse) VisitPostTeamAddResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
 type GetTeamGetRequestObject struct {
 	Params GetTeamGetParams
 }
@@ -171,12 +808,29 @@ type GetTeamGetResponseObject interface {
 	VisitGetTeamGetResponse(w http.ResponseWriter) error
 }
 
-type GetTeamGet200JSONResponse Team
+type GetTeamGet200ResponseHeaders struct {
+	ETag string
+}
+
+type GetTeamGet200JSONResponse struct {
+	Body    Team
+	Headers GetTeamGet200ResponseHeaders
+}
 
 func (response GetTeamGet200JSONResponse) VisitGetTeamGetResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("ETag", fmt.Sprint(response.Headers.ETag))
 	w.WriteHeader(200)
 
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type GetTeamGet400JSONResponse struct{ ValidationErrorJSONResponse }
+
+func (response GetTeamGet400JSONResponse) VisitGetTeamGetResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
 	return json.NewEncoder(w).Encode(response)
 }
 
@@ -189,14 +843,297 @@ func (response GetTeamGet404JSONResponse) VisitGetTeamGetResponse(w http.Respons
 	return json.NewEncoder(w).Encode(response)
 }
 
-// StrictServerInterface represents all server handlers.
-type StrictServerInterface interface {
-	// Создать команду с участниками (создаёт/обновляет пользователей)
-	// (POST /team/add)
+type GetTeamGet500JSONResponse struct{ InternalErrorJSONResponse }
+
+func (response GetTeamGet500JSONResponse) VisitGetTeamGetResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTeamSetDefaultMaxOpenReviewsRequestObject struct {
+	Params PostTeamSetDefaultMaxOpenReviewsParams
+	Body   *PostTeamSetDefaultMaxOpenReviewsJSONRequestBody
+}
+
+type PostTeamSetDefaultMaxOpenReviewsResponseObject interface {
+	VisitPostTeamSetDefaultMaxOpenReviewsResponse(w http.ResponseWriter) error
+}
+
+type PostTeamSetDefaultMaxOpenReviews200ResponseHeaders struct {
+	ETag string
+}
+
+type PostTeamSetDefaultMaxOpenReviews200JSONResponse struct {
+	Body struct {
+		Team *Team `json:"team,omitempty"`
+	}
+	Headers PostTeamSetDefaultMaxOpenReviews200ResponseHeaders
+}
+
+func (response PostTeamSetDefaultMaxOpenReviews200JSONResponse) VisitPostTeamSetDefaultMaxOpenReviewsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("ETag", fmt.Sprint(response.Headers.ETag))
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type PostTeamSetDefaultMaxOpenReviews400JSONResponse ErrorResponse
+
+func (response PostTeamSetDefaultMaxOpenReviews400JSONResponse) VisitPostTeamSetDefaultMaxOpenReviewsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTeamSetDefaultMaxOpenReviews404JSONResponse ErrorResponse
+
+func (response PostTeamSetDefaultMaxOpenReviews404JSONResponse) VisitPostTeamSetDefaultMaxOpenReviewsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTeamSetDefaultMaxOpenReviews412JSONResponse ErrorResponse
+
+func (response PostTeamSetDefaultMaxOpenReviews412JSONResponse) VisitPostTeamSetDefaultMaxOpenReviewsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(412)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTeamSetDefaultMaxOpenReviews500JSONResponse struct{ InternalErrorJSONResponse }
+
+func (response PostTeamSetDefaultMaxOpenReviews500JSONResponse) VisitPostTeamSetDefaultMaxOpenReviewsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTeamSetFallbacksRequestObject struct {
+	Params PostTeamSetFallbacksParams
+	Body   *PostTeamSetFallbacksJSONRequestBody
+}
+
+type PostTeamSetFallbacksResponseObject interface {
+	VisitPostTeamSetFallbacksResponse(w http.ResponseWriter) error
+}
+
+type PostTeamSetFallbacks200ResponseHeaders struct {
+	ETag string
+}
+
+type PostTeamSetFallbacks200JSONResponse struct {
+	Body struct {
+		Team *Team `json:"team,omitempty"`
+	}
+	Headers PostTeamSetFallbacks200ResponseHeaders
+}
+
+func (response PostTeamSetFallbacks200JSONResponse) VisitPostTeamSetFallbacksResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("ETag", fmt.Sprint(response.Headers.ETag))
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type PostTeamSetFallbacks400JSONResponse ErrorResponse
+
+func (response PostTeamSetFallbacks400JSONResponse) VisitPostTeamSetFallbacksResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTeamSetFallbacks404JSONResponse ErrorResponse
+
+func (response PostTeamSetFallbacks404JSONResponse) VisitPostTeamSetFallbacksResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTeamSetFallbacks412JSONResponse ErrorResponse
+
+func (response PostTeamSetFallbacks412JSONResponse) VisitPostTeamSetFallbacksResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(412)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTeamSetFallbacks500JSONResponse struct{ InternalErrorJSONResponse }
+
+func (response PostTeamSetFallbacks500JSONResponse) VisitPostTeamSetFallbacksResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTeamSetReviewPolicyRequestObject struct {
+	Params PostTeamSetReviewPolicyParams
+	Body   *PostTeamSetReviewPolicyJSONRequestBody
+}
+
+type PostTeamSetReviewPolicyResponseObject interface {
+	VisitPostTeamSetReviewPolicyResponse(w http.ResponseWriter) error
+}
+
+type PostTeamSetReviewPolicy200ResponseHeaders struct {
+	ETag string
+}
+
+type PostTeamSetReviewPolicy200JSONResponse struct {
+	Body struct {
+		Team *Team `json:"team,omitempty"`
+	}
+	Headers PostTeamSetReviewPolicy200ResponseHeaders
+}
+
+func (response PostTeamSetReviewPolicy200JSONResponse) VisitPostTeamSetReviewPolicyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("ETag", fmt.Sprint(response.Headers.ETag))
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type PostTeamSetReviewPolicy400JSONResponse ErrorResponse
+
+func (response PostTeamSetReviewPolicy400JSONResponse) VisitPostTeamSetReviewPolicyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTeamSetReviewPolicy404JSONResponse ErrorResponse
+
+func (response PostTeamSetReviewPolicy404JSONResponse) VisitPostTeamSetReviewPolicyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTeamSetReviewPolicy412JSONResponse ErrorResponse
+
+func (response PostTeamSetReviewPolicy412JSONResponse) VisitPostTeamSetReviewPolicyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(412)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTeamSetReviewPolicy500JSONResponse struct{ InternalErrorJSONResponse }
+
+func (response PostTeamSetReviewPolicy500JSONResponse) VisitPostTeamSetReviewPolicyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTeamSetSizeRulesRequestObject struct {
+	Params PostTeamSetSizeRulesParams
+	Body   *PostTeamSetSizeRulesJSONRequestBody
+}
+
+type PostTeamSetSizeRulesResponseObject interface {
+	VisitPostTeamSetSizeRulesResponse(w http.ResponseWriter) error
+}
+
+type PostTeamSetSizeRules200ResponseHeaders struct {
+	ETag string
+}
+
+type PostTeamSetSizeRules200JSONResponse struct {
+	Body struct {
+		Team *Team `json:"team,omitempty"`
+	}
+	Headers PostTeamSetSizeRules200ResponseHeaders
+}
+
+func (response PostTeamSetSizeRules200JSONResponse) VisitPostTeamSetSizeRulesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("ETag", fmt.Sprint(response.Headers.ETag))
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type PostTeamSetSizeRules400JSONResponse ErrorResponse
+
+func (response PostTeamSetSizeRules400JSONResponse) VisitPostTeamSetSizeRulesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTeamSetSizeRules404JSONResponse ErrorResponse
+
+func (response PostTeamSetSizeRules404JSONResponse) VisitPostTeamSetSizeRulesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTeamSetSizeRules412JSONResponse ErrorResponse
+
+func (response PostTeamSetSizeRules412JSONResponse) VisitPostTeamSetSizeRulesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(412)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTeamSetSizeRules500JSONResponse struct{ InternalErrorJSONResponse }
+
+func (response PostTeamSetSizeRules500JSONResponse) VisitPostTeamSetSizeRulesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+// StrictServerInterface represents all server handlers.
+type StrictServerInterface interface {
+	// Получить общий пул ревьюверов
+	// (GET /pools/get)
+	GetPoolsGet(ctx context.Context, request GetPoolsGetRequestObject) (GetPoolsGetResponseObject, error)
+	// Создать или заменить общий пул ревьюверов
+	// (POST /pools/set)
+	PostPoolsSet(ctx context.Context, request PostPoolsSetRequestObject) (PostPoolsSetResponseObject, error)
+	// Создать команду с участниками (создаёт/обновляет пользователей)
+	// (POST /team/add)
 	PostTeamAdd(ctx context.Context, request PostTeamAddRequestObject) (PostTeamAddResponseObject, error)
 	// Получить команду с участниками
 	// (GET /team/get)
 	GetTeamGet(ctx context.Context, request GetTeamGetRequestObject) (GetTeamGetResponseObject, error)
+	// Установить лимит открытых ревью по умолчанию для участников команды
+	// (POST /team/setDefaultMaxOpenReviews)
+	PostTeamSetDefaultMaxOpenReviews(ctx context.Context, request PostTeamSetDefaultMaxOpenReviewsRequestObject) (PostTeamSetDefaultMaxOpenReviewsResponseObject, error)
+	// Задать резервные команды и общие пулы ревьюверов
+	// (POST /team/setFallbacks)
+	PostTeamSetFallbacks(ctx context.Context, request PostTeamSetFallbacksRequestObject) (PostTeamSetFallbacksResponseObject, error)
+	// Выбрать режим назначения ревьюверов команды
+	// (POST /team/setReviewPolicy)
+	PostTeamSetReviewPolicy(ctx context.Context, request PostTeamSetReviewPolicyRequestObject) (PostTeamSetReviewPolicyResponseObject, error)
+	// Задать число ревьюверов в зависимости от размера PR
+	// (POST /team/setSizeRules)
+	PostTeamSetSizeRules(ctx context.Context, request PostTeamSetSizeRulesRequestObject) (PostTeamSetSizeRulesResponseObject, error)
 }
 
 type StrictHandlerFunc = strictecho.StrictEchoHandlerFunc
@@ -211,10 +1148,68 @@ type strictHandler struct {
 	middlewares []StrictMiddlewareFunc
 }
 
+// GetPoolsGet operation middleware
+func (sh *strictHandler) GetPoolsGet(ctx echo.Context, params GetPoolsGetParams) error {
+	var request GetPoolsGetRequestObject
+
+	request.Params = params
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetPoolsGet(ctx.Request().Context(), request.(GetPoolsGetRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetPoolsGet")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(GetPoolsGetResponseObject); ok {
+		return validResponse.VisitGetPoolsGetResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// PostPoolsSet operation middleware
+func (sh *strictHandler) PostPoolsSet(ctx echo.Context, params PostPoolsSetParams) error {
+	var request PostPoolsSetRequestObject
+
+	request.Params = params
+
+	var body PostPoolsSetJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostPoolsSet(ctx.Request().Context(), request.(PostPoolsSetRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostPoolsSet")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(PostPoolsSetResponseObject); ok {
+		return validResponse.VisitPostPoolsSetResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
 // PostTeamAdd operation middleware
-func (sh *strictHandler) PostTeamAdd(ctx echo.Context) error {
+func (sh *strictHandler) PostTeamAdd(ctx echo.Context, params PostTeamAddParams) error {
 	var request PostTeamAddRequestObject
 
+	request.Params = params
+
 	var body PostTeamAddJSONRequestBody
 	if err := ctx.Bind(&body); err != nil {
 		return err
@@ -264,3 +1259,127 @@ func (sh *strictHandler) GetTeamGet(ctx echo.Context, params GetTeamGetParams) e
 	}
 	return nil
 }
+
+// PostTeamSetDefaultMaxOpenReviews operation middleware
+func (sh *strictHandler) PostTeamSetDefaultMaxOpenReviews(ctx echo.Context, params PostTeamSetDefaultMaxOpenReviewsParams) error {
+	var request PostTeamSetDefaultMaxOpenReviewsRequestObject
+
+	request.Params = params
+
+	var body PostTeamSetDefaultMaxOpenReviewsJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostTeamSetDefaultMaxOpenReviews(ctx.Request().Context(), request.(PostTeamSetDefaultMaxOpenReviewsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostTeamSetDefaultMaxOpenReviews")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(PostTeamSetDefaultMaxOpenReviewsResponseObject); ok {
+		return validResponse.VisitPostTeamSetDefaultMaxOpenReviewsResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// PostTeamSetFallbacks operation middleware
+func (sh *strictHandler) PostTeamSetFallbacks(ctx echo.Context, params PostTeamSetFallbacksParams) error {
+	var request PostTeamSetFallbacksRequestObject
+
+	request.Params = params
+
+	var body PostTeamSetFallbacksJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostTeamSetFallbacks(ctx.Request().Context(), request.(PostTeamSetFallbacksRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostTeamSetFallbacks")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(PostTeamSetFallbacksResponseObject); ok {
+		return validResponse.VisitPostTeamSetFallbacksResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// PostTeamSetReviewPolicy operation middleware
+func (sh *strictHandler) PostTeamSetReviewPolicy(ctx echo.Context, params PostTeamSetReviewPolicyParams) error {
+	var request PostTeamSetReviewPolicyRequestObject
+
+	request.Params = params
+
+	var body PostTeamSetReviewPolicyJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostTeamSetReviewPolicy(ctx.Request().Context(), request.(PostTeamSetReviewPolicyRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostTeamSetReviewPolicy")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(PostTeamSetReviewPolicyResponseObject); ok {
+		return validResponse.VisitPostTeamSetReviewPolicyResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// PostTeamSetSizeRules operation middleware
+func (sh *strictHandler) PostTeamSetSizeRules(ctx echo.Context, params PostTeamSetSizeRulesParams) error {
+	var request PostTeamSetSizeRulesRequestObject
+
+	request.Params = params
+
+	var body PostTeamSetSizeRulesJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostTeamSetSizeRules(ctx.Request().Context(), request.(PostTeamSetSizeRulesRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostTeamSetSizeRules")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(PostTeamSetSizeRulesResponseObject); ok {
+		return validResponse.VisitPostTeamSetSizeRulesResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
@@ -8,20 +8,40 @@ import (
 	"encoding/json"
 	"fmt"
 	"net/http"
+	"time"
 
 	"github.com/labstack/echo/v4"
 	"github.com/oapi-codegen/runtime"
 	strictecho "github.com/oapi-codegen/runtime/strictmiddleware/echo"
 )
 
+const (
+	BearerAuthScopes = "bearerAuth.Scopes"
+)
+
 // Defines values for ErrorResponseErrorCode.
 const (
-	NOCANDIDATE ErrorResponseErrorCode = "NO_CANDIDATE"
-	NOTASSIGNED ErrorResponseErrorCode = "NOT_ASSIGNED"
-	NOTFOUND    ErrorResponseErrorCode = "NOT_FOUND"
-	PREXISTS    ErrorResponseErrorCode = "PR_EXISTS"
-	PRMERGED    ErrorResponseErrorCode = "PR_MERGED"
-	TEAMEXISTS  ErrorResponseErrorCode = "TEAM_EXISTS"
+	BADREQUEST               ErrorResponseErrorCode = "BAD_REQUEST"
+	FORBIDDEN                ErrorResponseErrorCode = "FORBIDDEN"
+	IDEMPOTENCYKEYINPROGRESS ErrorResponseErrorCode = "IDEMPOTENCY_KEY_IN_PROGRESS"
+	IDEMPOTENCYKEYREUSED     ErrorResponseErrorCode = "IDEMPOTENCY_KEY_REUSED"
+	INTERNALERROR            ErrorResponseErrorCode = "INTERNAL_ERROR"
+	NOCANDIDATE              ErrorResponseErrorCode = "NO_CANDIDATE"
+	NOTASSIGNED              ErrorResponseErrorCode = "NOT_ASSIGNED"
+	NOTFOUND                 ErrorResponseErrorCode = "NOT_FOUND"
+	PREXISTS                 ErrorResponseErrorCode = "PR_EXISTS"
+	PRMERGED                 ErrorResponseErrorCode = "PR_MERGED"
+	RATELIMITED              ErrorResponseErrorCode = "RATE_LIMITED"
+	TEAMEXISTS               ErrorResponseErrorCode = "TEAM_EXISTS"
+	UNAUTHORIZED             ErrorResponseErrorCode = "UNAUTHORIZED"
+	VERSIONMISMATCH          ErrorResponseErrorCode = "VERSION_MISMATCH"
+)
+
+// Defines values for Priority.
+const (
+	Low    Priority = "low"
+	Normal Priority = "normal"
+	Urgent Priority = "urgent"
 )
 
 // Defines values for PullRequestShortStatus.
@@ -30,43 +50,215 @@ const (
 	OPEN   PullRequestShortStatus = "OPEN"
 )
 
+// Defines values for Seniority.
+const (
+	Junior Seniority = "junior"
+	Senior Seniority = "senior"
+)
+
 // ErrorResponse defines model for ErrorResponse.
 type ErrorResponse struct {
 	Error struct {
-		Code    ErrorResponseErrorCode `json:"code"`
-		Message string                 `json:"message"`
+		Code ErrorResponseErrorCode `json:"code"`
+
+		// Fields Некорректные поля запроса, если он не прошёл проверку по схеме
+		Fields *[]struct {
+			// Field Путь к полю тела через точку (members.1.user_id) или имя параметра
+			Field   string `json:"field"`
+			Message string `json:"message"`
+		} `json:"fields,omitempty"`
+		Message string `json:"message"`
+
+		// RequestId X-Request-ID запроса, по нему ошибку можно найти в логах
+		RequestId *string `json:"request_id,omitempty"`
 	} `json:"error"`
 }
 
 // ErrorResponseErrorCode defines model for ErrorResponse.Error.Code.
 type ErrorResponseErrorCode string
 
+// Priority Приоритет PR; срочные PR получают наименее загруженные и самые быстрые ревьюверы
+type Priority string
+
+// PullRequestId defines model for PullRequestId.
+type PullRequestId = string
+
+// PullRequestName defines model for PullRequestName.
+type PullRequestName = string
+
 // PullRequestShort defines model for PullRequestShort.
 type PullRequestShort struct {
-	AuthorId        string                 `json:"author_id"`
-	PullRequestId   string                 `json:"pull_request_id"`
-	PullRequestName string                 `json:"pull_request_name"`
-	Status          PullRequestShortStatus `json:"status"`
+	// Additions Добавленные строки
+	Additions *int `json:"additions,omitempty"`
+
+	// AuthorId Идентификатор пользователя, без пробелов
+	AuthorId UserId `json:"author_id"`
+
+	// Deletions Удалённые строки
+	Deletions *int       `json:"deletions,omitempty"`
+	DueAt     *time.Time `json:"due_at"`
+	Labels    *[]Tag     `json:"labels,omitempty"`
+
+	// Priority Приоритет PR; срочные PR получают наименее загруженные и самые быстрые ревьюверы
+	Priority        *Priority       `json:"priority,omitempty"`
+	PullRequestId   PullRequestId   `json:"pull_request_id"`
+	PullRequestName PullRequestName `json:"pull_request_name"`
+
+	// Repository Репозиторий, например org/service
+	Repository   *string                `json:"repository,omitempty"`
+	SourceBranch *string                `json:"source_branch,omitempty"`
+	Status       PullRequestShortStatus `json:"status"`
+	TargetBranch *string                `json:"target_branch,omitempty"`
+
+	// Url Ссылка на PR в системе контроля версий
+	Url *string `json:"url,omitempty"`
 }
 
 // PullRequestShortStatus defines model for PullRequestShort.Status.
 type PullRequestShortStatus string
 
+// ReviewerExclusion defines model for ReviewerExclusion.
+type ReviewerExclusion struct {
+	// AuthorId Идентификатор пользователя, без пробелов
+	AuthorId  UserId    `json:"author_id"`
+	CreatedAt time.Time `json:"created_at"`
+	Reason    string    `json:"reason"`
+
+	// ReviewerId Идентификатор пользователя, без пробелов
+	ReviewerId UserId `json:"reviewer_id"`
+
+	// Symmetric Запрет действует в обе стороны
+	Symmetric bool `json:"symmetric"`
+}
+
+// ReviewerReassignment defines model for ReviewerReassignment.
+type ReviewerReassignment struct {
+	// NewReviewerId Новый ревьювер или null, если ревьювер снят из-за отсутствия кандидатов
+	NewReviewerId *string `json:"new_reviewer_id"`
+
+	// OldReviewerId Идентификатор пользователя, без пробелов
+	OldReviewerId UserId `json:"old_reviewer_id"`
+
+	// PairingBroken В команде режим наставничества, но замену того же уровня найти не удалось
+	PairingBroken *bool         `json:"pairing_broken,omitempty"`
+	PullRequestId PullRequestId `json:"pull_request_id"`
+}
+
+// Seniority Уровень пользователя для режима наставничества
+type Seniority string
+
+// Tag Тег навыка или метка PR
+type Tag = string
+
+// TeamName Имя команды, без пробелов по краям
+type TeamName = string
+
 // User defines model for User.
 type User struct {
-	IsActive bool   `json:"is_active"`
-	TeamName string `json:"team_name"`
-	UserId   string `json:"user_id"`
-	Username string `json:"username"`
+	IsActive       bool `json:"is_active"`
+	MaxOpenReviews *int `json:"max_open_reviews"`
+
+	// Seniority Уровень пользователя для режима наставничества
+	Seniority *Seniority `json:"seniority,omitempty"`
+
+	// TeamName Имя команды, без пробелов по краям
+	TeamName TeamName `json:"team_name"`
+
+	// UserId Идентификатор пользователя, без пробелов
+	UserId   UserId   `json:"user_id"`
+	Username Username `json:"username"`
+}
+
+// UserId Идентификатор пользователя, без пробелов
+type UserId = string
+
+// UserSkills defines model for UserSkills.
+type UserSkills struct {
+	Skills []Tag `json:"skills"`
+
+	// UserId Идентификатор пользователя, без пробелов
+	UserId UserId `json:"user_id"`
 }
 
-// UserIdQuery defines model for UserIdQuery.
-type UserIdQuery = string
+// Username defines model for Username.
+type Username = string
+
+// IdempotencyKey defines model for IdempotencyKey.
+type IdempotencyKey = string
+
+// UserIdQuery Идентификатор пользователя, без пробелов
+type UserIdQuery = UserId
+
+// InternalError defines model for InternalError.
+type InternalError = ErrorResponse
+
+// ValidationError defines model for ValidationError.
+type ValidationError = ErrorResponse
 
 // PostUsersDeactivateJSONBody defines parameters for PostUsersDeactivate.
 type PostUsersDeactivateJSONBody struct {
-	TeamName string   `json:"team_name"`
-	UserIds  []string `json:"user_ids"`
+	// DryRun Выполнить алгоритм и откатить транзакцию, вернув только план
+	DryRun *bool `json:"dry_run,omitempty"`
+
+	// TeamName Имя команды, без пробелов по краям
+	TeamName TeamName `json:"team_name"`
+	UserIds  []UserId `json:"user_ids"`
+}
+
+// PostUsersDeactivateParams defines parameters for PostUsersDeactivate.
+type PostUsersDeactivateParams struct {
+	// IdempotencyKey Ключ повторной отправки. Первый ответ (кроме 5xx) сохраняется на IDEMPOTENCY_TTL
+	// и возвращается повторным запросам того же клиента с тем же ключом, путём и телом
+	// с заголовком `Idempotent-Replayed: true`. Тот же ключ с другим запросом даёт
+	// 422 IDEMPOTENCY_KEY_REUSED, повтор во время выполнения первого запроса — 409
+	// IDEMPOTENCY_KEY_IN_PROGRESS.
+	IdempotencyKey *IdempotencyKey `json:"Idempotency-Key,omitempty"`
+}
+
+// GetUsersExclusionsParams defines parameters for GetUsersExclusions.
+type GetUsersExclusionsParams struct {
+	// UserId Идентификатор пользователя
+	UserId UserIdQuery `form:"user_id" json:"user_id"`
+}
+
+// PostUsersExclusionsAddJSONBody defines parameters for PostUsersExclusionsAdd.
+type PostUsersExclusionsAddJSONBody struct {
+	// AuthorId Идентификатор пользователя, без пробелов
+	AuthorId UserId  `json:"author_id"`
+	Reason   *string `json:"reason,omitempty"`
+
+	// ReviewerId Идентификатор пользователя, без пробелов
+	ReviewerId UserId `json:"reviewer_id"`
+	Symmetric  *bool  `json:"symmetric,omitempty"`
+}
+
+// PostUsersExclusionsAddParams defines parameters for PostUsersExclusionsAdd.
+type PostUsersExclusionsAddParams struct {
+	// IdempotencyKey Ключ повторной отправки. Первый ответ (кроме 5xx) сохраняется на IDEMPOTENCY_TTL
+	// и возвращается повторным запросам того же клиента с тем же ключом, путём и телом
+	// с заголовком `Idempotent-Replayed: true`. Тот же ключ с другим запросом даёт
+	// 422 IDEMPOTENCY_KEY_REUSED, повтор во время выполнения первого запроса — 409
+	// IDEMPOTENCY_KEY_IN_PROGRESS.
+	IdempotencyKey *IdempotencyKey `json:"Idempotency-Key,omitempty"`
+}
+
+// PostUsersExclusionsDeleteJSONBody defines parameters for PostUsersExclusionsDelete.
+type PostUsersExclusionsDeleteJSONBody struct {
+	// AuthorId Идентификатор пользователя, без пробелов
+	AuthorId UserId `json:"author_id"`
+
+	// ReviewerId Идентификатор пользователя, без пробелов
+	ReviewerId UserId `json:"reviewer_id"`
+}
+
+// PostUsersExclusionsDeleteParams defines parameters for PostUsersExclusionsDelete.
+type PostUsersExclusionsDeleteParams struct {
+	// IdempotencyKey Ключ повторной отправки. Первый ответ (кроме 5xx) сохраняется на IDEMPOTENCY_TTL
+	// и возвращается повторным запросам того же клиента с тем же ключом, путём и телом
+	// с заголовком `Idempotent-Replayed: true`. Тот же ключ с другим запросом даёт
+	// 422 IDEMPOTENCY_KEY_REUSED, повтор во время выполнения первого запроса — 409
+	// IDEMPOTENCY_KEY_IN_PROGRESS.
+	IdempotencyKey *IdempotencyKey `json:"Idempotency-Key,omitempty"`
 }
 
 // GetUsersGetReviewParams defines parameters for GetUsersGetReview.
@@ -77,27 +269,167 @@ type GetUsersGetReviewParams struct {
 
 // PostUsersSetIsActiveJSONBody defines parameters for PostUsersSetIsActive.
 type PostUsersSetIsActiveJSONBody struct {
-	IsActive bool   `json:"is_active"`
-	UserId   string `json:"user_id"`
+	IsActive bool `json:"is_active"`
+
+	// ReassignReviews При деактивации переназначить открытые ревью пользователя
+	ReassignReviews *bool `json:"reassign_reviews,omitempty"`
+
+	// RestoreReviews При активации вернуть пользователю его ревью, если PR ещё открыты
+	RestoreReviews *bool `json:"restore_reviews,omitempty"`
+
+	// UserId Идентификатор пользователя, без пробелов
+	UserId UserId `json:"user_id"`
+}
+
+// PostUsersSetIsActiveParams defines parameters for PostUsersSetIsActive.
+type PostUsersSetIsActiveParams struct {
+	// IdempotencyKey Ключ повторной отправки. Первый ответ (кроме 5xx) сохраняется на IDEMPOTENCY_TTL
+	// и возвращается повторным запросам того же клиента с тем же ключом, путём и телом
+	// с заголовком `Idempotent-Replayed: true`. Тот же ключ с другим запросом даёт
+	// 422 IDEMPOTENCY_KEY_REUSED, повтор во время выполнения первого запроса — 409
+	// IDEMPOTENCY_KEY_IN_PROGRESS.
+	IdempotencyKey *IdempotencyKey `json:"Idempotency-Key,omitempty"`
+}
+
+// PostUsersSetMaxOpenReviewsJSONBody defines parameters for PostUsersSetMaxOpenReviews.
+type PostUsersSetMaxOpenReviewsJSONBody struct {
+	// MaxOpenReviews null — использовать лимит команды
+	MaxOpenReviews *int `json:"max_open_reviews"`
+
+	// UserId Идентификатор пользователя, без пробелов
+	UserId UserId `json:"user_id"`
+}
+
+// PostUsersSetMaxOpenReviewsParams defines parameters for PostUsersSetMaxOpenReviews.
+type PostUsersSetMaxOpenReviewsParams struct {
+	// IdempotencyKey Ключ повторной отправки. Первый ответ (кроме 5xx) сохраняется на IDEMPOTENCY_TTL
+	// и возвращается повторным запросам того же клиента с тем же ключом, путём и телом
+	// с заголовком `Idempotent-Replayed: true`. Тот же ключ с другим запросом даёт
+	// 422 IDEMPOTENCY_KEY_REUSED, повтор во время выполнения первого запроса — 409
+	// IDEMPOTENCY_KEY_IN_PROGRESS.
+	IdempotencyKey *IdempotencyKey `json:"Idempotency-Key,omitempty"`
+}
+
+// PostUsersSetSeniorityJSONBody defines parameters for PostUsersSetSeniority.
+type PostUsersSetSeniorityJSONBody struct {
+	// Seniority Уровень пользователя для режима наставничества
+	Seniority Seniority `json:"seniority"`
+
+	// UserId Идентификатор пользователя, без пробелов
+	UserId UserId `json:"user_id"`
+}
+
+// PostUsersSetSeniorityParams defines parameters for PostUsersSetSeniority.
+type PostUsersSetSeniorityParams struct {
+	// IdempotencyKey Ключ повторной отправки. Первый ответ (кроме 5xx) сохраняется на IDEMPOTENCY_TTL
+	// и возвращается повторным запросам того же клиента с тем же ключом, путём и телом
+	// с заголовком `Idempotent-Replayed: true`. Тот же ключ с другим запросом даёт
+	// 422 IDEMPOTENCY_KEY_REUSED, повтор во время выполнения первого запроса — 409
+	// IDEMPOTENCY_KEY_IN_PROGRESS.
+	IdempotencyKey *IdempotencyKey `json:"Idempotency-Key,omitempty"`
+}
+
+// GetUsersSkillsParams defines parameters for GetUsersSkills.
+type GetUsersSkillsParams struct {
+	// UserId Идентификатор пользователя
+	UserId UserIdQuery `form:"user_id" json:"user_id"`
+}
+
+// PostUsersSkillsAddJSONBody defines parameters for PostUsersSkillsAdd.
+type PostUsersSkillsAddJSONBody struct {
+	Skills []Tag `json:"skills"`
+
+	// UserId Идентификатор пользователя, без пробелов
+	UserId UserId `json:"user_id"`
+}
+
+// PostUsersSkillsAddParams defines parameters for PostUsersSkillsAdd.
+type PostUsersSkillsAddParams struct {
+	// IdempotencyKey Ключ повторной отправки. Первый ответ (кроме 5xx) сохраняется на IDEMPOTENCY_TTL
+	// и возвращается повторным запросам того же клиента с тем же ключом, путём и телом
+	// с заголовком `Idempotent-Replayed: true`. Тот же ключ с другим запросом даёт
+	// 422 IDEMPOTENCY_KEY_REUSED, повтор во время выполнения первого запроса — 409
+	// IDEMPOTENCY_KEY_IN_PROGRESS.
+	IdempotencyKey *IdempotencyKey `json:"Idempotency-Key,omitempty"`
+}
+
+// PostUsersSkillsRemoveJSONBody defines parameters for PostUsersSkillsRemove.
+type PostUsersSkillsRemoveJSONBody struct {
+	Skills []Tag `json:"skills"`
+
+	// UserId Идентификатор пользователя, без пробелов
+	UserId UserId `json:"user_id"`
+}
+
+// PostUsersSkillsRemoveParams defines parameters for PostUsersSkillsRemove.
+type PostUsersSkillsRemoveParams struct {
+	// IdempotencyKey Ключ повторной отправки. Первый ответ (кроме 5xx) сохраняется на IDEMPOTENCY_TTL
+	// и возвращается повторным запросам того же клиента с тем же ключом, путём и телом
+	// с заголовком `Idempotent-Replayed: true`. Тот же ключ с другим запросом даёт
+	// 422 IDEMPOTENCY_KEY_REUSED, повтор во время выполнения первого запроса — 409
+	// IDEMPOTENCY_KEY_IN_PROGRESS.
+	IdempotencyKey *IdempotencyKey `json:"Idempotency-Key,omitempty"`
 }
 
 // PostUsersDeactivateJSONRequestBody defines body for PostUsersDeactivate for application/json ContentType.
 type PostUsersDeactivateJSONRequestBody PostUsersDeactivateJSONBody
 
+// PostUsersExclusionsAddJSONRequestBody defines body for PostUsersExclusionsAdd for application/json ContentType.
+type PostUsersExclusionsAddJSONRequestBody PostUsersExclusionsAddJSONBody
+
+// PostUsersExclusionsDeleteJSONRequestBody defines body for PostUsersExclusionsDelete for application/json ContentType.
+type PostUsersExclusionsDeleteJSONRequestBody PostUsersExclusionsDeleteJSONBody
+
 // PostUsersSetIsActiveJSONRequestBody defines body for PostUsersSetIsActive for application/json ContentType.
 type PostUsersSetIsActiveJSONRequestBody PostUsersSetIsActiveJSONBody
 
+// PostUsersSetMaxOpenReviewsJSONRequestBody defines body for PostUsersSetMaxOpenReviews for application/json ContentType.
+type PostUsersSetMaxOpenReviewsJSONRequestBody PostUsersSetMaxOpenReviewsJSONBody
+
+// PostUsersSetSeniorityJSONRequestBody defines body for PostUsersSetSeniority for application/json ContentType.
+type PostUsersSetSeniorityJSONRequestBody PostUsersSetSeniorityJSONBody
+
+// PostUsersSkillsAddJSONRequestBody defines body for PostUsersSkillsAdd for application/json ContentType.
+type PostUsersSkillsAddJSONRequestBody PostUsersSkillsAddJSONBody
+
+// PostUsersSkillsRemoveJSONRequestBody defines body for PostUsersSkillsRemove for application/json ContentType.
+type PostUsersSkillsRemoveJSONRequestBody PostUsersSkillsRemoveJSONBody
+
 // ServerInterface represents all server handlers.
 type ServerInterface interface {
 	// Массово деактивировать пользователей команды и переназначить открытые PR
 	// (POST /users/deactivate)
-	PostUsersDeactivate(ctx echo.Context) error
+	PostUsersDeactivate(ctx echo.Context, params PostUsersDeactivateParams) error
+	// Получить запреты на ревью, в которых участвует пользователь
+	// (GET /users/exclusions)
+	GetUsersExclusions(ctx echo.Context, params GetUsersExclusionsParams) error
+	// Запретить пользователю ревьюить PR автора
+	// (POST /users/exclusions/add)
+	PostUsersExclusionsAdd(ctx echo.Context, params PostUsersExclusionsAddParams) error
+	// Снять запрет на ревью
+	// (POST /users/exclusions/delete)
+	PostUsersExclusionsDelete(ctx echo.Context, params PostUsersExclusionsDeleteParams) error
 	// Получить PR'ы, где пользователь назначен ревьювером
 	// (GET /users/getReview)
 	GetUsersGetReview(ctx echo.Context, params GetUsersGetReviewParams) error
 	// Установить флаг активности пользователя
 	// (POST /users/setIsActive)
-	PostUsersSetIsActive(ctx echo.Context) error
+	PostUsersSetIsActive(ctx echo.Context, params PostUsersSetIsActiveParams) error
+	// Установить пользователю лимит открытых ревью
+	// (POST /users/setMaxOpenReviews)
+	PostUsersSetMaxOpenReviews(ctx echo.Context, params PostUsersSetMaxOpenReviewsParams) error
+	// Установить уровень пользователя (junior или senior)
+	// (POST /users/setSeniority)
+	PostUsersSetSeniority(ctx echo.Context, params PostUsersSetSeniorityParams) error
+	// Получить теги навыков пользователя
+	// (GET /users/skills)
+	GetUsersSkills(ctx echo.Context, params GetUsersSkillsParams) error
+	// Добавить пользователю теги навыков
+	// (POST /users/skills/add)
+	PostUsersSkillsAdd(ctx echo.Context, params PostUsersSkillsAddParams) error
+	// Удалить у пользователя теги навыков
+	// (POST /users/skills/remove)
+	PostUsersSkillsRemove(ctx echo.Context, params PostUsersSkillsRemoveParams) error
 }
 
 // ServerInterfaceWrapper converts echo contexts to parameters.
@@ -109,8 +441,112 @@ type ServerInterfaceWrapper struct {
 func (w *ServerInterfaceWrapper) PostUsersDeactivate(ctx echo.Context) error {
 	var err error
 
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PostUsersDeactivateParams
+
+	headers := ctx.Request().Header
+	// ------------- Optional header parameter "Idempotency-Key" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("Idempotency-Key")]; found {
+		var IdempotencyKey IdempotencyKey
+		n := len(valueList)
+		if n != 1 {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Expected one value for Idempotency-Key, got %d", n))
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "Idempotency-Key", valueList[0], &IdempotencyKey, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter Idempotency-Key: %s", err))
+		}
+
+		params.IdempotencyKey = &IdempotencyKey
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostUsersDeactivate(ctx, params)
+	return err
+}
+
+// GetUsersExclusions converts echo context to params.
+func (w *ServerInterfaceWrapper) GetUsersExclusions(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetUsersExclusionsParams
+	// ------------- Required query parameter "user_id" -------------
+
+	err = runtime.BindQueryParameter("form", true, true, "user_id", ctx.QueryParams(), &params.UserId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter user_id: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetUsersExclusions(ctx, params)
+	return err
+}
+
+// PostUsersExclusionsAdd converts echo context to params.
+func (w *ServerInterfaceWrapper) PostUsersExclusionsAdd(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PostUsersExclusionsAddParams
+
+	headers := ctx.Request().Header
+	// ------------- Optional header parameter "Idempotency-Key" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("Idempotency-Key")]; found {
+		var IdempotencyKey IdempotencyKey
+		n := len(valueList)
+		if n != 1 {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Expected one value for Idempotency-Key, got %d", n))
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "Idempotency-Key", valueList[0], &IdempotencyKey, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter Idempotency-Key: %s", err))
+		}
+
+		params.IdempotencyKey = &IdempotencyKey
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostUsersExclusionsAdd(ctx, params)
+	return err
+}
+
+// PostUsersExclusionsDelete converts echo context to params.
+func (w *ServerInterfaceWrapper) PostUsersExclusionsDelete(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PostUsersExclusionsDeleteParams
+
+	headers := ctx.Request().Header
+	// ------------- Optional header parameter "Idempotency-Key" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("Idempotency-Key")]; found {
+		var IdempotencyKey IdempotencyKey
+		n := len(valueList)
+		if n != 1 {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Expected one value for Idempotency-Key, got %d", n))
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "Idempotency-Key", valueList[0], &IdempotencyKey, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter Idempotency-Key: %s", err))
+		}
+
+		params.IdempotencyKey = &IdempotencyKey
+	}
+
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.PostUsersDeactivate(ctx)
+	err = w.Handler.PostUsersExclusionsDelete(ctx, params)
 	return err
 }
 
@@ -118,6 +554,8 @@ func (w *ServerInterfaceWrapper) PostUsersDeactivate(ctx echo.Context) error {
 func (w *ServerInterfaceWrapper) GetUsersGetReview(ctx echo.Context) error {
 	var err error
 
+	ctx.Set(BearerAuthScopes, []string{})
+
 	// Parameter object where we will unmarshal all parameters from the context
 	var params GetUsersGetReviewParams
 	// ------------- Required query parameter "user_id" -------------
@@ -136,8 +574,174 @@ func (w *ServerInterfaceWrapper) GetUsersGetReview(ctx echo.Context) error {
 func (w *ServerInterfaceWrapper) PostUsersSetIsActive(ctx echo.Context) error {
 	var err error
 
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PostUsersSetIsActiveParams
+
+	headers := ctx.Request().Header
+	// ------------- Optional header parameter "Idempotency-Key" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("Idempotency-Key")]; found {
+		var IdempotencyKey IdempotencyKey
+		n := len(valueList)
+		if n != 1 {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Expected one value for Idempotency-Key, got %d", n))
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "Idempotency-Key", valueList[0], &IdempotencyKey, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter Idempotency-Key: %s", err))
+		}
+
+		params.IdempotencyKey = &IdempotencyKey
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostUsersSetIsActive(ctx, params)
+	return err
+}
+
+// PostUsersSetMaxOpenReviews converts echo context to params.
+func (w *ServerInterfaceWrapper) PostUsersSetMaxOpenReviews(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PostUsersSetMaxOpenReviewsParams
+
+	headers := ctx.Request().Header
+	// ------------- Optional header parameter "Idempotency-Key" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("Idempotency-Key")]; found {
+		var IdempotencyKey IdempotencyKey
+		n := len(valueList)
+		if n != 1 {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Expected one value for Idempotency-Key, got %d", n))
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "Idempotency-Key", valueList[0], &IdempotencyKey, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter Idempotency-Key: %s", err))
+		}
+
+		params.IdempotencyKey = &IdempotencyKey
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostUsersSetMaxOpenReviews(ctx, params)
+	return err
+}
+
+// PostUsersSetSeniority converts echo context to params.
+func (w *ServerInterfaceWrapper) PostUsersSetSeniority(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PostUsersSetSeniorityParams
+
+	headers := ctx.Request().Header
+	// ------------- Optional header parameter "Idempotency-Key" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("Idempotency-Key")]; found {
+		var IdempotencyKey IdempotencyKey
+		n := len(valueList)
+		if n != 1 {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Expected one value for Idempotency-Key, got %d", n))
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "Idempotency-Key", valueList[0], &IdempotencyKey, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter Idempotency-Key: %s", err))
+		}
+
+		params.IdempotencyKey = &IdempotencyKey
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostUsersSetSeniority(ctx, params)
+	return err
+}
+
+// GetUsersSkills converts echo context to params.
+func (w *ServerInterfaceWrapper) GetUsersSkills(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetUsersSkillsParams
+	// ------------- Required query parameter "user_id" -------------
+
+	err = runtime.BindQueryParameter("form", true, true, "user_id", ctx.QueryParams(), &params.UserId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter user_id: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetUsersSkills(ctx, params)
+	return err
+}
+
+// PostUsersSkillsAdd converts echo context to params.
+func (w *ServerInterfaceWrapper) PostUsersSkillsAdd(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PostUsersSkillsAddParams
+
+	headers := ctx.Request().Header
+	// ------------- Optional header parameter "Idempotency-Key" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("Idempotency-Key")]; found {
+		var IdempotencyKey IdempotencyKey
+		n := len(valueList)
+		if n != 1 {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Expected one value for Idempotency-Key, got %d", n))
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "Idempotency-Key", valueList[0], &IdempotencyKey, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter Idempotency-Key: %s", err))
+		}
+
+		params.IdempotencyKey = &IdempotencyKey
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostUsersSkillsAdd(ctx, params)
+	return err
+}
+
+// PostUsersSkillsRemove converts echo context to params.
+func (w *ServerInterfaceWrapper) PostUsersSkillsRemove(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PostUsersSkillsRemoveParams
+
+	headers := ctx.Request().Header
+	// ------------- Optional header parameter "Idempotency-Key" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("Idempotency-Key")]; found {
+		var IdempotencyKey IdempotencyKey
+		n := len(valueList)
+		if n != 1 {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Expected one value for Idempotency-Key, got %d", n))
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "Idempotency-Key", valueList[0], &IdempotencyKey, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter Idempotency-Key: %s", err))
+		}
+
+		params.IdempotencyKey = &IdempotencyKey
+	}
+
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.PostUsersSetIsActive(ctx)
+	err = w.Handler.PostUsersSkillsRemove(ctx, params)
 	return err
 }
 
@@ -170,13 +774,26 @@ func RegisterHandlersWithBaseURL(router EchoRouter, si ServerInterface, baseURL
 	}
 
 	router.POST(baseURL+"/users/deactivate", wrapper.PostUsersDeactivate)
+	router.GET(baseURL+"/users/exclusions", wrapper.GetUsersExclusions)
+	router.POST(baseURL+"/users/exclusions/add", wrapper.PostUsersExclusionsAdd)
+	router.POST(baseURL+"/users/exclusions/delete", wrapper.PostUsersExclusionsDelete)
 	router.GET(baseURL+"/users/getReview", wrapper.GetUsersGetReview)
 	router.POST(baseURL+"/users/setIsActive", wrapper.PostUsersSetIsActive)
+	router.POST(baseURL+"/users/setMaxOpenReviews", wrapper.PostUsersSetMaxOpenReviews)
+	router.POST(baseURL+"/users/setSeniority", wrapper.PostUsersSetSeniority)
+	router.GET(baseURL+"/users/skills", wrapper.GetUsersSkills)
+	router.POST(baseURL+"/users/skills/add", wrapper.PostUsersSkillsAdd)
+	router.POST(baseURL+"/users/skills/remove", wrapper.PostUsersSkillsRemove)
 
 }
 
+type InternalErrorJSONResponse ErrorResponse
+
+type ValidationErrorJSONResponse ErrorResponse
+
 type PostUsersDeactivateRequestObject struct {
-	Body *PostUsersDeactivateJSONRequestBody
+	Params PostUsersDeactivateParams
+	Body   *PostUsersDeactivateJSONRequestBody
 }
 
 type PostUsersDeactivateResponseObject interface {
@@ -184,10 +801,14 @@ type PostUsersDeactivateResponseObject interface {
 }
 
 type PostUsersDeactivate200JSONResponse struct {
-	AffectedPrCount          int    `json:"affected_pr_count"`
-	DeactivatedCount         int    `json:"deactivated_count"`
-	ReassignedReviewersCount int    `json:"reassigned_reviewers_count"`
-	TeamName                 string `json:"team_name"`
+	AffectedPrCount          int                    `json:"affected_pr_count"`
+	DeactivatedCount         int                    `json:"deactivated_count"`
+	DryRun                   bool                   `json:"dry_run"`
+	ReassignedReviewersCount int                    `json:"reassigned_reviewers_count"`
+	Reassignments            []ReviewerReassignment `json:"reassignments"`
+
+	// TeamName Имя команды, без пробелов по краям
+	TeamName TeamName `json:"team_name"`
 }
 
 func (response PostUsersDeactivate200JSONResponse) VisitPostUsersDeactivateResponse(w http.ResponseWriter) error {
@@ -215,97 +836,547 @@ func (response PostUsersDeactivate404JSONResponse) VisitPostUsersDeactivateRespo
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetUsersGetReviewRequestObject struct {
-	Params GetUsersGetReviewParams
+type PostUsersDeactivate500JSONResponse struct{ InternalErrorJSONResponse }
+
+func (response PostUsersDeactivate500JSONResponse) VisitPostUsersDeactivateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-type GetUsersGetReviewResponseObject interface {
-	VisitGetUsersGetReviewResponse(w http.ResponseWriter) error
+type GetUsersExclusionsRequestObject struct {
+	Params GetUsersExclusionsParams
 }
 
-type GetUsersGetReview200JSONResponse struct {
-	PullRequests []PullRequestShort `json:"pull_requests"`
-	UserId       string             `json:"user_id"`
+type GetUsersExclusionsResponseObject interface {
+	VisitGetUsersExclusionsResponse(w http.ResponseWriter) error
 }
 
-func (response GetUsersGetReview200JSONResponse) VisitGetUsersGetReviewResponse(w http.ResponseWriter) error {
+type GetUsersExclusions200JSONResponse struct {
+	Exclusions []ReviewerExclusion `json:"exclusions"`
+}
+
+func (response GetUsersExclusions200JSONResponse) VisitGetUsersExclusionsResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type PostUsersSetIsActiveRequestObject struct {
-	Body *PostUsersSetIsActiveJSONRequestBody
-}
+type GetUsersExclusions400JSONResponse struct{ ValidationErrorJSONResponse }
 
-type PostUsersSetIsActiveResponseObject interface {
-	VisitPostUsersSetIsActiveResponse(w http.ResponseWriter) error
-}
+func (response GetUsersExclusions400JSONResponse) VisitGetUsersExclusionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
 
-type PostUsersSetIsActive200JSONResponse struct {
-	User *User `json:"user,omitempty"`
+	return json.NewEncoder(w).Encode(response)
 }
 
-func (response PostUsersSetIsActive200JSONResponse) VisitPostUsersSetIsActiveResponse(w http.ResponseWriter) error {
+type GetUsersExclusions404JSONResponse ErrorResponse
+
+func (response GetUsersExclusions404JSONResponse) VisitGetUsersExclusionsResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	w.WriteHeader(404)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type PostUsersSetIsActive404JSONResponse ErrorResponse
+type GetUsersExclusions500JSONResponse struct{ InternalErrorJSONResponse }
 
-func (response PostUsersSetIsActive404JSONResponse) VisitPostUsersSetIsActiveResponse(w http.ResponseWriter) error {
+func (response GetUsersExclusions500JSONResponse) VisitGetUsersExclusionsResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	w.WriteHeader(500)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-// StrictServerInterface represents all server handlers.
-type StrictServerInterface interface {
-	// Массово деактивировать пользователей команды и переназначить открытые PR
-	// (POST /users/deactivate)
-	PostUsersDeactivate(ctx context.Context, request PostUsersDeactivateRequestObject) (PostUsersDeactivateResponseObject, error)
-	// Получить PR'ы, где пользователь назначен ревьювером
-	// (GET /users/getReview)
-	GetUsersGetReview(ctx context.Context, request GetUsersGetReviewRequestObject) (GetUsersGetReviewResponseObject, error)
-	// Установить флаг активности пользователя
-	// (POST /users/setIsActive)
-	PostUsersSetIsActive(ctx context.Context, request PostUsersSetIsActiveRequestObject) (PostUsersSetIsActiveResponseObject, error)
+type PostUsersExclusionsAddRequestObject struct {
+	Params PostUsersExclusionsAddParams
+	Body   *PostUsersExclusionsAddJSONRequestBody
 }
 
-type StrictHandlerFunc = strictecho.StrictEchoHandlerFunc
-type StrictMiddlewareFunc = strictecho.StrictEchoMiddlewareFunc
-
-func NewStrictHandler(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc) ServerInterface {
-	return &strictHandler{ssi: ssi, middlewares: middlewares}
+type PostUsersExclusionsAddResponseObject interface {
+	VisitPostUsersExclusionsAddResponse(w http.ResponseWriter) error
 }
 
-type strictHandler struct {
-	ssi         StrictServerInterface
-	middlewares []StrictMiddlewareFunc
+type PostUsersExclusionsAdd200JSONResponse struct {
+	Exclusion ReviewerExclusion `json:"exclusion"`
 }
 
-// PostUsersDeactivate operation middleware
-func (sh *strictHandler) PostUsersDeactivate(ctx echo.Context) error {
-	var request PostUsersDeactivateRequestObject
+func (response PostUsersExclusionsAdd200JSONResponse) VisitPostUsersExclusionsAddResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
 
-	var body PostUsersDeactivateJSONRequestBody
-	if err := ctx.Bind(&body); err != nil {
-		return err
-	}
-	request.Body = &body
+	return json.NewEncoder(w).Encode(response)
+}
 
-	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.PostUsersDeactivate(ctx.Request().Context(), request.(PostUsersDeactivateRequestObject))
-	}
-	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "PostUsersDeactivate")
-	}
+type PostUsersExclusionsAdd400JSONResponse ErrorResponse
 
-	response, err := handler(ctx, request)
+func (response PostUsersExclusionsAdd400JSONResponse) VisitPostUsersExclusionsAddResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostUsersExclusionsAdd404JSONResponse ErrorResponse
+
+func (response PostUsersExclusionsAdd404JSONResponse) VisitPostUsersExclusionsAddResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostUsersExclusionsAdd500JSONResponse struct{ InternalErrorJSONResponse }
+
+func (response PostUsersExclusionsAdd500JSONResponse) VisitPostUsersExclusionsAddResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostUsersExclusionsDeleteRequestObject struct {
+	Params PostUsersExclusionsDeleteParams
+	Body   *PostUsersExclusionsDeleteJSONRequestBody
+}
+
+type PostUsersExclusionsDeleteResponseObject interface {
+	VisitPostUsersExclusionsDeleteResponse(w http.ResponseWriter) error
+}
+
+type PostUsersExclusionsDelete204Response struct {
+}
+
+func (response PostUsersExclusionsDelete204Response) VisitPostUsersExclusionsDeleteResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type PostUsersExclusionsDelete400JSONResponse struct{ ValidationErrorJSONResponse }
+
+func (response PostUsersExclusionsDelete400JSONResponse) VisitPostUsersExclusionsDeleteResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostUsersExclusionsDelete404JSONResponse ErrorResponse
+
+func (response PostUsersExclusionsDelete404JSONResponse) VisitPostUsersExclusionsDeleteResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostUsersExclusionsDelete500JSONResponse struct{ InternalErrorJSONResponse }
+
+func (response PostUsersExclusionsDelete500JSONResponse) VisitPostUsersExclusionsDeleteResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetUsersGetReviewRequestObject struct {
+	Params GetUsersGetReviewParams
+}
+
+type GetUsersGetReviewResponseObject interface {
+	VisitGetUsersGetReviewResponse(w http.ResponseWriter) error
+}
+
+type GetUsersGetReview200JSONResponse struct {
+	PullRequests []PullRequestShort `json:"pull_requests"`
+
+	// UserId Идентификатор пользователя, без пробелов
+	UserId UserId `json:"user_id"`
+}
+
+func (response GetUsersGetReview200JSONResponse) VisitGetUsersGetReviewResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetUsersGetReview400JSONResponse struct{ ValidationErrorJSONResponse }
+
+func (response GetUsersGetReview400JSONResponse) VisitGetUsersGetReviewResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetUsersGetReview404JSONResponse ErrorResponse
+
+func (response GetUsersGetReview404JSONResponse) VisitGetUsersGetReviewResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetUsersGetReview500JSONResponse struct{ InternalErrorJSONResponse }
+
+func (response GetUsersGetReview500JSONResponse) VisitGetUsersGetReviewResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostUsersSetIsActiveRequestObject struct {
+	Params PostUsersSetIsActiveParams
+	Body   *PostUsersSetIsActiveJSONRequestBody
+}
+
+type PostUsersSetIsActiveResponseObject interface {
+	VisitPostUsersSetIsActiveResponse(w http.ResponseWriter) error
+}
+
+type PostUsersSetIsActive200JSONResponse struct {
+	Reassignments        *[]ReviewerReassignment `json:"reassignments,omitempty"`
+	RestoredPullRequests *[]string               `json:"restored_pull_requests,omitempty"`
+	User                 *User                   `json:"user,omitempty"`
+}
+
+func (response PostUsersSetIsActive200JSONResponse) VisitPostUsersSetIsActiveResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostUsersSetIsActive400JSONResponse struct{ ValidationErrorJSONResponse }
+
+func (response PostUsersSetIsActive400JSONResponse) VisitPostUsersSetIsActiveResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostUsersSetIsActive404JSONResponse ErrorResponse
+
+func (response PostUsersSetIsActive404JSONResponse) VisitPostUsersSetIsActiveResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostUsersSetIsActive500JSONResponse struct{ InternalErrorJSONResponse }
+
+func (response PostUsersSetIsActive500JSONResponse) VisitPostUsersSetIsActiveResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostUsersSetMaxOpenReviewsRequestObject struct {
+	Params PostUsersSetMaxOpenReviewsParams
+	Body   *PostUsersSetMaxOpenReviewsJSONRequestBody
+}
+
+type PostUsersSetMaxOpenReviewsResponseObject interface {
+	VisitPostUsersSetMaxOpenReviewsResponse(w http.ResponseWriter) error
+}
+
+type PostUsersSetMaxOpenReviews200JSONResponse struct {
+	User *User `json:"user,omitempty"`
+}
+
+func (response PostUsersSetMaxOpenReviews200JSONResponse) VisitPostUsersSetMaxOpenReviewsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostUsersSetMaxOpenReviews400JSONResponse ErrorResponse
+
+func (response PostUsersSetMaxOpenReviews400JSONResponse) VisitPostUsersSetMaxOpenReviewsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostUsersSetMaxOpenReviews404JSONResponse ErrorResponse
+
+func (response PostUsersSetMaxOpenReviews404JSONResponse) VisitPostUsersSetMaxOpenReviewsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostUsersSetMaxOpenReviews500JSONResponse struct{ InternalErrorJSONResponse }
+
+func (response PostUsersSetMaxOpenReviews500JSONResponse) VisitPostUsersSetMaxOpenReviewsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostUsersSetSeniorityRequestObject struct {
+	Params PostUsersSetSeniorityParams
+	Body   *PostUsersSetSeniorityJSONRequestBody
+}
+
+type PostUsersSetSeniorityResponseObject interface {
+	VisitPostUsersSetSeniorityResponse(w http.ResponseWriter) error
+}
+
+type PostUsersSetSeniority200JSONResponse struct {
+	User *User `json:"user,omitempty"`
+}
+
+func (response PostUsersSetSeniority200JSONResponse) VisitPostUsersSetSeniorityResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostUsersSetSeniority400JSONResponse ErrorResponse
+
+func (response PostUsersSetSeniority400JSONResponse) VisitPostUsersSetSeniorityResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostUsersSetSeniority404JSONResponse ErrorResponse
+
+func (response PostUsersSetSeniority404JSONResponse) VisitPostUsersSetSeniorityResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostUsersSetSeniority500JSONResponse struct{ InternalErrorJSONResponse }
+
+func (response PostUsersSetSeniority500JSONResponse) VisitPostUsersSetSeniorityResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetUsersSkillsRequestObject struct {
+	Params GetUsersSkillsParams
+}
+
+type GetUsersSkillsResponseObject interface {
+	VisitGetUsersSkillsResponse(w http.ResponseWriter) error
+}
+
+type GetUsersSkills200JSONResponse UserSkills
+
+func (response GetUsersSkills200JSONResponse) VisitGetUsersSkillsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetUsersSkills400JSONResponse struct{ ValidationErrorJSONResponse }
+
+func (response GetUsersSkills400JSONResponse) VisitGetUsersSkillsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetUsersSkills404JSONResponse ErrorResponse
+
+func (response GetUsersSkills404JSONResponse) VisitGetUsersSkillsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetUsersSkills500JSONResponse struct{ InternalErrorJSONResponse }
+
+func (response GetUsersSkills500JSONResponse) VisitGetUsersSkillsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostUsersSkillsAddRequestObject struct {
+	Params PostUsersSkillsAddParams
+	Body   *PostUsersSkillsAddJSONRequestBody
+}
+
+type PostUsersSkillsAddResponseObject interface {
+	VisitPostUsersSkillsAddResponse(w http.ResponseWriter) error
+}
+
+type PostUsersSkillsAdd200JSONResponse UserSkills
+
+func (response PostUsersSkillsAdd200JSONResponse) VisitPostUsersSkillsAddResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostUsersSkillsAdd400JSONResponse ErrorResponse
+
+func (response PostUsersSkillsAdd400JSONResponse) VisitPostUsersSkillsAddResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostUsersSkillsAdd404JSONResponse ErrorResponse
+
+func (response PostUsersSkillsAdd404JSONResponse) VisitPostUsersSkillsAddResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostUsersSkillsAdd500JSONResponse struct{ InternalErrorJSONResponse }
+
+func (response PostUsersSkillsAdd500JSONResponse) VisitPostUsersSkillsAddResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostUsersSkillsRemoveRequestObject struct {
+	Params PostUsersSkillsRemoveParams
+	Body   *PostUsersSkillsRemoveJSONRequestBody
+}
+
+type PostUsersSkillsRemoveResponseObject interface {
+	VisitPostUsersSkillsRemoveResponse(w http.ResponseWriter) error
+}
+
+type PostUsersSkillsRemove200JSONResponse UserSkills
+
+func (response PostUsersSkillsRemove200JSONResponse) VisitPostUsersSkillsRemoveResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostUsersSkillsRemove400JSONResponse ErrorResponse
+
+func (response PostUsersSkillsRemove400JSONResponse) VisitPostUsersSkillsRemoveResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostUsersSkillsRemove404JSONResponse ErrorResponse
+
+func (response PostUsersSkillsRemove404JSONResponse) VisitPostUsersSkillsRemoveResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostUsersSkillsRemove500JSONResponse struct{ InternalErrorJSONResponse }
+
+func (response PostUsersSkillsRemove500JSONResponse) VisitPostUsersSkillsRemoveResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+// StrictServerInterface represents all server handlers.
+type StrictServerInterface interface {
+	// Массово деактивировать пользователей команды и переназначить открытые PR
+	// (POST /users/deactivate)
+	PostUsersDeactivate(ctx context.Context, request PostUsersDeactivateRequestObject) (PostUsersDeactivateResponseObject, error)
+	// Получить запреты на ревью, в которых участвует пользователь
+	// (GET /users/exclusions)
+	GetUsersExclusions(ctx context.Context, request GetUsersExclusionsRequestObject) (GetUsersExclusionsResponseObject, error)
+	// Запретить пользователю ревьюить PR автора
+	// (POST /users/exclusions/add)
+	PostUsersExclusionsAdd(ctx context.Context, request PostUsersExclusionsAddRequestObject) (PostUsersExclusionsAddResponseObject, error)
+	// Снять запрет на ревью
+	// (POST /users/exclusions/delete)
+	PostUsersExclusionsDelete(ctx context.Context, request PostUsersExclusionsDeleteRequestObject) (PostUsersExclusionsDeleteResponseObject, error)
+	// Получить PR'ы, где пользователь назначен ревьювером
+	// (GET /users/getReview)
+	GetUsersGetReview(ctx context.Context, request GetUsersGetReviewRequestObject) (GetUsersGetReviewResponseObject, error)
+	// Установить флаг активности пользователя
+	// (POST /users/setIsActive)
+	PostUsersSetIsActive(ctx context.Context, request PostUsersSetIsActiveRequestObject) (PostUsersSetIsActiveResponseObject, error)
+	// Установить пользователю лимит открытых ревью
+	// (POST /users/setMaxOpenReviews)
+	PostUsersSetMaxOpenReviews(ctx context.Context, request PostUsersSetMaxOpenReviewsRequestObject) (PostUsersSetMaxOpenReviewsResponseObject, error)
+	// Установить уровень пользователя (junior или senior)
+	// (POST /users/setSeniority)
+	PostUsersSetSeniority(ctx context.Context, request PostUsersSetSeniorityRequestObject) (PostUsersSetSeniorityResponseObject, error)
+	// Получить теги навыков пользователя
+	// (GET /users/skills)
+	GetUsersSkills(ctx context.Context, request GetUsersSkillsRequestObject) (GetUsersSkillsResponseObject, error)
+	// Добавить пользователю теги навыков
+	// (POST /users/skills/add)
+	PostUsersSkillsAdd(ctx context.Context, request PostUsersSkillsAddRequestObject) (PostUsersSkillsAddResponseObject, error)
+	// Удалить у пользователя теги навыков
+	// (POST /users/skills/remove)
+	PostUsersSkillsRemove(ctx context.Context, request PostUsersSkillsRemoveRequestObject) (PostUsersSkillsRemoveResponseObject, error)
+}
+
+type StrictHandlerFunc = strictecho.StrictEchoHandlerFunc
+type StrictMiddlewareFunc = strictecho.StrictEchoMiddlewareFunc
+
+func NewStrictHandler(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc) ServerInterface {
+	return &strictHandler{ssi: ssi, middlewares: middlewares}
+}
+
+type strictHandler struct {
+	ssi         StrictServerInterface
+	middlewares []StrictMiddlewareFunc
+}
+
+// PostUsersDeactivate operation middleware
+func (sh *strictHandler) PostUsersDeactivate(ctx echo.Context, params PostUsersDeactivateParams) error {
+	var request PostUsersDeactivateRequestObject
+
+	request.Params = params
+
+	var body PostUsersDeactivateJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostUsersDeactivate(ctx.Request().Context(), request.(PostUsersDeactivateRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostUsersDeactivate")
+	}
+
+	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
@@ -317,6 +1388,93 @@ func (sh *strictHandler) PostUsersDeactivate(ctx echo.Context) error {
 	return nil
 }
 
+// GetUsersExclusions operation middleware
+func (sh *strictHandler) GetUsersExclusions(ctx echo.Context, params GetUsersExclusionsParams) error {
+	var request GetUsersExclusionsRequestObject
+
+	request.Params = params
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetUsersExclusions(ctx.Request().Context(), request.(GetUsersExclusionsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetUsersExclusions")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(GetUsersExclusionsResponseObject); ok {
+		return validResponse.VisitGetUsersExclusionsResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// PostUsersExclusionsAdd operation middleware
+func (sh *strictHandler) PostUsersExclusionsAdd(ctx echo.Context, params PostUsersExclusionsAddParams) error {
+	var request PostUsersExclusionsAddRequestObject
+
+	request.Params = params
+
+	var body PostUsersExclusionsAddJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostUsersExclusionsAdd(ctx.Request().Context(), request.(PostUsersExclusionsAddRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostUsersExclusionsAdd")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(PostUsersExclusionsAddResponseObject); ok {
+		return validResponse.VisitPostUsersExclusionsAddResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// PostUsersExclusionsDelete operation middleware
+func (sh *strictHandler) PostUsersExclusionsDelete(ctx echo.Context, params PostUsersExclusionsDeleteParams) error {
+	var request PostUsersExclusionsDeleteRequestObject
+
+	request.Params = params
+
+	var body PostUsersExclusionsDeleteJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostUsersExclusionsDelete(ctx.Request().Context(), request.(PostUsersExclusionsDeleteRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostUsersExclusionsDelete")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(PostUsersExclusionsDeleteResponseObject); ok {
+		return validResponse.VisitPostUsersExclusionsDeleteResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
 // GetUsersGetReview operation middleware
 func (sh *strictHandler) GetUsersGetReview(ctx echo.Context, params GetUsersGetReviewParams) error {
 	var request GetUsersGetReviewRequestObject
@@ -343,9 +1501,11 @@ func (sh *strictHandler) GetUsersGetReview(ctx echo.Context, params GetUsersGetR
 }
 
 // PostUsersSetIsActive operation middleware
-func (sh *strictHandler) PostUsersSetIsActive(ctx echo.Context) error {
+func (sh *strictHandler) PostUsersSetIsActive(ctx echo.Context, params PostUsersSetIsActiveParams) error {
 	var request PostUsersSetIsActiveRequestObject
 
+	request.Params = params
+
 	var body PostUsersSetIsActiveJSONRequestBody
 	if err := ctx.Bind(&body); err != nil {
 		return err
@@ -370,3 +1530,152 @@ func (sh *strictHandler) PostUsersSetIsActive(ctx echo.Context) error {
 	}
 	return nil
 }
+
+// PostUsersSetMaxOpenReviews operation middleware
+func (sh *strictHandler) PostUsersSetMaxOpenReviews(ctx echo.Context, params PostUsersSetMaxOpenReviewsParams) error {
+	var request PostUsersSetMaxOpenReviewsRequestObject
+
+	request.Params = params
+
+	var body PostUsersSetMaxOpenReviewsJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostUsersSetMaxOpenReviews(ctx.Request().Context(), request.(PostUsersSetMaxOpenReviewsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostUsersSetMaxOpenReviews")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(PostUsersSetMaxOpenReviewsResponseObject); ok {
+		return validResponse.VisitPostUsersSetMaxOpenReviewsResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// PostUsersSetSeniority operation middleware
+func (sh *strictHandler) PostUsersSetSeniority(ctx echo.Context, params PostUsersSetSeniorityParams) error {
+	var request PostUsersSetSeniorityRequestObject
+
+	request.Params = params
+
+	var body PostUsersSetSeniorityJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostUsersSetSeniority(ctx.Request().Context(), request.(PostUsersSetSeniorityRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostUsersSetSeniority")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(PostUsersSetSeniorityResponseObject); ok {
+		return validResponse.VisitPostUsersSetSeniorityResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// GetUsersSkills operation middleware
+func (sh *strictHandler) GetUsersSkills(ctx echo.Context, params GetUsersSkillsParams) error {
+	var request GetUsersSkillsRequestObject
+
+	request.Params = params
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetUsersSkills(ctx.Request().Context(), request.(GetUsersSkillsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetUsersSkills")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(GetUsersSkillsResponseObject); ok {
+		return validResponse.VisitGetUsersSkillsResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// PostUsersSkillsAdd operation middleware
+func (sh *strictHandler) PostUsersSkillsAdd(ctx echo.Context, params PostUsersSkillsAddParams) error {
+	var request PostUsersSkillsAddRequestObject
+
+	request.Params = params
+
+	var body PostUsersSkillsAddJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostUsersSkillsAdd(ctx.Request().Context(), request.(PostUsersSkillsAddRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostUsersSkillsAdd")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(PostUsersSkillsAddResponseObject); ok {
+		return validResponse.VisitPostUsersSkillsAddResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// PostUsersSkillsRemove operation middleware
+func (sh *strictHandler) PostUsersSkillsRemove(ctx echo.Context, params PostUsersSkillsRemoveParams) error {
+	var request PostUsersSkillsRemoveRequestObject
+
+	request.Params = params
+
+	var body PostUsersSkillsRemoveJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostUsersSkillsRemove(ctx.Request().Context(), request.(PostUsersSkillsRemoveRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostUsersSkillsRemove")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(PostUsersSkillsRemoveResponseObject); ok {
+		return validResponse.VisitPostUsersSkillsRemoveResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
@@ -26,6 +26,7 @@ type Config struct {
 	BaseURL     string
 	Duration    time.Duration
 	Concurrency int
+	Token       string
 }
 
 func main() {
@@ -33,6 +34,7 @@ func main() {
 		baseURL     = flag.String("base-url", "http://localhost:8080", "service base URL")
 		durationStr = flag.String("duration", "30s", "test duration, e.g. 30s, 1m")
 		concurrency = flag.Int("concurrency", 10, "number of concurrent workers")
+		token       = flag.String("token", os.Getenv("API_TOKEN"), "admin API token, needed unless the service runs with AUTH_INSECURE_DISABLED")
 	)
 	flag.Parse()
 
@@ -46,6 +48,7 @@ func main() {
 		BaseURL:     *baseURL,
 		Duration:    dur,
 		Concurrency: *concurrency,
+		Token:       *token,
 	}
 
 	rand.Seed(time.Now().UnixNano())
@@ -60,6 +63,9 @@ func run(cfg Config) error {
 	client := &http.Client{
 		Timeout: 2 * time.Second,
 	}
+	if cfg.Token != "" {
+		client.Transport = bearerTransport{token: cfg.Token, next: http.DefaultTransport}
+	}
 
 	if err := setupTeam(cfg.BaseURL, client); err != nil {
 		return fmt.Errorf("setup team: %w", err)
@@ -113,6 +119,18 @@ func run(cfg Config) error {
 	return nil
 }
 
+// bearerTransport adds the API token to every request.
+type bearerTransport struct {
+	token string
+	next  http.RoundTripper
+}
+
+func (t bearerTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	req = req.Clone(req.Context())
+	req.Header.Set("Authorization", "Bearer "+t.token)
+	return t.next.RoundTrip(req)
+}
+
 func setupTeam(baseURL string, client *http.Client) error {
 	teamPayload := map[string]any{
 		"team_name": "payments",
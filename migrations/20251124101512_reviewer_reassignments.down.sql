@@ -0,0 +1,2 @@
+DROP INDEX IF EXISTS idx_reviewer_reassignments_old_reviewer;
+DROP TABLE IF EXISTS reviewer_reassignments;
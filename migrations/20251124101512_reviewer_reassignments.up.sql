@@ -0,0 +1,11 @@
+CREATE TABLE IF NOT EXISTS reviewer_reassignments (
+    id BIGSERIAL PRIMARY KEY,
+    pull_request_id VARCHAR(50) NOT NULL REFERENCES pull_requests(pull_request_id) ON DELETE CASCADE,
+    old_reviewer_id VARCHAR(25) NOT NULL REFERENCES users(id),
+    new_reviewer_id VARCHAR(25) REFERENCES users(id),
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    restored_at TIMESTAMP WITH TIME ZONE
+);
+
+CREATE INDEX IF NOT EXISTS idx_reviewer_reassignments_old_reviewer
+    ON reviewer_reassignments(old_reviewer_id) WHERE restored_at IS NULL;
@@ -0,0 +1,17 @@
+CREATE TABLE IF NOT EXISTS user_absences (
+    id BIGSERIAL PRIMARY KEY,
+    user_id VARCHAR(25) NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    starts_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    ends_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    reason VARCHAR(255) NOT NULL DEFAULT '',
+    source VARCHAR(10) NOT NULL DEFAULT 'api' CHECK (source IN ('api', 'ics')),
+    external_uid VARCHAR(255),
+    hand_off_reviews BOOLEAN NOT NULL DEFAULT FALSE,
+    handed_off_at TIMESTAMP WITH TIME ZONE,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    CHECK (ends_at > starts_at),
+    UNIQUE (user_id, external_uid)
+);
+
+CREATE INDEX IF NOT EXISTS idx_user_absences_user_period
+    ON user_absences(user_id, starts_at, ends_at);
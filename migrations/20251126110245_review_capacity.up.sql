@@ -0,0 +1,5 @@
+ALTER TABLE users
+    ADD COLUMN IF NOT EXISTS max_open_reviews INTEGER CHECK (max_open_reviews >= 0);
+
+ALTER TABLE teams
+    ADD COLUMN IF NOT EXISTS default_max_open_reviews INTEGER CHECK (default_max_open_reviews >= 0);
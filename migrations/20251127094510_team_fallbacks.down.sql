@@ -0,0 +1,4 @@
+DROP TABLE IF EXISTS team_reviewer_pools;
+DROP TABLE IF EXISTS reviewer_pool_members;
+DROP TABLE IF EXISTS reviewer_pools;
+DROP TABLE IF EXISTS team_fallbacks;
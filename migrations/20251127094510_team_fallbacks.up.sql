@@ -0,0 +1,23 @@
+CREATE TABLE IF NOT EXISTS team_fallbacks (
+    team_name VARCHAR(25) NOT NULL REFERENCES teams(name) ON DELETE CASCADE,
+    fallback_team_name VARCHAR(25) NOT NULL REFERENCES teams(name) ON DELETE CASCADE,
+    position INTEGER NOT NULL DEFAULT 0,
+    PRIMARY KEY (team_name, fallback_team_name),
+    CHECK (team_name <> fallback_team_name)
+);
+
+CREATE TABLE IF NOT EXISTS reviewer_pools (
+    name VARCHAR(50) PRIMARY KEY
+);
+
+CREATE TABLE IF NOT EXISTS reviewer_pool_members (
+    pool_name VARCHAR(50) NOT NULL REFERENCES reviewer_pools(name) ON DELETE CASCADE,
+    user_id VARCHAR(25) NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    PRIMARY KEY (pool_name, user_id)
+);
+
+CREATE TABLE IF NOT EXISTS team_reviewer_pools (
+    team_name VARCHAR(25) NOT NULL REFERENCES teams(name) ON DELETE CASCADE,
+    pool_name VARCHAR(50) NOT NULL REFERENCES reviewer_pools(name) ON DELETE CASCADE,
+    PRIMARY KEY (team_name, pool_name)
+);
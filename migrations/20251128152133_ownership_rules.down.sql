@@ -0,0 +1,2 @@
+DROP INDEX IF EXISTS idx_ownership_rules_position;
+DROP TABLE IF EXISTS ownership_rules;
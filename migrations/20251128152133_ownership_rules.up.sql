@@ -0,0 +1,11 @@
+CREATE TABLE IF NOT EXISTS ownership_rules (
+    id BIGSERIAL PRIMARY KEY,
+    position INTEGER NOT NULL,
+    pattern VARCHAR(255) NOT NULL,
+    owner_user_id VARCHAR(25) REFERENCES users(id) ON DELETE CASCADE,
+    owner_team VARCHAR(25) REFERENCES teams(name) ON DELETE CASCADE,
+    CHECK (owner_user_id IS NULL OR owner_team IS NULL)
+);
+
+CREATE INDEX IF NOT EXISTS idx_ownership_rules_position
+    ON ownership_rules(position);
@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS idx_user_skills_skill;
+DROP TABLE IF EXISTS pull_request_tags;
+DROP TABLE IF EXISTS user_skills;
@@ -0,0 +1,13 @@
+CREATE TABLE IF NOT EXISTS user_skills (
+    user_id VARCHAR(25) NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    skill VARCHAR(50) NOT NULL,
+    PRIMARY KEY (user_id, skill)
+);
+
+CREATE TABLE IF NOT EXISTS pull_request_tags (
+    pull_request_id VARCHAR(50) NOT NULL REFERENCES pull_requests(pull_request_id) ON DELETE CASCADE,
+    tag VARCHAR(50) NOT NULL,
+    PRIMARY KEY (pull_request_id, tag)
+);
+
+CREATE INDEX IF NOT EXISTS idx_user_skills_skill ON user_skills(skill);
@@ -0,0 +1,2 @@
+ALTER TABLE teams DROP COLUMN IF EXISTS review_policy;
+ALTER TABLE users DROP COLUMN IF EXISTS seniority;
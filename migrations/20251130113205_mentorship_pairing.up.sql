@@ -0,0 +1,7 @@
+ALTER TABLE users
+    ADD COLUMN IF NOT EXISTS seniority VARCHAR(10) NOT NULL DEFAULT 'senior'
+        CHECK (seniority IN ('junior', 'senior'));
+
+ALTER TABLE teams
+    ADD COLUMN IF NOT EXISTS review_policy VARCHAR(20) NOT NULL DEFAULT 'standard'
+        CHECK (review_policy IN ('standard', 'pairing'));
@@ -0,0 +1,3 @@
+DROP TABLE IF EXISTS pull_request_excluded_reviewers;
+DROP INDEX IF EXISTS idx_reviewer_exclusions_reviewer;
+DROP TABLE IF EXISTS reviewer_exclusions;
@@ -0,0 +1,18 @@
+CREATE TABLE IF NOT EXISTS reviewer_exclusions (
+    author_id VARCHAR(25) NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    reviewer_id VARCHAR(25) NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    symmetric BOOLEAN NOT NULL DEFAULT FALSE,
+    reason TEXT NOT NULL DEFAULT '',
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (author_id, reviewer_id),
+    CHECK (author_id <> reviewer_id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_reviewer_exclusions_reviewer
+    ON reviewer_exclusions(reviewer_id);
+
+CREATE TABLE IF NOT EXISTS pull_request_excluded_reviewers (
+    pull_request_id VARCHAR(50) NOT NULL REFERENCES pull_requests(pull_request_id) ON DELETE CASCADE,
+    user_id VARCHAR(25) NOT NULL,
+    PRIMARY KEY (pull_request_id, user_id)
+);
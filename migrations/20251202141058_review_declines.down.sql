@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS idx_review_declines_user;
+DROP INDEX IF EXISTS idx_review_declines_pr;
+DROP TABLE IF EXISTS review_declines;
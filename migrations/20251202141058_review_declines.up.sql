@@ -0,0 +1,11 @@
+CREATE TABLE IF NOT EXISTS review_declines (
+    id BIGSERIAL PRIMARY KEY,
+    pull_request_id VARCHAR(50) NOT NULL REFERENCES pull_requests(pull_request_id) ON DELETE CASCADE,
+    user_id VARCHAR(25) NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    reason TEXT NOT NULL,
+    replaced_by VARCHAR(25) REFERENCES users(id) ON DELETE SET NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_review_declines_pr ON review_declines(pull_request_id);
+CREATE INDEX IF NOT EXISTS idx_review_declines_user ON review_declines(user_id);
@@ -0,0 +1,5 @@
+ALTER TABLE pull_request_reviewers DROP COLUMN IF EXISTS assigned_at;
+
+ALTER TABLE pull_requests
+    DROP COLUMN IF EXISTS due_at,
+    DROP COLUMN IF EXISTS priority;
@@ -0,0 +1,7 @@
+ALTER TABLE pull_requests
+    ADD COLUMN IF NOT EXISTS priority VARCHAR(10) NOT NULL DEFAULT 'normal'
+        CHECK (priority IN ('low', 'normal', 'urgent')),
+    ADD COLUMN IF NOT EXISTS due_at TIMESTAMP;
+
+ALTER TABLE pull_request_reviewers
+    ADD COLUMN IF NOT EXISTS assigned_at TIMESTAMP NOT NULL DEFAULT NOW();
@@ -0,0 +1,11 @@
+DROP TABLE IF EXISTS pull_request_labels;
+
+DROP INDEX IF EXISTS idx_pull_requests_repository;
+
+ALTER TABLE pull_requests
+    DROP COLUMN IF EXISTS deletions,
+    DROP COLUMN IF EXISTS additions,
+    DROP COLUMN IF EXISTS url,
+    DROP COLUMN IF EXISTS target_branch,
+    DROP COLUMN IF EXISTS source_branch,
+    DROP COLUMN IF EXISTS repository;
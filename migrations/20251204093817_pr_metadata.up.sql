@@ -0,0 +1,16 @@
+ALTER TABLE pull_requests
+    ADD COLUMN IF NOT EXISTS repository VARCHAR(200) NOT NULL DEFAULT '',
+    ADD COLUMN IF NOT EXISTS source_branch VARCHAR(255) NOT NULL DEFAULT '',
+    ADD COLUMN IF NOT EXISTS target_branch VARCHAR(255) NOT NULL DEFAULT '',
+    ADD COLUMN IF NOT EXISTS url TEXT NOT NULL DEFAULT '',
+    ADD COLUMN IF NOT EXISTS additions INTEGER NOT NULL DEFAULT 0 CHECK (additions >= 0),
+    ADD COLUMN IF NOT EXISTS deletions INTEGER NOT NULL DEFAULT 0 CHECK (deletions >= 0);
+
+CREATE TABLE IF NOT EXISTS pull_request_labels (
+    pull_request_id VARCHAR(50) NOT NULL REFERENCES pull_requests(pull_request_id) ON DELETE CASCADE,
+    label VARCHAR(50) NOT NULL,
+    PRIMARY KEY (pull_request_id, label)
+);
+
+CREATE INDEX IF NOT EXISTS idx_pull_requests_repository ON pull_requests(repository);
+CREATE INDEX IF NOT EXISTS idx_pull_request_labels_label ON pull_request_labels(label);
@@ -0,0 +1,6 @@
+CREATE TABLE IF NOT EXISTS team_size_rules (
+    team_name VARCHAR(25) NOT NULL REFERENCES teams(name) ON DELETE CASCADE,
+    min_lines INTEGER NOT NULL CHECK (min_lines >= 0),
+    reviewers INTEGER NOT NULL CHECK (reviewers BETWEEN 1 AND 10),
+    PRIMARY KEY (team_name, min_lines)
+);
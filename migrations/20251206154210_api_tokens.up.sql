@@ -0,0 +1,13 @@
+CREATE TABLE IF NOT EXISTS api_tokens (
+    id BIGSERIAL PRIMARY KEY,
+    name VARCHAR(100) NOT NULL,
+    token_hash CHAR(64) NOT NULL UNIQUE,
+    role VARCHAR(20) NOT NULL CHECK (role IN ('admin', 'team-lead', 'member')),
+    user_id VARCHAR(25) REFERENCES users(id) ON DELETE CASCADE,
+    team_name VARCHAR(25) REFERENCES teams(name) ON DELETE CASCADE,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    last_used_at TIMESTAMP,
+    revoked_at TIMESTAMP,
+    CHECK (role <> 'team-lead' OR team_name IS NOT NULL),
+    CHECK (role <> 'member' OR user_id IS NOT NULL)
+);
@@ -0,0 +1 @@
+ALTER TABLE reviewer_reassignments DROP COLUMN IF EXISTS actor;
@@ -0,0 +1,2 @@
+ALTER TABLE reviewer_reassignments
+    ADD COLUMN IF NOT EXISTS actor VARCHAR(255) NOT NULL DEFAULT 'system';
@@ -0,0 +1,148 @@
+-- Only works while IDs and names are unique across organizations. The foreign keys
+-- keep their names, so they are dropped and added again with single columns.
+
+ALTER TABLE users
+    DROP CONSTRAINT IF EXISTS users_team_name_fkey;
+ALTER TABLE pull_requests
+    DROP CONSTRAINT IF EXISTS pull_requests_author_id_fkey;
+ALTER TABLE pull_request_reviewers
+    DROP CONSTRAINT IF EXISTS pull_request_reviewers_pull_request_id_fkey,
+    DROP CONSTRAINT IF EXISTS pull_request_reviewers_reviewer_id_fkey;
+ALTER TABLE reviewer_reassignments
+    DROP CONSTRAINT IF EXISTS reviewer_reassignments_pull_request_id_fkey,
+    DROP CONSTRAINT IF EXISTS reviewer_reassignments_old_reviewer_id_fkey,
+    DROP CONSTRAINT IF EXISTS reviewer_reassignments_new_reviewer_id_fkey;
+ALTER TABLE user_absences
+    DROP CONSTRAINT IF EXISTS user_absences_user_id_fkey;
+ALTER TABLE team_fallbacks
+    DROP CONSTRAINT IF EXISTS team_fallbacks_team_name_fkey,
+    DROP CONSTRAINT IF EXISTS team_fallbacks_fallback_team_name_fkey;
+ALTER TABLE reviewer_pool_members
+    DROP CONSTRAINT IF EXISTS reviewer_pool_members_pool_name_fkey,
+    DROP CONSTRAINT IF EXISTS reviewer_pool_members_user_id_fkey;
+ALTER TABLE team_reviewer_pools
+    DROP CONSTRAINT IF EXISTS team_reviewer_pools_team_name_fkey,
+    DROP CONSTRAINT IF EXISTS team_reviewer_pools_pool_name_fkey;
+ALTER TABLE ownership_rules
+    DROP CONSTRAINT IF EXISTS ownership_rules_owner_user_id_fkey,
+    DROP CONSTRAINT IF EXISTS ownership_rules_owner_team_fkey;
+ALTER TABLE user_skills
+    DROP CONSTRAINT IF EXISTS user_skills_user_id_fkey;
+ALTER TABLE pull_request_tags
+    DROP CONSTRAINT IF EXISTS pull_request_tags_pull_request_id_fkey;
+ALTER TABLE reviewer_exclusions
+    DROP CONSTRAINT IF EXISTS reviewer_exclusions_author_id_fkey,
+    DROP CONSTRAINT IF EXISTS reviewer_exclusions_reviewer_id_fkey;
+ALTER TABLE pull_request_excluded_reviewers
+    DROP CONSTRAINT IF EXISTS pull_request_excluded_reviewers_pull_request_id_fkey;
+ALTER TABLE review_declines
+    DROP CONSTRAINT IF EXISTS review_declines_pull_request_id_fkey,
+    DROP CONSTRAINT IF EXISTS review_declines_user_id_fkey,
+    DROP CONSTRAINT IF EXISTS review_declines_replaced_by_fkey;
+ALTER TABLE pull_request_labels
+    DROP CONSTRAINT IF EXISTS pull_request_labels_pull_request_id_fkey;
+ALTER TABLE team_size_rules
+    DROP CONSTRAINT IF EXISTS team_size_rules_team_name_fkey;
+ALTER TABLE api_tokens
+    DROP CONSTRAINT IF EXISTS api_tokens_user_id_fkey,
+    DROP CONSTRAINT IF EXISTS api_tokens_team_name_fkey;
+
+ALTER TABLE pull_request_reviewers DROP CONSTRAINT pull_request_reviewers_pkey, ADD PRIMARY KEY (pull_request_id, reviewer_id);
+ALTER TABLE team_fallbacks DROP CONSTRAINT team_fallbacks_pkey, ADD PRIMARY KEY (team_name, fallback_team_name);
+ALTER TABLE reviewer_pool_members DROP CONSTRAINT reviewer_pool_members_pkey, ADD PRIMARY KEY (pool_name, user_id);
+ALTER TABLE team_reviewer_pools DROP CONSTRAINT team_reviewer_pools_pkey, ADD PRIMARY KEY (team_name, pool_name);
+ALTER TABLE user_skills DROP CONSTRAINT user_skills_pkey, ADD PRIMARY KEY (user_id, skill);
+ALTER TABLE pull_request_tags DROP CONSTRAINT pull_request_tags_pkey, ADD PRIMARY KEY (pull_request_id, tag);
+ALTER TABLE reviewer_exclusions DROP CONSTRAINT reviewer_exclusions_pkey, ADD PRIMARY KEY (author_id, reviewer_id);
+ALTER TABLE pull_request_excluded_reviewers DROP CONSTRAINT pull_request_excluded_reviewers_pkey, ADD PRIMARY KEY (pull_request_id, user_id);
+ALTER TABLE pull_request_labels DROP CONSTRAINT pull_request_labels_pkey, ADD PRIMARY KEY (pull_request_id, label);
+ALTER TABLE team_size_rules DROP CONSTRAINT team_size_rules_pkey, ADD PRIMARY KEY (team_name, min_lines);
+ALTER TABLE user_absences
+    DROP CONSTRAINT IF EXISTS user_absences_user_id_external_uid_key,
+    ADD UNIQUE (user_id, external_uid);
+
+ALTER TABLE teams DROP CONSTRAINT teams_pkey, ADD PRIMARY KEY (name);
+ALTER TABLE users DROP CONSTRAINT users_pkey, ADD PRIMARY KEY (id);
+ALTER TABLE pull_requests DROP CONSTRAINT pull_requests_pkey, ADD PRIMARY KEY (pull_request_id);
+ALTER TABLE reviewer_pools DROP CONSTRAINT reviewer_pools_pkey, ADD PRIMARY KEY (name);
+
+ALTER TABLE users
+    ADD FOREIGN KEY (team_name) REFERENCES teams(name);
+ALTER TABLE pull_requests
+    ADD FOREIGN KEY (author_id) REFERENCES users(id);
+ALTER TABLE pull_request_reviewers
+    ADD FOREIGN KEY (pull_request_id) REFERENCES pull_requests(pull_request_id) ON DELETE CASCADE,
+    ADD FOREIGN KEY (reviewer_id) REFERENCES users(id);
+ALTER TABLE reviewer_reassignments
+    ADD FOREIGN KEY (pull_request_id) REFERENCES pull_requests(pull_request_id) ON DELETE CASCADE,
+    ADD FOREIGN KEY (old_reviewer_id) REFERENCES users(id),
+    ADD FOREIGN KEY (new_reviewer_id) REFERENCES users(id);
+ALTER TABLE user_absences
+    ADD FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE;
+ALTER TABLE team_fallbacks
+    ADD FOREIGN KEY (team_name) REFERENCES teams(name) ON DELETE CASCADE,
+    ADD FOREIGN KEY (fallback_team_name) REFERENCES teams(name) ON DELETE CASCADE;
+ALTER TABLE reviewer_pool_members
+    ADD FOREIGN KEY (pool_name) REFERENCES reviewer_pools(name) ON DELETE CASCADE,
+    ADD FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE;
+ALTER TABLE team_reviewer_pools
+    ADD FOREIGN KEY (team_name) REFERENCES teams(name) ON DELETE CASCADE,
+    ADD FOREIGN KEY (pool_name) REFERENCES reviewer_pools(name) ON DELETE CASCADE;
+ALTER TABLE ownership_rules
+    ADD FOREIGN KEY (owner_user_id) REFERENCES users(id) ON DELETE CASCADE,
+    ADD FOREIGN KEY (owner_team) REFERENCES teams(name) ON DELETE CASCADE;
+ALTER TABLE user_skills
+    ADD FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE;
+ALTER TABLE pull_request_tags
+    ADD FOREIGN KEY (pull_request_id) REFERENCES pull_requests(pull_request_id) ON DELETE CASCADE;
+ALTER TABLE reviewer_exclusions
+    ADD FOREIGN KEY (author_id) REFERENCES users(id) ON DELETE CASCADE,
+    ADD FOREIGN KEY (reviewer_id) REFERENCES users(id) ON DELETE CASCADE;
+ALTER TABLE pull_request_excluded_reviewers
+    ADD FOREIGN KEY (pull_request_id) REFERENCES pull_requests(pull_request_id) ON DELETE CASCADE;
+ALTER TABLE review_declines
+    ADD FOREIGN KEY (pull_request_id) REFERENCES pull_requests(pull_request_id) ON DELETE CASCADE,
+    ADD FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE,
+    ADD FOREIGN KEY (replaced_by) REFERENCES users(id) ON DELETE SET NULL;
+ALTER TABLE pull_request_labels
+    ADD FOREIGN KEY (pull_request_id) REFERENCES pull_requests(pull_request_id) ON DELETE CASCADE;
+ALTER TABLE team_size_rules
+    ADD FOREIGN KEY (team_name) REFERENCES teams(name) ON DELETE CASCADE;
+ALTER TABLE api_tokens
+    ADD FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE,
+    ADD FOREIGN KEY (team_name) REFERENCES teams(name) ON DELETE CASCADE;
+
+DROP INDEX IF EXISTS idx_audit_log_organization;
+ALTER TABLE teams DROP COLUMN IF EXISTS organization;
+ALTER TABLE users DROP COLUMN IF EXISTS organization;
+ALTER TABLE pull_requests DROP COLUMN IF EXISTS organization;
+ALTER TABLE reviewer_pools DROP COLUMN IF EXISTS organization;
+ALTER TABLE audit_log DROP COLUMN IF EXISTS organization;
+ALTER TABLE pull_request_reviewers DROP COLUMN IF EXISTS organization;
+ALTER TABLE reviewer_reassignments DROP COLUMN IF EXISTS organization;
+ALTER TABLE user_absences DROP COLUMN IF EXISTS organization;
+ALTER TABLE team_fallbacks DROP COLUMN IF EXISTS organization;
+ALTER TABLE reviewer_pool_members DROP COLUMN IF EXISTS organization;
+ALTER TABLE team_reviewer_pools DROP COLUMN IF EXISTS organization;
+ALTER TABLE ownership_rules DROP COLUMN IF EXISTS organization;
+ALTER TABLE user_skills DROP COLUMN IF EXISTS organization;
+ALTER TABLE pull_request_tags DROP COLUMN IF EXISTS organization;
+ALTER TABLE reviewer_exclusions DROP COLUMN IF EXISTS organization;
+ALTER TABLE pull_request_excluded_reviewers DROP COLUMN IF EXISTS organization;
+ALTER TABLE review_declines DROP COLUMN IF EXISTS organization;
+ALTER TABLE pull_request_labels DROP COLUMN IF EXISTS organization;
+ALTER TABLE team_size_rules DROP COLUMN IF EXISTS organization;
+ALTER TABLE api_tokens DROP COLUMN IF EXISTS organization;
+
+CREATE INDEX IF NOT EXISTS idx_users_team_active ON users(team_name, is_active);
+CREATE INDEX IF NOT EXISTS idx_pull_requests_status ON pull_requests(status);
+CREATE INDEX IF NOT EXISTS idx_pr_reviewers_reviewer ON pull_request_reviewers(reviewer_id);
+CREATE INDEX IF NOT EXISTS idx_pr_reviewers_pr ON pull_request_reviewers(pull_request_id);
+CREATE INDEX IF NOT EXISTS idx_reviewer_reassignments_old_reviewer
+    ON reviewer_reassignments(old_reviewer_id) WHERE restored_at IS NULL;
+CREATE INDEX IF NOT EXISTS idx_user_absences_user_period ON user_absences(user_id, starts_at, ends_at);
+CREATE INDEX IF NOT EXISTS idx_reviewer_exclusions_reviewer ON reviewer_exclusions(reviewer_id);
+CREATE INDEX IF NOT EXISTS idx_review_declines_pr ON review_declines(pull_request_id);
+CREATE INDEX IF NOT EXISTS idx_review_declines_user ON review_declines(user_id);
+CREATE INDEX IF NOT EXISTS idx_pull_requests_repository ON pull_requests(repository);
+CREATE INDEX IF NOT EXISTS idx_ownership_rules_position ON ownership_rules(position);
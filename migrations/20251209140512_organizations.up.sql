@@ -0,0 +1,195 @@
+-- Every team, user and PR belongs to an organization; names and IDs only need to be
+-- unique within one. Existing data moves to the 'default' organization. Child tables
+-- carry the organization too, so their foreign keys can't cross organizations.
+
+ALTER TABLE users DROP CONSTRAINT IF EXISTS users_team_name_fkey;
+ALTER TABLE pull_requests DROP CONSTRAINT IF EXISTS pull_requests_author_id_fkey;
+ALTER TABLE pull_request_reviewers
+    DROP CONSTRAINT IF EXISTS pull_request_reviewers_pull_request_id_fkey,
+    DROP CONSTRAINT IF EXISTS pull_request_reviewers_reviewer_id_fkey;
+ALTER TABLE reviewer_reassignments
+    DROP CONSTRAINT IF EXISTS reviewer_reassignments_pull_request_id_fkey,
+    DROP CONSTRAINT IF EXISTS reviewer_reassignments_old_reviewer_id_fkey,
+    DROP CONSTRAINT IF EXISTS reviewer_reassignments_new_reviewer_id_fkey;
+ALTER TABLE user_absences DROP CONSTRAINT IF EXISTS user_absences_user_id_fkey;
+ALTER TABLE team_fallbacks
+    DROP CONSTRAINT IF EXISTS team_fallbacks_team_name_fkey,
+    DROP CONSTRAINT IF EXISTS team_fallbacks_fallback_team_name_fkey;
+ALTER TABLE reviewer_pool_members
+    DROP CONSTRAINT IF EXISTS reviewer_pool_members_pool_name_fkey,
+    DROP CONSTRAINT IF EXISTS reviewer_pool_members_user_id_fkey;
+ALTER TABLE team_reviewer_pools
+    DROP CONSTRAINT IF EXISTS team_reviewer_pools_team_name_fkey,
+    DROP CONSTRAINT IF EXISTS team_reviewer_pools_pool_name_fkey;
+ALTER TABLE ownership_rules
+    DROP CONSTRAINT IF EXISTS ownership_rules_owner_user_id_fkey,
+    DROP CONSTRAINT IF EXISTS ownership_rules_owner_team_fkey;
+ALTER TABLE user_skills DROP CONSTRAINT IF EXISTS user_skills_user_id_fkey;
+ALTER TABLE pull_request_tags DROP CONSTRAINT IF EXISTS pull_request_tags_pull_request_id_fkey;
+ALTER TABLE reviewer_exclusions
+    DROP CONSTRAINT IF EXISTS reviewer_exclusions_author_id_fkey,
+    DROP CONSTRAINT IF EXISTS reviewer_exclusions_reviewer_id_fkey;
+ALTER TABLE pull_request_excluded_reviewers
+    DROP CONSTRAINT IF EXISTS pull_request_excluded_reviewers_pull_request_id_fkey;
+ALTER TABLE review_declines
+    DROP CONSTRAINT IF EXISTS review_declines_pull_request_id_fkey,
+    DROP CONSTRAINT IF EXISTS review_declines_user_id_fkey,
+    DROP CONSTRAINT IF EXISTS review_declines_replaced_by_fkey;
+ALTER TABLE pull_request_labels DROP CONSTRAINT IF EXISTS pull_request_labels_pull_request_id_fkey;
+ALTER TABLE team_size_rules DROP CONSTRAINT IF EXISTS team_size_rules_team_name_fkey;
+ALTER TABLE api_tokens
+    DROP CONSTRAINT IF EXISTS api_tokens_user_id_fkey,
+    DROP CONSTRAINT IF EXISTS api_tokens_team_name_fkey;
+
+ALTER TABLE teams ADD COLUMN IF NOT EXISTS organization VARCHAR(50) NOT NULL DEFAULT 'default';
+ALTER TABLE users ADD COLUMN IF NOT EXISTS organization VARCHAR(50) NOT NULL DEFAULT 'default';
+ALTER TABLE pull_requests ADD COLUMN IF NOT EXISTS organization VARCHAR(50) NOT NULL DEFAULT 'default';
+ALTER TABLE pull_request_reviewers ADD COLUMN IF NOT EXISTS organization VARCHAR(50) NOT NULL DEFAULT 'default';
+ALTER TABLE reviewer_reassignments ADD COLUMN IF NOT EXISTS organization VARCHAR(50) NOT NULL DEFAULT 'default';
+ALTER TABLE user_absences ADD COLUMN IF NOT EXISTS organization VARCHAR(50) NOT NULL DEFAULT 'default';
+ALTER TABLE team_fallbacks ADD COLUMN IF NOT EXISTS organization VARCHAR(50) NOT NULL DEFAULT 'default';
+ALTER TABLE reviewer_pools ADD COLUMN IF NOT EXISTS organization VARCHAR(50) NOT NULL DEFAULT 'default';
+ALTER TABLE reviewer_pool_members ADD COLUMN IF NOT EXISTS organization VARCHAR(50) NOT NULL DEFAULT 'default';
+ALTER TABLE team_reviewer_pools ADD COLUMN IF NOT EXISTS organization VARCHAR(50) NOT NULL DEFAULT 'default';
+ALTER TABLE ownership_rules ADD COLUMN IF NOT EXISTS organization VARCHAR(50) NOT NULL DEFAULT 'default';
+ALTER TABLE user_skills ADD COLUMN IF NOT EXISTS organization VARCHAR(50) NOT NULL DEFAULT 'default';
+ALTER TABLE pull_request_tags ADD COLUMN IF NOT EXISTS organization VARCHAR(50) NOT NULL DEFAULT 'default';
+ALTER TABLE reviewer_exclusions ADD COLUMN IF NOT EXISTS organization VARCHAR(50) NOT NULL DEFAULT 'default';
+ALTER TABLE pull_request_excluded_reviewers ADD COLUMN IF NOT EXISTS organization VARCHAR(50) NOT NULL DEFAULT 'default';
+ALTER TABLE review_declines ADD COLUMN IF NOT EXISTS organization VARCHAR(50) NOT NULL DEFAULT 'default';
+ALTER TABLE pull_request_labels ADD COLUMN IF NOT EXISTS organization VARCHAR(50) NOT NULL DEFAULT 'default';
+ALTER TABLE team_size_rules ADD COLUMN IF NOT EXISTS organization VARCHAR(50) NOT NULL DEFAULT 'default';
+ALTER TABLE api_tokens ADD COLUMN IF NOT EXISTS organization VARCHAR(50) NOT NULL DEFAULT 'default';
+ALTER TABLE audit_log ADD COLUMN IF NOT EXISTS organization VARCHAR(50) NOT NULL DEFAULT 'default';
+
+ALTER TABLE teams DROP CONSTRAINT teams_pkey, ADD PRIMARY KEY (organization, name);
+ALTER TABLE users DROP CONSTRAINT users_pkey, ADD PRIMARY KEY (organization, id);
+ALTER TABLE pull_requests DROP CONSTRAINT pull_requests_pkey, ADD PRIMARY KEY (organization, pull_request_id);
+ALTER TABLE reviewer_pools DROP CONSTRAINT reviewer_pools_pkey, ADD PRIMARY KEY (organization, name);
+
+ALTER TABLE pull_request_reviewers
+    DROP CONSTRAINT pull_request_reviewers_pkey,
+    ADD PRIMARY KEY (organization, pull_request_id, reviewer_id);
+ALTER TABLE team_fallbacks
+    DROP CONSTRAINT team_fallbacks_pkey,
+    ADD PRIMARY KEY (organization, team_name, fallback_team_name);
+ALTER TABLE reviewer_pool_members
+    DROP CONSTRAINT reviewer_pool_members_pkey,
+    ADD PRIMARY KEY (organization, pool_name, user_id);
+ALTER TABLE team_reviewer_pools
+    DROP CONSTRAINT team_reviewer_pools_pkey,
+    ADD PRIMARY KEY (organization, team_name, pool_name);
+ALTER TABLE user_skills
+    DROP CONSTRAINT user_skills_pkey,
+    ADD PRIMARY KEY (organization, user_id, skill);
+ALTER TABLE pull_request_tags
+    DROP CONSTRAINT pull_request_tags_pkey,
+    ADD PRIMARY KEY (organization, pull_request_id, tag);
+ALTER TABLE reviewer_exclusions
+    DROP CONSTRAINT reviewer_exclusions_pkey,
+    ADD PRIMARY KEY (organization, author_id, reviewer_id);
+ALTER TABLE pull_request_excluded_reviewers
+    DROP CONSTRAINT pull_request_excluded_reviewers_pkey,
+    ADD PRIMARY KEY (organization, pull_request_id, user_id);
+ALTER TABLE pull_request_labels
+    DROP CONSTRAINT pull_request_labels_pkey,
+    ADD PRIMARY KEY (organization, pull_request_id, label);
+ALTER TABLE team_size_rules
+    DROP CONSTRAINT team_size_rules_pkey,
+    ADD PRIMARY KEY (organization, team_name, min_lines);
+ALTER TABLE user_absences
+    DROP CONSTRAINT IF EXISTS user_absences_user_id_external_uid_key,
+    ADD CONSTRAINT user_absences_user_id_external_uid_key UNIQUE (organization, user_id, external_uid);
+
+ALTER TABLE users
+    ADD CONSTRAINT users_team_name_fkey FOREIGN KEY (organization, team_name) REFERENCES teams (organization, name);
+ALTER TABLE pull_requests
+    ADD CONSTRAINT pull_requests_author_id_fkey FOREIGN KEY (organization, author_id) REFERENCES users (organization, id);
+ALTER TABLE pull_request_reviewers
+    ADD CONSTRAINT pull_request_reviewers_pull_request_id_fkey FOREIGN KEY (organization, pull_request_id) REFERENCES pull_requests (organization, pull_request_id) ON DELETE CASCADE,
+    ADD CONSTRAINT pull_request_reviewers_reviewer_id_fkey FOREIGN KEY (organization, reviewer_id) REFERENCES users (organization, id);
+ALTER TABLE reviewer_reassignments
+    ADD CONSTRAINT reviewer_reassignments_pull_request_id_fkey FOREIGN KEY (organization, pull_request_id) REFERENCES pull_requests (organization, pull_request_id) ON DELETE CASCADE,
+    ADD CONSTRAINT reviewer_reassignments_old_reviewer_id_fkey FOREIGN KEY (organization, old_reviewer_id) REFERENCES users (organization, id),
+    ADD CONSTRAINT reviewer_reassignments_new_reviewer_id_fkey FOREIGN KEY (organization, new_reviewer_id) REFERENCES users (organization, id);
+ALTER TABLE user_absences
+    ADD CONSTRAINT user_absences_user_id_fkey FOREIGN KEY (organization, user_id) REFERENCES users (organization, id) ON DELETE CASCADE;
+ALTER TABLE team_fallbacks
+    ADD CONSTRAINT team_fallbacks_team_name_fkey FOREIGN KEY (organization, team_name) REFERENCES teams (organization, name) ON DELETE CASCADE,
+    ADD CONSTRAINT team_fallbacks_fallback_team_name_fkey FOREIGN KEY (organization, fallback_team_name) REFERENCES teams (organization, name) ON DELETE CASCADE;
+ALTER TABLE reviewer_pool_members
+    ADD CONSTRAINT reviewer_pool_members_pool_name_fkey FOREIGN KEY (organization, pool_name) REFERENCES reviewer_pools (organization, name) ON DELETE CASCADE,
+    ADD CONSTRAINT reviewer_pool_members_user_id_fkey FOREIGN KEY (organization, user_id) REFERENCES users (organization, id) ON DELETE CASCADE;
+ALTER TABLE team_reviewer_pools
+    ADD CONSTRAINT team_reviewer_pools_team_name_fkey FOREIGN KEY (organization, team_name) REFERENCES teams (organization, name) ON DELETE CASCADE,
+    ADD CONSTRAINT team_reviewer_pools_pool_name_fkey FOREIGN KEY (organization, pool_name) REFERENCES reviewer_pools (organization, name) ON DELETE CASCADE;
+ALTER TABLE ownership_rules
+    ADD CONSTRAINT ownership_rules_owner_user_id_fkey FOREIGN KEY (organization, owner_user_id) REFERENCES users (organization, id) ON DELETE CASCADE,
+    ADD CONSTRAINT ownership_rules_owner_team_fkey FOREIGN KEY (organization, owner_team) REFERENCES teams (organization, name) ON DELETE CASCADE;
+ALTER TABLE user_skills
+    ADD CONSTRAINT user_skills_user_id_fkey FOREIGN KEY (organization, user_id) REFERENCES users (organization, id) ON DELETE CASCADE;
+ALTER TABLE pull_request_tags
+    ADD CONSTRAINT pull_request_tags_pull_request_id_fkey FOREIGN KEY (organization, pull_request_id) REFERENCES pull_requests (organization, pull_request_id) ON DELETE CASCADE;
+ALTER TABLE reviewer_exclusions
+    ADD CONSTRAINT reviewer_exclusions_author_id_fkey FOREIGN KEY (organization, author_id) REFERENCES users (organization, id) ON DELETE CASCADE,
+    ADD CONSTRAINT reviewer_exclusions_reviewer_id_fkey FOREIGN KEY (organization, reviewer_id) REFERENCES users (organization, id) ON DELETE CASCADE;
+ALTER TABLE pull_request_excluded_reviewers
+    ADD CONSTRAINT pull_request_excluded_reviewers_pull_request_id_fkey FOREIGN KEY (organization, pull_request_id) REFERENCES pull_requests (organization, pull_request_id) ON DELETE CASCADE;
+ALTER TABLE review_declines
+    ADD CONSTRAINT review_declines_pull_request_id_fkey FOREIGN KEY (organization, pull_request_id) REFERENCES pull_requests (organization, pull_request_id) ON DELETE CASCADE,
+    ADD CONSTRAINT review_declines_user_id_fkey FOREIGN KEY (organization, user_id) REFERENCES users (organization, id) ON DELETE CASCADE,
+    ADD CONSTRAINT review_declines_replaced_by_fkey FOREIGN KEY (organization, replaced_by) REFERENCES users (organization, id) ON DELETE SET NULL (replaced_by);
+ALTER TABLE pull_request_labels
+    ADD CONSTRAINT pull_request_labels_pull_request_id_fkey FOREIGN KEY (organization, pull_request_id) REFERENCES pull_requests (organization, pull_request_id) ON DELETE CASCADE;
+ALTER TABLE team_size_rules
+    ADD CONSTRAINT team_size_rules_team_name_fkey FOREIGN KEY (organization, team_name) REFERENCES teams (organization, name) ON DELETE CASCADE;
+ALTER TABLE api_tokens
+    ADD CONSTRAINT api_tokens_user_id_fkey FOREIGN KEY (organization, user_id) REFERENCES users (organization, id) ON DELETE CASCADE,
+    ADD CONSTRAINT api_tokens_team_name_fkey FOREIGN KEY (organization, team_name) REFERENCES teams (organization, name) ON DELETE CASCADE;
+
+-- The default only served existing rows; new rows must name their organization.
+ALTER TABLE teams ALTER COLUMN organization DROP DEFAULT;
+ALTER TABLE users ALTER COLUMN organization DROP DEFAULT;
+ALTER TABLE pull_requests ALTER COLUMN organization DROP DEFAULT;
+ALTER TABLE pull_request_reviewers ALTER COLUMN organization DROP DEFAULT;
+ALTER TABLE reviewer_reassignments ALTER COLUMN organization DROP DEFAULT;
+ALTER TABLE user_absences ALTER COLUMN organization DROP DEFAULT;
+ALTER TABLE team_fallbacks ALTER COLUMN organization DROP DEFAULT;
+ALTER TABLE reviewer_pools ALTER COLUMN organization DROP DEFAULT;
+ALTER TABLE reviewer_pool_members ALTER COLUMN organization DROP DEFAULT;
+ALTER TABLE team_reviewer_pools ALTER COLUMN organization DROP DEFAULT;
+ALTER TABLE ownership_rules ALTER COLUMN organization DROP DEFAULT;
+ALTER TABLE user_skills ALTER COLUMN organization DROP DEFAULT;
+ALTER TABLE pull_request_tags ALTER COLUMN organization DROP DEFAULT;
+ALTER TABLE reviewer_exclusions ALTER COLUMN organization DROP DEFAULT;
+ALTER TABLE pull_request_excluded_reviewers ALTER COLUMN organization DROP DEFAULT;
+ALTER TABLE review_declines ALTER COLUMN organization DROP DEFAULT;
+ALTER TABLE pull_request_labels ALTER COLUMN organization DROP DEFAULT;
+ALTER TABLE team_size_rules ALTER COLUMN organization DROP DEFAULT;
+ALTER TABLE api_tokens ALTER COLUMN organization DROP DEFAULT;
+ALTER TABLE audit_log ALTER COLUMN organization DROP DEFAULT;
+
+DROP INDEX IF EXISTS idx_users_team_active;
+CREATE INDEX IF NOT EXISTS idx_users_team_active ON users(organization, team_name, is_active);
+DROP INDEX IF EXISTS idx_pull_requests_status;
+CREATE INDEX IF NOT EXISTS idx_pull_requests_status ON pull_requests(organization, status);
+DROP INDEX IF EXISTS idx_pr_reviewers_reviewer;
+CREATE INDEX IF NOT EXISTS idx_pr_reviewers_reviewer ON pull_request_reviewers(organization, reviewer_id);
+DROP INDEX IF EXISTS idx_pr_reviewers_pr;
+DROP INDEX IF EXISTS idx_reviewer_reassignments_old_reviewer;
+CREATE INDEX IF NOT EXISTS idx_reviewer_reassignments_old_reviewer
+    ON reviewer_reassignments(organization, old_reviewer_id) WHERE restored_at IS NULL;
+DROP INDEX IF EXISTS idx_user_absences_user_period;
+CREATE INDEX IF NOT EXISTS idx_user_absences_user_period
+    ON user_absences(organization, user_id, starts_at, ends_at);
+DROP INDEX IF EXISTS idx_reviewer_exclusions_reviewer;
+CREATE INDEX IF NOT EXISTS idx_reviewer_exclusions_reviewer ON reviewer_exclusions(organization, reviewer_id);
+DROP INDEX IF EXISTS idx_review_declines_pr;
+CREATE INDEX IF NOT EXISTS idx_review_declines_pr ON review_declines(organization, pull_request_id);
+DROP INDEX IF EXISTS idx_review_declines_user;
+CREATE INDEX IF NOT EXISTS idx_review_declines_user ON review_declines(organization, user_id);
+DROP INDEX IF EXISTS idx_pull_requests_repository;
+CREATE INDEX IF NOT EXISTS idx_pull_requests_repository ON pull_requests(organization, repository);
+DROP INDEX IF EXISTS idx_ownership_rules_position;
+CREATE INDEX IF NOT EXISTS idx_ownership_rules_position ON ownership_rules(organization, position);
+CREATE INDEX IF NOT EXISTS idx_audit_log_organization ON audit_log(organization, created_at);
@@ -0,0 +1,7 @@
+-- Every change to a team or a PR moves it to the next version. The version is sent as
+-- the ETag, so a client can make its change conditional with If-Match.
+ALTER TABLE teams
+    ADD COLUMN IF NOT EXISTS version BIGINT NOT NULL DEFAULT 1;
+
+ALTER TABLE pull_requests
+    ADD COLUMN IF NOT EXISTS version BIGINT NOT NULL DEFAULT 1;
@@ -0,0 +1,9 @@
+-- Token buckets of API clients, used when RATE_LIMIT_STORE=postgres so that all
+-- replicas share them. A missing row is a full bucket.
+CREATE TABLE IF NOT EXISTS rate_limit_buckets (
+    key VARCHAR(255) PRIMARY KEY,
+    tokens DOUBLE PRECISION NOT NULL,
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS idx_rate_limit_buckets_updated_at ON rate_limit_buckets(updated_at);
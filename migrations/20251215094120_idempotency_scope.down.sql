@@ -0,0 +1,9 @@
+-- Keys of different callers may collide, so the stored responses are dropped.
+DELETE FROM idempotency_keys;
+
+ALTER TABLE idempotency_keys DROP CONSTRAINT IF EXISTS idempotency_keys_pkey;
+ALTER TABLE idempotency_keys ADD PRIMARY KEY (organization, key);
+
+ALTER TABLE idempotency_keys
+    DROP COLUMN IF EXISTS headers,
+    DROP COLUMN IF EXISTS actor;
@@ -0,0 +1,8 @@
+-- Idempotency keys belong to the caller, so two callers may use the same key, and
+-- the response headers set by the handler (ETag, Location, ...) are replayed too.
+ALTER TABLE idempotency_keys
+    ADD COLUMN IF NOT EXISTS actor VARCHAR(255) NOT NULL DEFAULT '',
+    ADD COLUMN IF NOT EXISTS headers JSONB NOT NULL DEFAULT '{}';
+
+ALTER TABLE idempotency_keys DROP CONSTRAINT IF EXISTS idempotency_keys_pkey;
+ALTER TABLE idempotency_keys ADD PRIMARY KEY (organization, actor, key);
@@ -0,0 +1,3 @@
+UPDATE user_absences
+SET external_uid = NULL
+WHERE source = 'ics' AND external_uid LIKE 'period:%';
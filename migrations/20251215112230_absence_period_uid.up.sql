@@ -0,0 +1,16 @@
+-- Imported absences without a UID never conflicted on (organization, user_id,
+-- external_uid), so every import added them again. The import now gives them a UID
+-- built from their period; older ones get the same, and their copies are dropped.
+DELETE FROM user_absences a
+    USING user_absences b
+WHERE a.source = 'ics' AND b.source = 'ics'
+    AND a.external_uid IS NULL AND b.external_uid IS NULL
+    AND a.organization = b.organization AND a.user_id = b.user_id
+    AND a.starts_at = b.starts_at AND a.ends_at = b.ends_at
+    AND a.id < b.id;
+
+UPDATE user_absences
+SET external_uid = 'period:'
+    || to_char(starts_at AT TIME ZONE 'UTC', 'YYYYMMDD"T"HH24MISS"Z"') || '/'
+    || to_char(ends_at AT TIME ZONE 'UTC', 'YYYYMMDD"T"HH24MISS"Z"')
+WHERE source = 'ics' AND external_uid IS NULL;
@@ -0,0 +1,9 @@
+// Package openapi holds the API specification the web packages are generated from.
+package openapi
+
+import _ "embed"
+
+// Spec is openapi.yaml, built into the binary for request validation.
+//
+//go:embed openapi.yaml
+var Spec []byte